@@ -0,0 +1,82 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"os"
+	"strconv"
+)
+
+// ******** Public constants ********
+
+// EnvAcceleration is the name of the environment variable that selects the code path.
+// If it is set to AccelerationScalar, the portable implementation is used even if an accelerated one is available.
+// This makes it possible to verify results against the portable implementation.
+// The variable is read once, when the package is initialized.
+const EnvAcceleration = `Z85_ACCELERATION`
+
+// EnvSIMDThreshold is the name of the environment variable that sets the size of the data in bytes
+// from which on the accelerated code path is used.
+// If it is not set, the threshold is determined by a short calibration, when the package is initialized,
+// as the setup of the vector instructions makes tiny inputs slower on some processors.
+const EnvSIMDThreshold = `Z85_SIMD_THRESHOLD`
+
+// EnvParallelThreshold is the name of the environment variable that sets the size of the data in bytes
+// from which on it is encoded in parallel. If it is not set, the threshold is 1 MiB.
+const EnvParallelThreshold = `Z85_PARALLEL_THRESHOLD`
+
+// ******** Private variables ********
+
+// simdThreshold is the size of the data in bytes from which on the accelerated code path is used.
+var simdThreshold = thresholdFromEnv(EnvSIMDThreshold, calibrateSIMDThreshold)
+
+// parallelThreshold is the size of the data in bytes from which on it is encoded in parallel.
+var parallelThreshold = thresholdFromEnv(EnvParallelThreshold, func() int { return defaultParallelThreshold })
+
+// ******** Private functions ********
+
+// selectAcceleration returns the name of the code path that is used for encoding and decoding.
+func selectAcceleration() string {
+	if os.Getenv(EnvAcceleration) == AccelerationScalar {
+		return AccelerationScalar
+	}
+
+	return platformAcceleration()
+}
+
+// thresholdFromEnv returns the threshold in the environment variable name.
+// If the variable is not set or is not a number of 0 or more, the threshold of defaultThreshold is returned.
+func thresholdFromEnv(name string, defaultThreshold func() int) int {
+	threshold, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || threshold < 0 {
+		return defaultThreshold()
+	}
+
+	return threshold
+}
@@ -0,0 +1,195 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+//go:build amd64 && !purego
+
+package z85
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/xformerfhs/z85/internal/cpu"
+)
+
+// ******** Private constants ********
+
+// avx2BlockSize is the number of source bytes that are encoded in one iteration of the AVX2 loop.
+const avx2BlockSize = 8 * byteChunkSize
+
+// avx2EncodedBlockSize is the number of encoded bytes that are written in one iteration of the AVX2 loop.
+const avx2EncodedBlockSize = 8 * encodedChunkSize
+
+// avx2TableSize is the size of the alphabet tables for the AVX2 loops.
+// They consist of 6 lookup tables with 16 entries each.
+const avx2TableSize = 6 * 16
+
+// avx2TableStart is the first character that is covered by the decode table of the AVX2 loop.
+const avx2TableStart = ' '
+
+// calibrationRounds is the number of times the code paths are run for one measurement of the calibration.
+const calibrationRounds = 256
+
+// calibrationTrials is the number of measurements of which the fastest one is used.
+const calibrationTrials = 5
+
+// ******** Private variables ********
+
+// calibrationSizes contains the sizes of the data in bytes that the calibration measures.
+var calibrationSizes = []int{avx2BlockSize, 2 * avx2BlockSize, 4 * avx2BlockSize, 8 * avx2BlockSize}
+
+// useAVX2 is true, if the AVX2 code path has been selected.
+var useAVX2 = acceleration == AccelerationAVX2
+
+// ******** Private functions ********
+
+// platformAcceleration returns the name of the fastest code path that is supported by this processor.
+func platformAcceleration() string {
+	if cpu.X86.HasAVX2 {
+		return AccelerationAVX2
+	}
+
+	return AccelerationScalar
+}
+
+// calibrateSIMDThreshold returns the smallest of the calibration sizes for which encoding and decoding
+// with AVX2 instructions is faster than with the portable implementation.
+// If none is faster, the threshold is twice the largest size.
+func calibrateSIMDThreshold() int {
+	if !useAVX2 {
+		return 0
+	}
+
+	maxSize := calibrationSizes[len(calibrationSizes)-1]
+	source := make([]byte, maxSize)
+	encoded := make([]byte, maxSize/byteChunkSize*encodedChunkSize)
+	decoded := make([]byte, maxSize)
+	StdEncoding.encodeScalarChunks(encoded, source)
+
+	var encodeTable, decodeTable [avx2TableSize]byte
+	copy(encodeTable[:], StdEncoding.encodeTable)
+	for i := 0; i < len(StdEncoding.encodeTable); i++ {
+		decodeTable[int(StdEncoding.encodeTable[i])-avx2TableStart] = byte(i + 1)
+	}
+
+	for _, size := range calibrationSizes {
+		encodedSize := size / byteChunkSize * encodedChunkSize
+		scalar := fastestRun(func() {
+			StdEncoding.encodeScalarChunks(encoded, source[:size])
+			decodeDoubleChunks(StdEncoding, decoded, encoded[:encodedSize])
+		})
+		simd := fastestRun(func() {
+			encodeAVX2(&encoded[0], &source[0], size/avx2BlockSize, &encodeTable)
+			decodeAVX2(&decoded[0], &encoded[0], size/avx2BlockSize, &decodeTable)
+		})
+
+		if simd <= scalar {
+			return size
+		}
+	}
+
+	return 2 * maxSize
+}
+
+// fastestRun returns the fastest of calibrationTrials measurements of calibrationRounds calls of f.
+func fastestRun(f func()) time.Duration {
+	fastest := time.Duration(1<<63 - 1)
+	for trial := 0; trial < calibrationTrials; trial++ {
+		start := time.Now()
+		for round := 0; round < calibrationRounds; round++ {
+			f()
+		}
+
+		fastest = min(fastest, time.Since(start))
+	}
+
+	return fastest
+}
+
+// encodeBlocks encodes as many blocks of 32 bytes of source as possible with AVX2 instructions.
+// Data that is smaller than the threshold is left to the portable implementation.
+// It returns the number of source bytes that have been encoded.
+func encodeBlocks(destination []byte, source []byte, alphabet string) int {
+	blockCount := len(source) / avx2BlockSize
+	if !useAVX2 || blockCount == 0 || len(source) < simdThreshold {
+		return 0
+	}
+
+	_ = destination[blockCount*avx2EncodedBlockSize-1] // Check the destination size once.
+
+	var table [avx2TableSize]byte
+	copy(table[:], alphabet)
+
+	encodeAVX2(&destination[0], &source[0], blockCount, &table)
+
+	return blockCount * avx2BlockSize
+}
+
+// decodeBlocks decodes as many blocks of 40 characters of source as possible with AVX2 instructions.
+// It stops in front of the first block that contains an invalid character or an overflow,
+// so that the scalar code can report the error.
+// Data that decodes to less than the threshold is left to the portable implementation.
+// It returns the number of source characters that have been decoded.
+func decodeBlocks[T string | []byte](destination []byte, source T, alphabet string) int {
+	blockCount := len(source) / avx2EncodedBlockSize
+	if !useAVX2 || blockCount == 0 || len(source)/encodedChunkSize*byteChunkSize < simdThreshold {
+		return 0
+	}
+
+	_ = destination[blockCount*avx2BlockSize-1] // Check the destination size once.
+
+	// The table contains the value of each character + 1, so that invalid characters have the value 0.
+	var table [avx2TableSize]byte
+	for i := 0; i < len(alphabet); i++ {
+		index := int(alphabet[i]) - avx2TableStart
+		if index >= 0 && index < avx2TableSize {
+			table[index] = byte(i + 1)
+		}
+	}
+
+	// A string header is the prefix of a slice header, so this works for both types.
+	data := unsafe.StringData(*(*string)(unsafe.Pointer(&source)))
+	doneCount := decodeAVX2(&destination[0], data, blockCount, &table)
+
+	return doneCount * avx2EncodedBlockSize
+}
+
+// ******** Assembler functions ********
+
+// encodeAVX2 encodes blockCount blocks of 32 bytes from source into blocks of 40 bytes in destination.
+// table contains the alphabet padded to 96 bytes.
+//
+//go:noescape
+func encodeAVX2(destination *byte, source *byte, blockCount int, table *[avx2TableSize]byte)
+
+// decodeAVX2 decodes blockCount blocks of 40 characters from source into blocks of 32 bytes in destination.
+// table contains the value + 1 of the characters from ' ' to 0x7f.
+// It returns the number of blocks that have been decoded before the first invalid block.
+//
+//go:noescape
+func decodeAVX2(destination *byte, source *byte, blockCount int, table *[avx2TableSize]byte) int
@@ -0,0 +1,322 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.1
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//    2026-10-17: V1.0.1: Avoid the SSE to AVX transition penalty.
+//
+
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// Shuffle mask that converts the big endian 32 bit groups into little endian values.
+DATA bswapMask<>+0x00(SB)/8, $0x0405060700010203
+DATA bswapMask<>+0x08(SB)/8, $0x0c0d0e0f08090a0b
+DATA bswapMask<>+0x10(SB)/8, $0x0405060700010203
+DATA bswapMask<>+0x18(SB)/8, $0x0c0d0e0f08090a0b
+GLOBL bswapMask<>(SB), RODATA|NOPTR, $32
+
+// Value that is subtracted from the digits to get the index into the next lookup table.
+DATA sixteens<>+0x00(SB)/8, $0x1010101010101010
+DATA sixteens<>+0x08(SB)/8, $0x1010101010101010
+DATA sixteens<>+0x10(SB)/8, $0x1010101010101010
+DATA sixteens<>+0x18(SB)/8, $0x1010101010101010
+GLOBL sixteens<>(SB), RODATA|NOPTR, $32
+
+// Shuffle masks that interleave the first 4 characters and the last character of each chunk.
+// The "first" masks build bytes 0 to 15 and the "last" masks build bytes 4 to 19 of the 20 bytes of 4 chunks.
+DATA firstLoMask<>+0x00(SB)/8, $0x0605048003020100
+DATA firstLoMask<>+0x08(SB)/8, $0x0c800b0a09088007
+DATA firstLoMask<>+0x10(SB)/8, $0x0605048003020100
+DATA firstLoMask<>+0x18(SB)/8, $0x0c800b0a09088007
+GLOBL firstLoMask<>(SB), RODATA|NOPTR, $32
+
+DATA firstHiMask<>+0x00(SB)/8, $0x8080800080808080
+DATA firstHiMask<>+0x08(SB)/8, $0x8008808080800480
+DATA firstHiMask<>+0x10(SB)/8, $0x8080800080808080
+DATA firstHiMask<>+0x18(SB)/8, $0x8008808080800480
+GLOBL firstHiMask<>(SB), RODATA|NOPTR, $32
+
+DATA lastLoMask<>+0x00(SB)/8, $0x0908800706050480
+DATA lastLoMask<>+0x08(SB)/8, $0x800f0e0d0c800b0a
+DATA lastLoMask<>+0x10(SB)/8, $0x0908800706050480
+DATA lastLoMask<>+0x18(SB)/8, $0x800f0e0d0c800b0a
+GLOBL lastLoMask<>(SB), RODATA|NOPTR, $32
+
+DATA lastHiMask<>+0x00(SB)/8, $0x8080048080808000
+DATA lastHiMask<>+0x08(SB)/8, $0x0c80808080088080
+DATA lastHiMask<>+0x10(SB)/8, $0x8080048080808000
+DATA lastHiMask<>+0x18(SB)/8, $0x0c80808080088080
+GLOBL lastHiMask<>(SB), RODATA|NOPTR, $32
+
+// Value that is subtracted from the characters to get the index into the first lookup table.
+DATA spaces<>+0x00(SB)/8, $0x2020202020202020
+DATA spaces<>+0x08(SB)/8, $0x2020202020202020
+DATA spaces<>+0x10(SB)/8, $0x2020202020202020
+DATA spaces<>+0x18(SB)/8, $0x2020202020202020
+GLOBL spaces<>(SB), RODATA|NOPTR, $32
+
+// Shuffle masks that collect the first 4 characters of each chunk from bytes 0 to 15 ("A")
+// and bytes 4 to 19 ("B") of 4 chunks and the last character of each chunk from "B".
+DATA loAMask<>+0x00(SB)/8, $0x0807060503020100
+DATA loAMask<>+0x08(SB)/8, $0x8080800f0d0c0b0a
+DATA loAMask<>+0x10(SB)/8, $0x0807060503020100
+DATA loAMask<>+0x18(SB)/8, $0x8080800f0d0c0b0a
+GLOBL loAMask<>(SB), RODATA|NOPTR, $32
+
+DATA loBMask<>+0x00(SB)/8, $0x8080808080808080
+DATA loBMask<>+0x08(SB)/8, $0x0e0d0c8080808080
+DATA loBMask<>+0x10(SB)/8, $0x8080808080808080
+DATA loBMask<>+0x18(SB)/8, $0x0e0d0c8080808080
+GLOBL loBMask<>(SB), RODATA|NOPTR, $32
+
+DATA hiBMask<>+0x00(SB)/8, $0x8080800580808000
+DATA hiBMask<>+0x08(SB)/8, $0x8080800f8080800a
+DATA hiBMask<>+0x10(SB)/8, $0x8080800580808000
+DATA hiBMask<>+0x18(SB)/8, $0x8080800f8080800a
+GLOBL hiBMask<>(SB), RODATA|NOPTR, $32
+
+// Mask for the lowest byte of each 32 bit value.
+DATA lowByteMask<>+0x00(SB)/8, $0x000000ff000000ff
+DATA lowByteMask<>+0x08(SB)/8, $0x000000ff000000ff
+DATA lowByteMask<>+0x10(SB)/8, $0x000000ff000000ff
+DATA lowByteMask<>+0x18(SB)/8, $0x000000ff000000ff
+GLOBL lowByteMask<>(SB), RODATA|NOPTR, $32
+
+// Weights that combine pairs of digits into values with d0*85 + d1.
+DATA weights85<>+0x00(SB)/8, $0x0155015501550155
+DATA weights85<>+0x08(SB)/8, $0x0155015501550155
+DATA weights85<>+0x10(SB)/8, $0x0155015501550155
+DATA weights85<>+0x18(SB)/8, $0x0155015501550155
+GLOBL weights85<>(SB), RODATA|NOPTR, $32
+
+// Weights that combine pairs of 16 bit values into values with w0*85*85 + w1.
+DATA weights7225<>+0x00(SB)/8, $0x00011c3900011c39
+DATA weights7225<>+0x08(SB)/8, $0x00011c3900011c39
+DATA weights7225<>+0x10(SB)/8, $0x00011c3900011c39
+DATA weights7225<>+0x18(SB)/8, $0x00011c3900011c39
+GLOBL weights7225<>(SB), RODATA|NOPTR, $32
+
+// DIVMOD85 divides the 8 values in Y0 by 85.
+// The quotients are stored in Y0 and the remainders in Y3.
+// The division is a multiplication with 0xc0c0c0c1 followed by a shift by 38 bits.
+// This is exact for all 32 bit values.
+#define DIVMOD85 \
+	VPMULUDQ Y15, Y0, Y1; \
+	VPSRLQ   $38, Y1, Y1; \
+	VPSRLQ   $32, Y0, Y2; \
+	VPMULUDQ Y15, Y2, Y2; \
+	VPSRLQ   $38, Y2, Y2; \
+	VPSLLQ   $32, Y2, Y2; \
+	VPBLENDD $0xaa, Y2, Y1, Y1; \
+	VPMULLD  Y14, Y1, Y2; \
+	VPSUBD   Y2, Y0, Y3; \
+	VMOVDQU  Y1, Y0
+
+// LOOKUP replaces the indices in the register "indices" by the entries of the 6 tables in Y5 to Y10.
+// The result is stored in "result". The content of "indices" and "temp" is destroyed.
+// Adding 0x70 with unsigned saturation sets the high bit of all indices that are not in the
+// range of the current table, so that VPSHUFB yields 0 for them.
+#define LOOKUP(indices, result, temp) \
+	VPADDUSB Y13, indices, temp; \
+	VPSHUFB  temp, Y5, result; \
+	VPSUBB   sixteens<>(SB), indices, indices; \
+	VPADDUSB Y13, indices, temp; \
+	VPSHUFB  temp, Y6, temp; \
+	VPOR     temp, result, result; \
+	VPSUBB   sixteens<>(SB), indices, indices; \
+	VPADDUSB Y13, indices, temp; \
+	VPSHUFB  temp, Y7, temp; \
+	VPOR     temp, result, result; \
+	VPSUBB   sixteens<>(SB), indices, indices; \
+	VPADDUSB Y13, indices, temp; \
+	VPSHUFB  temp, Y8, temp; \
+	VPOR     temp, result, result; \
+	VPSUBB   sixteens<>(SB), indices, indices; \
+	VPADDUSB Y13, indices, temp; \
+	VPSHUFB  temp, Y9, temp; \
+	VPOR     temp, result, result; \
+	VPSUBB   sixteens<>(SB), indices, indices; \
+	VPADDUSB Y13, indices, temp; \
+	VPSHUFB  temp, Y10, temp; \
+	VPOR     temp, result, result
+
+// func encodeAVX2(destination *byte, source *byte, blockCount int, table *[96]byte)
+TEXT ·encodeAVX2(SB), NOSPLIT, $0-32
+	MOVQ destination+0(FP), DI
+	MOVQ source+8(FP), SI
+	MOVQ blockCount+16(FP), CX
+	MOVQ table+24(FP), AX
+
+	VBROADCASTI128 0x00(AX), Y5
+	VBROADCASTI128 0x10(AX), Y6
+	VBROADCASTI128 0x20(AX), Y7
+	VBROADCASTI128 0x30(AX), Y8
+	VBROADCASTI128 0x40(AX), Y9
+	VBROADCASTI128 0x50(AX), Y10
+
+	MOVL         $0x70707070, AX
+	VMOVD        AX, X13
+	VPBROADCASTD X13, Y13
+	MOVL         $85, AX
+	VMOVD        AX, X14
+	VPBROADCASTD X14, Y14
+	MOVL         $0xc0c0c0c1, AX
+	VMOVD        AX, X15
+	VPBROADCASTD X15, Y15
+
+encodeLoop:
+	// Load 8 chunks and convert them into 32 bit values.
+	VMOVDQU (SI), Y0
+	VPSHUFB bswapMask<>(SB), Y0, Y0
+
+	// The last digit of each chunk is kept in Y4.
+	DIVMOD85
+	VMOVDQU Y3, Y4
+
+	// The first 4 digits of each chunk are collected in Y12 with the first digit in the lowest byte.
+	DIVMOD85
+	VPSLLD $24, Y3, Y12
+	DIVMOD85
+	VPSLLD $16, Y3, Y3
+	VPOR   Y3, Y12, Y12
+	DIVMOD85
+	VPSLLD $8, Y3, Y3
+	VPOR   Y3, Y12, Y12
+	VPOR   Y0, Y12, Y12
+
+	LOOKUP(Y12, Y2, Y1)
+	LOOKUP(Y4, Y3, Y1)
+
+	// Interleave the characters into 20 bytes per 128 bit lane.
+	VPSHUFB firstLoMask<>(SB), Y2, Y0
+	VPSHUFB firstHiMask<>(SB), Y3, Y1
+	VPOR    Y1, Y0, Y0
+	VPSHUFB lastLoMask<>(SB), Y2, Y4
+	VPSHUFB lastHiMask<>(SB), Y3, Y1
+	VPOR    Y1, Y4, Y4
+
+	// The stores of the last bytes overlap the stores of the first bytes.
+	VMOVDQU      X0, 0(DI)
+	VMOVDQU      X4, 4(DI)
+	VEXTRACTI128 $1, Y0, X0
+	VEXTRACTI128 $1, Y4, X4
+	VMOVDQU      X0, 20(DI)
+	VMOVDQU      X4, 24(DI)
+
+	ADDQ $32, SI
+	ADDQ $40, DI
+	DECQ CX
+	JNZ  encodeLoop
+
+	VZEROUPPER
+	RET
+
+// func decodeAVX2(destination *byte, source *byte, blockCount int, table *[96]byte) int
+TEXT ·decodeAVX2(SB), NOSPLIT, $0-40
+	MOVQ destination+0(FP), DI
+	MOVQ source+8(FP), SI
+	MOVQ blockCount+16(FP), CX
+	MOVQ table+24(FP), AX
+	XORQ BX, BX
+
+	VBROADCASTI128 0x00(AX), Y5
+	VBROADCASTI128 0x10(AX), Y6
+	VBROADCASTI128 0x20(AX), Y7
+	VBROADCASTI128 0x30(AX), Y8
+	VBROADCASTI128 0x40(AX), Y9
+	VBROADCASTI128 0x50(AX), Y10
+
+	VPCMPEQB     Y11, Y11, Y11
+	MOVL         $1, AX
+	VMOVD        AX, X12
+	VPBROADCASTD X12, Y12
+	MOVL         $0x70707070, AX
+	VMOVD        AX, X13
+	VPBROADCASTD X13, Y13
+	MOVL         $85, AX
+	VMOVD        AX, X14
+	VPBROADCASTD X14, Y14
+	MOVL         $50529027, AX
+	VMOVD        AX, X15
+	VPBROADCASTD X15, Y15
+
+decodeLoop:
+	// Load the 20 characters of 4 chunks into each 128 bit lane as the overlapping parts "A" and "B".
+	VMOVDQU     0(SI), X0
+	VINSERTI128 $1, 20(SI), Y0, Y0
+	VMOVDQU     4(SI), X1
+	VINSERTI128 $1, 24(SI), Y1, Y1
+
+	// Collect the first 4 characters of each chunk in Y2 and the last one in Y3.
+	VPSHUFB loAMask<>(SB), Y0, Y2
+	VPSHUFB loBMask<>(SB), Y1, Y3
+	VPOR    Y3, Y2, Y2
+	VPSHUFB hiBMask<>(SB), Y1, Y3
+
+	// The tables contain the digit + 1, so invalid characters are mapped to 0.
+	VPSUBB spaces<>(SB), Y2, Y2
+	LOOKUP(Y2, Y0, Y4)
+	VPSUBB spaces<>(SB), Y3, Y3
+	LOOKUP(Y3, Y1, Y4)
+
+	VPXOR    Y4, Y4, Y4
+	VPCMPEQB Y4, Y0, Y2
+	VPCMPEQB Y4, Y1, Y3
+	VPAND    lowByteMask<>(SB), Y3, Y3
+	VPOR     Y3, Y2, Y2
+	VPTEST   Y2, Y2
+	JNZ      decodeDone
+
+	VPADDB Y11, Y0, Y0
+	VPADDB Y11, Y1, Y1
+	VPAND  lowByteMask<>(SB), Y1, Y1
+
+	// Combine the first 4 digits into (d0*85 + d1)*85*85 + d2*85 + d3.
+	VPMADDUBSW weights85<>(SB), Y0, Y0
+	VPMADDWD   weights7225<>(SB), Y0, Y0
+
+	// The value overflows, if the combined value + (d4 > 0 ? 1 : 0) is larger than (2^32 - 1) / 85.
+	VPMINUD  Y12, Y1, Y2
+	VPADDD   Y2, Y0, Y2
+	VPCMPGTD Y15, Y2, Y2
+	VPTEST   Y2, Y2
+	JNZ      decodeDone
+
+	VPMULLD Y14, Y0, Y0
+	VPADDD  Y1, Y0, Y0
+	VPSHUFB bswapMask<>(SB), Y0, Y0
+	VMOVDQU Y0, (DI)
+
+	ADDQ $40, SI
+	ADDQ $32, DI
+	INCQ BX
+	CMPQ BX, CX
+	JB   decodeLoop
+
+decodeDone:
+	VZEROUPPER
+	MOVQ BX, ret+32(FP)
+	RET
@@ -0,0 +1,53 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+//go:build !amd64 || purego
+
+package z85
+
+// ******** Private functions ********
+
+// platformAcceleration returns the name of the fastest code path that is supported by this processor.
+func platformAcceleration() string {
+	return AccelerationScalar
+}
+
+// calibrateSIMDThreshold returns 0, as there is no accelerated code path on this platform.
+func calibrateSIMDThreshold() int {
+	return 0
+}
+
+// encodeBlocks encodes nothing, as there is no accelerated code path on this platform.
+func encodeBlocks(_ []byte, _ []byte, _ string) int {
+	return 0
+}
+
+// decodeBlocks decodes nothing, as there is no accelerated code path on this platform.
+func decodeBlocks[T string | []byte](_ []byte, _ T, _ string) int {
+	return 0
+}
@@ -0,0 +1,175 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestEncodeAccelerated tests if the accelerated encoding yields the same result as the scalar reference.
+func TestEncodeAccelerated(t *testing.T) {
+	customEncoding, _ := z85.NewEncoding(reversedAlphabet)
+
+	for size := 0; size <= 4*maxSliceSize; size += 4 {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		encoded, _ := z85.Encode(data)
+		expected := referenceEncode(z85.StdEncoding.Alphabet(), data)
+		if encoded != expected {
+			t.Fatalf(`Encoding of size %d is '%s', but should be '%s'`, size, encoded, expected)
+		}
+
+		encoded, _ = customEncoding.EncodeToString(data)
+		expected = referenceEncode(reversedAlphabet, data)
+		if encoded != expected {
+			t.Fatalf(`Custom encoding of size %d is '%s', but should be '%s'`, size, encoded, expected)
+		}
+	}
+}
+
+// TestEncodeAcceleratedLimits tests if the accelerated encoding handles the smallest and largest chunk values.
+func TestEncodeAcceleratedLimits(t *testing.T) {
+	for _, value := range []byte{0x00, 0x54, 0x55, 0x7f, 0x80, 0xff} {
+		data := make([]byte, 64)
+		for i := range data {
+			data[i] = value
+		}
+
+		encoded, _ := z85.Encode(data)
+		expected := referenceEncode(z85.StdEncoding.Alphabet(), data)
+		if encoded != expected {
+			t.Fatalf(`Encoding of 0x%02x is '%s', but should be '%s'`, value, encoded, expected)
+		}
+	}
+}
+
+// TestDecodeAccelerated tests if the accelerated decoding yields the source data.
+func TestDecodeAccelerated(t *testing.T) {
+	customEncoding, _ := z85.NewEncoding(reversedAlphabet)
+
+	for size := 0; size <= 4*maxSliceSize; size += 4 {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		decoded, err := z85.Decode(referenceEncode(z85.StdEncoding.Alphabet(), data))
+		if err != nil {
+			t.Fatalf(`Decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoding of size %d is '% 02x', but should be '% 02x'`, size, decoded, data)
+		}
+
+		decoded, err = customEncoding.DecodeString(referenceEncode(reversedAlphabet, data))
+		if err != nil {
+			t.Fatalf(`Custom decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Custom decoding of size %d is '% 02x', but should be '% 02x'`, size, decoded, data)
+		}
+	}
+}
+
+// TestDecodeAcceleratedLimits tests if the accelerated decoding handles the largest chunk values.
+func TestDecodeAcceleratedLimits(t *testing.T) {
+	decoded, err := z85.Decode(strings.Repeat(`%nSc0`, 16))
+	if err != nil {
+		t.Fatalf(`Decoding of the largest value failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, bytes.Repeat([]byte{0xff}, 64)) {
+		t.Fatalf(`Decoding of the largest value is '% 02x'`, decoded)
+	}
+}
+
+// TestDecodeAcceleratedErrors tests if errors in any position are reported with the correct offsets.
+func TestDecodeAcceleratedErrors(t *testing.T) {
+	valid := strings.Repeat(`HelloWorld`, 8)
+
+	for position := 0; position < len(valid); position++ {
+		for _, b := range []byte{' ', '"', 0x80, 0xff} {
+			invalid := []byte(valid)
+			invalid[position] = b
+
+			_, err := z85.DecodeBytes(invalid)
+
+			var codecErr *z85.CodecError
+			if !errors.As(err, &codecErr) {
+				t.Fatalf(`Error for 0x%02x at %d is not a CodecError: '%v'`, b, position, err)
+			}
+
+			checkCodecError(t, codecErr, z85.KindInvalidByte, int64(position/5*4), int64(position), b)
+		}
+	}
+
+	for position := 0; position < len(valid); position += 5 {
+		for _, chunk := range []string{`%nSc1`, `%nSd0`, `#####`} {
+			overflowing := valid[:position] + chunk + valid[position+5:]
+
+			_, err := z85.Decode(overflowing)
+
+			var codecErr *z85.CodecError
+			if !errors.As(err, &codecErr) {
+				t.Fatalf(`Overflow error for '%s' at %d is not a CodecError: '%v'`, chunk, position, err)
+			}
+
+			checkCodecError(t, codecErr, z85.KindOverflow, int64(position/5*4), int64(position), 0)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// referenceEncode encodes data with the straightforward scalar algorithm.
+func referenceEncode(alphabet string, data []byte) string {
+	result := make([]byte, 0, len(data)/4*5)
+	for i := 0; i < len(data); i += 4 {
+		value := binary.BigEndian.Uint32(data[i:])
+		var chunk [5]byte
+		for j := 4; j >= 0; j-- {
+			chunk[j] = alphabet[value%85]
+			value /= 85
+		}
+
+		result = append(result, chunk[:]...)
+	}
+
+	return string(result)
+}
@@ -0,0 +1,138 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// allocRuns is the number of runs that the number of allocations is averaged over.
+const allocRuns = 20
+
+// ******** Private variables ********
+
+// allocSizes are the data sizes that the allocation budget is checked with.
+// They cover the stack buffer for small data and the heap, but stay below the size for parallel encoding.
+var allocSizes = []int{0, 32, 4096, 256 * 1024}
+
+// ******** Test functions ********
+
+// TestAllocationBudget tests if the functions keep the documented allocation budget.
+func TestAllocationBudget(t *testing.T) {
+	wrapped := z85.StdEncoding.WithWrap(76)
+
+	for _, size := range allocSizes {
+		data := make([]byte, size)
+		encoded := z85.MustEncode(data)
+		encodedBytes := []byte(encoded)
+		wrappedEncoded, _ := wrapped.EncodeToString(data)
+		paddedData := make([]byte, size+1)
+		buffer := make([]byte, len(encoded)+len(wrappedEncoded))
+		reader := bytes.NewReader(data)
+
+		for _, test := range []struct {
+			name   string
+			budget float64
+			f      func()
+		}{
+			{`Encode`, 1, func() { _, _ = z85.Encode(data) }},
+			{`EncodeToBytes`, 1, func() { _, _ = z85.EncodeToBytes(data) }},
+			{`MustEncode`, 1, func() { _ = z85.MustEncode(data) }},
+			{`Decode`, 1, func() { _, _ = z85.Decode(encoded) }},
+			{`DecodeBytes`, 1, func() { _, _ = z85.DecodeBytes(encodedBytes) }},
+			{`MustDecode`, 1, func() { _ = z85.MustDecode(encoded) }},
+			{`PaddedEncoding.EncodeToString`, 1, func() { _, _ = z85.PaddedEncoding.EncodeToString(paddedData) }},
+			{`WithWrap.EncodeToString`, 1, func() { _, _ = wrapped.EncodeToString(data) }},
+			// Wrapped data that does not fit into the stack buffer is copied without its line breaks first.
+			{`WithWrap.DecodeString`, 2, func() { _, _ = wrapped.DecodeString(wrappedEncoded) }},
+			// The read buffer is allocated besides the result.
+			{`EncodeReaderN`, 2, func() { reader.Reset(data); _, _ = z85.EncodeReaderN(reader, int64(size)) }},
+			{`EncodeInto`, 0, func() { _, _ = z85.EncodeInto(buffer, data) }},
+			{`DecodeInto`, 0, func() { _, _ = z85.DecodeInto(buffer, encoded) }},
+			{`AppendEncode`, 0, func() { _, _ = z85.AppendEncode(buffer[:0], data) }},
+			{`AppendDecode`, 0, func() { _, _ = z85.AppendDecode(buffer[:0], encoded) }},
+			{`Encoding.Encode`, 0, func() { _, _ = z85.StdEncoding.Encode(buffer, data) }},
+			{`Encoding.Decode`, 0, func() { _, _ = z85.StdEncoding.Decode(buffer, encodedBytes) }},
+		} {
+			allocs := testing.AllocsPerRun(allocRuns, test.f)
+			if allocs > test.budget {
+				t.Errorf(`%s of %d bytes needs %.1f allocations instead of at most %.0f`, test.name, size, allocs, test.budget)
+			}
+		}
+	}
+}
+
+// TestAllocationBudgetFixed tests if the functions for fixed sizes do not allocate.
+func TestAllocationBudgetFixed(t *testing.T) {
+	encoded20 := z85.MustEncode(make([]byte, 16))
+	encoded40 := z85.MustEncode(make([]byte, 32))
+	encoded80 := z85.MustEncode(make([]byte, 64))
+
+	allocs := testing.AllocsPerRun(allocRuns, func() {
+		_, _ = z85.Decode20(encoded20)
+		_, _ = z85.Decode40(encoded40)
+		_, _ = z85.Decode80(encoded80)
+		_ = z85.EncodeChunkString(0)
+		_ = z85.MustDecodeChunk(encodedTheOne[:5])
+	})
+	if allocs > 1 {
+		t.Fatalf(`Functions for fixed sizes need %.1f allocations instead of at most 1 for EncodeChunkString`, allocs)
+	}
+}
+
+// TestAllocationBudgetStream tests if the stream encoder and decoder do not allocate after their creation.
+func TestAllocationBudgetStream(t *testing.T) {
+	data := make([]byte, 4096)
+	encoded := []byte(z85.MustEncode(data))
+
+	encoder := z85.NewEncoder(io.Discard)
+	allocs := testing.AllocsPerRun(allocRuns, func() {
+		_, _ = encoder.Write(data)
+	})
+	if allocs != 0 {
+		t.Fatalf(`Encoder.Write needs %.1f allocations instead of 0`, allocs)
+	}
+
+	source := bytes.NewReader(encoded)
+	decoder := z85.NewDecoder(source)
+	buffer := make([]byte, len(data))
+	allocs = testing.AllocsPerRun(allocRuns, func() {
+		source.Reset(encoded)
+		_, _ = io.ReadFull(decoder, buffer)
+	})
+	if allocs != 0 {
+		t.Fatalf(`Decoder.Read needs %.1f allocations instead of 0`, allocs)
+	}
+}
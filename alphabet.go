@@ -0,0 +1,108 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ******** Public constants ********
+
+// Alphabet is the alphabet of the Z85 encoding as specified in https://rfc.zeromq.org/spec/32.
+// The character at index i encodes the value i.
+const Alphabet = `0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#`
+
+// CharacterClass is a regular expression character class that matches the characters of Alphabet.
+// It is the result of QuoteForRegexp(Alphabet).
+const CharacterClass = `[0-9a-zA-Z\.\-\:\+\=\^\!\/\*\?\&\<\>\(\)\[\]\{\}\@\%\$\#]`
+
+// ******** Private variables ********
+
+// blockRegexp is compiled on first use, so programs that do not need it do not pay for it.
+var blockRegexp = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(`(?:` + CharacterClass + `{5})+`)
+})
+
+// ******** Public functions ********
+
+// QuoteForRegexp returns a regular expression character class that matches the characters of alphabet.
+// Runs of at least 3 consecutive characters are written as ranges and all characters
+// that are not letters or digits are escaped, so the result can be embedded into any regular expression.
+func QuoteForRegexp(alphabet string) string {
+	var sb strings.Builder
+	sb.Grow(2*len(alphabet) + 2)
+
+	sb.WriteByte('[')
+	for i := 0; i < len(alphabet); {
+		last := i
+		for last+1 < len(alphabet) && alphabet[last+1] == alphabet[last]+1 {
+			last++
+		}
+
+		if last-i >= 2 {
+			writeClassCharacter(&sb, alphabet[i])
+			sb.WriteByte('-')
+			writeClassCharacter(&sb, alphabet[last])
+			i = last + 1
+		} else {
+			writeClassCharacter(&sb, alphabet[i])
+			i++
+		}
+	}
+	sb.WriteByte(']')
+
+	return sb.String()
+}
+
+// BlockRegexp returns a regular expression that matches a sequence of complete chunks
+// of 5 characters of the Z85 alphabet.
+// It is meant for finding Z85 data in logs and other text.
+// A regular expression can not detect chunks whose value is too large, so a match may still fail to decode.
+// The returned value is shared and must not be modified.
+func BlockRegexp() *regexp.Regexp {
+	return blockRegexp()
+}
+
+// ******** Private functions ********
+
+// writeClassCharacter writes c into a character class and escapes it, if it is not a letter or a digit.
+func writeClassCharacter(sb *strings.Builder, c byte) {
+	if !isAlphaNumeric(c) {
+		sb.WriteByte('\\')
+	}
+
+	sb.WriteByte(c)
+}
+
+// isAlphaNumeric reports whether c is an ASCII letter or digit.
+func isAlphaNumeric(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,97 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestAlphabet tests if the public alphabet is the one of the standard encoding.
+func TestAlphabet(t *testing.T) {
+	if z85.Alphabet != z85.StdEncoding.Alphabet() {
+		t.Fatalf(`Alphabet is '%s', but the standard encoding uses '%s'`, z85.Alphabet, z85.StdEncoding.Alphabet())
+	}
+
+	if len(z85.Alphabet) != 85 {
+		t.Fatalf(`Alphabet has %d characters`, len(z85.Alphabet))
+	}
+}
+
+// TestCharacterClass tests if the character class matches exactly the characters of the alphabet.
+func TestCharacterClass(t *testing.T) {
+	if z85.QuoteForRegexp(z85.Alphabet) != z85.CharacterClass {
+		t.Fatalf(`Quoted alphabet is '%s', but should be '%s'`, z85.QuoteForRegexp(z85.Alphabet), z85.CharacterClass)
+	}
+
+	for _, alphabet := range []string{z85.Alphabet, reversedAlphabet, z85.RFC1924Encoding.Alphabet()} {
+		class := regexp.MustCompile(`^` + z85.QuoteForRegexp(alphabet) + `$`)
+		for c := 0; c < 256; c++ {
+			s := string([]byte{byte(c)})
+			inAlphabet := false
+			for i := 0; i < len(alphabet); i++ {
+				inAlphabet = inAlphabet || alphabet[i] == byte(c)
+			}
+
+			if class.MatchString(s) != inAlphabet {
+				t.Fatalf(`Character class of '%s' is wrong for 0x%02x`, alphabet, c)
+			}
+		}
+	}
+}
+
+// TestBlockRegexp tests if the regular expression finds complete chunks.
+func TestBlockRegexp(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{`id HelloWorld;`, []string{`HelloWorld`}},
+		{`a HelloWorld1 b`, []string{`HelloWorld`}},
+		{`Hell; 12345 67890`, []string{`12345`, `67890`}},
+		{`abcd`, nil},
+	}
+
+	for _, test := range tests {
+		found := z85.BlockRegexp().FindAllString(test.input, -1)
+		if len(found) != len(test.expected) {
+			t.Fatalf(`Found %q in '%s', but should find %q`, found, test.input, test.expected)
+		}
+
+		for i := range found {
+			if found[i] != test.expected[i] {
+				t.Fatalf(`Found %q in '%s', but should find %q`, found, test.input, test.expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,211 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"strings"
+)
+
+// ******** Private constants ********
+
+// maxReportedPositions is the maximum number of positions that a Report lists.
+const maxReportedPositions = 100
+
+// reportWhitespace contains the characters that are counted as whitespace by Analyze.
+const reportWhitespace = " \t\n\v\f\r"
+
+// ******** Public types ********
+
+// Report contains the results of Analyze.
+type Report struct {
+	// Length is the number of characters of the analyzed string.
+	Length int `json:"length"`
+	// GroupCount is the number of complete groups of 5 characters without whitespace.
+	GroupCount int `json:"groupCount"`
+	// WhitespaceCount is the number of whitespace characters.
+	WhitespaceCount int `json:"whitespaceCount"`
+	// InvalidCount is the number of characters that are neither whitespace nor valid in the variant.
+	InvalidCount int `json:"invalidCount"`
+	// InvalidPositions contains the positions of the first 100 invalid characters.
+	InvalidPositions []int `json:"invalidPositions,omitempty"`
+	// OverflowPositions contains the positions of the first 100 groups whose value does not fit into 32 bits.
+	OverflowPositions []int `json:"overflowPositions,omitempty"`
+	// Variant is the name of the detected format as returned by Spec, or an empty string,
+	// if the string is no valid encoding of any format.
+	Variant string `json:"variant"`
+	// Canonical reports whether the string is exactly the encoding of its decoding in the detected format,
+	// i.e. it is valid, has no whitespace and padding bytes are zero.
+	Canonical bool `json:"canonical"`
+}
+
+// ******** Public functions ********
+
+// Analyze examines s and returns statistics about it without decoding it.
+//
+// The variant is detected from the characters and the length of s without whitespace.
+// A string that matches Z85 and RFC 1924 is reported as Z85.
+// A string with a length of a multiple of 5 plus 1 is reported as Z85-JSONSafe, if it starts with 'z'
+// and as Z85P otherwise.
+// Invalid characters are reported for the detected variant, or for Z85, if no variant matches.
+func Analyze(s string) Report {
+	report := Report{Length: len(s)}
+
+	compact := s
+	if strings.ContainsAny(s, reportWhitespace) {
+		compact = removeWhitespace(s)
+		report.WhitespaceCount = len(s) - len(compact)
+	}
+
+	report.GroupCount = len(compact) / encodedChunkSize
+
+	encoding, variant := detectVariant(compact)
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(reportWhitespace, s[i]) < 0 && encoding.decodeValue(s[i]) == ivEc {
+			report.InvalidCount++
+			if len(report.InvalidPositions) < maxReportedPositions {
+				report.InvalidPositions = append(report.InvalidPositions, i)
+			}
+		}
+	}
+
+	// The groups start after the prefix of the JSON-safe variant.
+	groups := compact
+	if variant == nameJSONSafe {
+		groups = compact[1:]
+	}
+
+	for position := 0; position+encodedChunkSize <= len(groups); position += encodedChunkSize {
+		_, err := encoding.decodeChunkValue(groups[position:position+encodedChunkSize], uint(position))
+		if IsErrOverflow(err) && len(report.OverflowPositions) < maxReportedPositions {
+			report.OverflowPositions = append(report.OverflowPositions, originalPosition(s, len(compact)-len(groups)+position))
+		}
+	}
+
+	if report.InvalidCount == 0 && len(report.OverflowPositions) == 0 {
+		report.Variant = variant
+		report.Canonical = report.WhitespaceCount == 0 && isCanonical(variant, compact)
+	}
+
+	return report
+}
+
+// ******** Private functions ********
+
+// removeWhitespace returns s without the characters in reportWhitespace.
+func removeWhitespace(s string) string {
+	var builder strings.Builder
+	builder.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(reportWhitespace, s[i]) < 0 {
+			builder.WriteByte(s[i])
+		}
+	}
+
+	return builder.String()
+}
+
+// originalPosition returns the position in s of the character at position in s without whitespace.
+func originalPosition(s string, position int) int {
+	remaining := position
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(reportWhitespace, s[i]) >= 0 {
+			continue
+		}
+
+		if remaining == 0 {
+			return i
+		}
+
+		remaining--
+	}
+
+	return len(s)
+}
+
+// detectVariant returns the encoding and the name of the format that s, which has no whitespace, matches.
+// If no format matches, it returns StdEncoding and an empty name.
+func detectVariant(s string) (*Encoding, string) {
+	if isInAlphabet(StdEncoding, s) {
+		switch len(s) % encodedChunkSize {
+		case 0:
+			return StdEncoding, nameZ85
+
+		case 1:
+			if s[0] == jsonSafePrefix {
+				return StdEncoding, nameJSONSafe
+			}
+
+			if len(s) > 1 && StdEncoding.decodeValue(s[len(s)-1]) < byteChunkSize {
+				return StdEncoding, namePadded
+			}
+		}
+
+		return StdEncoding, ``
+	}
+
+	if len(s)%encodedChunkSize == 0 && isInAlphabet(RFC1924Encoding, s) {
+		return RFC1924Encoding, nameRFC1924
+	}
+
+	return StdEncoding, ``
+}
+
+// isInAlphabet reports whether all characters of s are in the alphabet of the encoding e.
+func isInAlphabet(e *Encoding, s string) bool {
+	for i := 0; i < len(s); i++ {
+		if e.decodeValue(s[i]) == ivEc {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isCanonical reports whether s, which is a valid encoding of the variant without overflowing groups,
+// is the encoding of its decoding.
+// Only the padding bytes of a padded encoding can make a valid encoding non-canonical.
+func isCanonical(variant string, s string) bool {
+	switch variant {
+	case ``:
+		return false
+
+	case namePadded:
+		padCount := StdEncoding.decodeValue(s[len(s)-1])
+		if padCount == 0 {
+			return true
+		}
+
+		lastChunk := s[len(s)-1-encodedChunkSize : len(s)-1]
+		value, _ := StdEncoding.decodeChunkValue(lastChunk, 0)
+
+		return value&(1<<(8*padCount)-1) == 0
+	}
+
+	return true
+}
@@ -0,0 +1,100 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestAnalyzeVariants tests the detection of the variants and the canonicality.
+func TestAnalyzeVariants(t *testing.T) {
+	tests := []struct {
+		input     string
+		variant   string
+		canonical bool
+	}{
+		{``, `Z85`, true},
+		{encodedTheOne, `Z85`, true},
+		{`z` + encodedTheOne, `Z85-JSONSafe`, true},
+		{encodedTheOne + `0`, `Z85P`, true},
+		{`k%+LK2`, `Z85P`, true},
+		{`k%+LL2`, `Z85P`, false},
+		{`0`, ``, false},
+		{`Hello World`, `Z85`, false},
+		{`HelloWorl`, ``, false},
+		{`Hell"World`, ``, false},
+		{`Hell;World`, `RFC1924`, true},
+		{`HelloWorld%nSc1`, ``, false},
+	}
+
+	for _, test := range tests {
+		report := z85.Analyze(test.input)
+		if report.Variant != test.variant {
+			t.Fatalf(`Variant of %q is '%s', but should be '%s'`, test.input, report.Variant, test.variant)
+		}
+
+		if report.Canonical != test.canonical {
+			t.Fatalf(`Canonicality of %q is %t, but should be %t`, test.input, report.Canonical, test.canonical)
+		}
+	}
+}
+
+// TestAnalyzeStatistics tests the counts and positions of a report.
+func TestAnalyzeStatistics(t *testing.T) {
+	report := z85.Analyze("Hello World\n%nSc1 ~~")
+
+	if report.Length != 20 {
+		t.Fatalf(`Length is %d, but should be 20`, report.Length)
+	}
+
+	if report.GroupCount != 3 {
+		t.Fatalf(`Group count is %d, but should be 3`, report.GroupCount)
+	}
+
+	if report.WhitespaceCount != 3 {
+		t.Fatalf(`Whitespace count is %d, but should be 3`, report.WhitespaceCount)
+	}
+
+	if report.InvalidCount != 2 || !slices.Equal(report.InvalidPositions, []int{18, 19}) {
+		t.Fatalf(`Invalid characters are %d at %v, but should be 2 at [18 19]`, report.InvalidCount, report.InvalidPositions)
+	}
+
+	if !slices.Equal(report.OverflowPositions, []int{12}) {
+		t.Fatalf(`Overflow positions are %v, but should be [12]`, report.OverflowPositions)
+	}
+
+	if report.Variant != `` || report.Canonical {
+		t.Fatalf(`Invalid input is reported as variant '%s' with canonicality %t`, report.Variant, report.Canonical)
+	}
+}
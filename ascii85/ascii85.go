@@ -0,0 +1,260 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Package ascii85 implements the Adobe variant of the Ascii85 encoding.
+//
+// It uses the chunk engine of the z85 package with the Ascii85 alphabet
+// and adds the features that Ascii85 has in addition to Z85:
+// the shorthand 'z' for a chunk of zero bytes, partial final chunks and the delimiters "<~" and "~>".
+// It can also decode the output of the historical btoa tool.
+package ascii85
+
+import (
+	"encoding/binary"
+	"github.com/xformerfhs/z85"
+	"strings"
+)
+
+// ******** Private constants ********
+
+// alphabet contains the Ascii85 encoding characters. They are the characters from '!' to 'u'.
+const alphabet = "!\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstu"
+
+// byteChunkSize is the size of a raw chunk.
+const byteChunkSize = 4
+
+// encodedChunkSize is the size of an encoded chunk.
+const encodedChunkSize = 5
+
+// zeroChunk is the shorthand for a chunk of zero bytes.
+const zeroChunk = 'z'
+
+// encodedZeroChunk is the encoding of a chunk of zero bytes without the shorthand.
+const encodedZeroChunk = `!!!!!`
+
+// spaceChunk is the shorthand for a chunk of spaces that is used by btoa.
+const spaceChunk = 'y'
+
+// encodedSpaceChunk is the encoding of a chunk of spaces without the shorthand.
+const encodedSpaceChunk = `+<VdL`
+
+// maxChunk is the encoding of the largest value of a chunk.
+// As the alphabet is in ASCII order, a larger encoded chunk compares greater than maxChunk.
+const maxChunk = `s8W-!`
+
+// startDelimiter is the delimiter that starts the encoded data.
+const startDelimiter = `<~`
+
+// endDelimiter is the delimiter that ends the encoded data.
+const endDelimiter = `~>`
+
+// whitespace contains the characters that are ignored when decoding.
+const whitespace = " \t\n\v\f\r\x00"
+
+// ******** Private variables ********
+
+// encoding is the z85 encoding with the Ascii85 alphabet.
+var encoding = mustNewEncoding()
+
+// ******** Public functions ********
+
+// Encode encodes a byte slice of any length into an Ascii85 string without delimiters.
+// A chunk of 4 zero bytes is encoded as 'z'.
+// A partial final chunk of n bytes is encoded as n+1 characters.
+func Encode(source []byte) string {
+	return string(appendEncode(make([]byte, 0, maxEncodedLen(len(source))), source))
+}
+
+// EncodeWithDelimiters encodes a byte slice of any length into an Ascii85 string
+// that is enclosed in the delimiters "<~" and "~>".
+func EncodeWithDelimiters(source []byte) string {
+	result := make([]byte, 0, maxEncodedLen(len(source))+len(startDelimiter)+len(endDelimiter))
+	result = append(result, startDelimiter...)
+	result = appendEncode(result, source)
+	result = append(result, endDelimiter...)
+
+	return string(result)
+}
+
+// Decode decodes an Ascii85 string into a byte slice.
+// The string may be enclosed in the delimiters "<~" and "~>".
+// Whitespace is ignored.
+func Decode(source string) ([]byte, error) {
+	start := len(source) - len(strings.TrimLeft(source, whitespace))
+	end := max(len(strings.TrimRight(source, whitespace)), start)
+
+	if strings.HasPrefix(source[start:end], startDelimiter) {
+		if !strings.HasSuffix(source[start+len(startDelimiter):end], endDelimiter) {
+			return nil, ErrCorruptInput(end)
+		}
+
+		start += len(startDelimiter)
+		end -= len(endDelimiter)
+	}
+
+	return decodeBody(source[start:end], int64(start), false)
+}
+
+// DecodePDF decodes Ascii85 data with the semantics of the ASCII85Decode filter of PDF.
+//
+// Whitespace is permitted anywhere and the end-of-data marker "~>" terminates the data.
+// Everything after the marker is ignored.
+// A missing marker at the end of the data and a leading "<~" are tolerated, as some producers write them.
+func DecodePDF(source string) ([]byte, error) {
+	start := len(source) - len(strings.TrimLeft(source, whitespace))
+	if strings.HasPrefix(source[start:], startDelimiter) {
+		start += len(startDelimiter)
+	}
+
+	end := len(source)
+	markerIndex := strings.IndexByte(source[start:], endDelimiter[0])
+	if markerIndex >= 0 {
+		end = start + markerIndex
+
+		// Whitespace is also permitted inside the marker.
+		rest := strings.TrimLeft(source[end+1:], whitespace)
+		if len(rest) == 0 || rest[0] != endDelimiter[1] {
+			return nil, ErrCorruptInput(end)
+		}
+	}
+
+	return decodeBody(source[start:end], int64(start), false)
+}
+
+// ******** Private functions ********
+
+// mustNewEncoding creates the z85 encoding with the Ascii85 alphabet.
+func mustNewEncoding() *z85.Encoding {
+	result, err := z85.NewEncoding(alphabet)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// maxEncodedLen returns the maximum length of the encoding of n bytes.
+func maxEncodedLen(n int) int {
+	return (n + byteChunkSize - 1) / byteChunkSize * encodedChunkSize
+}
+
+// appendEncode appends the encoding of source to destination.
+func appendEncode(destination []byte, source []byte) []byte {
+	fullLen := len(source) &^ (byteChunkSize - 1)
+
+	// Runs of non-zero chunks are encoded in one call.
+	// The length of a run is always a multiple of 4, so the encoding cannot fail.
+	runStart := 0
+	for i := 0; i < fullLen; i += byteChunkSize {
+		if binary.BigEndian.Uint32(source[i:]) == 0 {
+			destination, _ = encoding.AppendEncode(destination, source[runStart:i])
+			destination = append(destination, zeroChunk)
+			runStart = i + byteChunkSize
+		}
+	}
+
+	destination, _ = encoding.AppendEncode(destination, source[runStart:fullLen])
+
+	restLen := len(source) - fullLen
+	if restLen > 0 {
+		var lastChunk [byteChunkSize]byte
+		copy(lastChunk[:], source[fullLen:])
+
+		var encodedChunk [encodedChunkSize]byte
+		_, _ = encoding.Encode(encodedChunk[:], lastChunk[:])
+		destination = append(destination, encodedChunk[:restLen+1]...)
+	}
+
+	return destination
+}
+
+// decodeBody decodes the encoded data without delimiters.
+// The offset is the offset of body in the input and is used for error reporting.
+// If allowSpaceChunk is true, the shorthand 'y' for a chunk of spaces is accepted.
+func decodeBody(body string, offset int64, allowSpaceChunk bool) ([]byte, error) {
+	// Collect the encoded chunks without whitespace and with expanded shorthands.
+	chunks := make([]byte, 0, len(body)+encodedChunkSize)
+	chunkStart := int64(0)
+	chunkLen := 0
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case strings.IndexByte(whitespace, c) >= 0:
+			continue
+
+		case c == zeroChunk && chunkLen == 0:
+			chunks = append(chunks, encodedZeroChunk...)
+
+		case c == spaceChunk && chunkLen == 0 && allowSpaceChunk:
+			chunks = append(chunks, encodedSpaceChunk...)
+
+		case c >= alphabet[0] && c <= alphabet[len(alphabet)-1]:
+			if chunkLen == 0 {
+				chunkStart = offset + int64(i)
+			}
+
+			chunks = append(chunks, c)
+			chunkLen++
+			if chunkLen == encodedChunkSize {
+				if string(chunks[len(chunks)-encodedChunkSize:]) > maxChunk {
+					return nil, ErrCorruptInput(chunkStart)
+				}
+
+				chunkLen = 0
+			}
+
+		default:
+			return nil, ErrCorruptInput(offset + int64(i))
+		}
+	}
+
+	// A partial final chunk is padded with the largest character and the surplus bytes are removed.
+	restLen := 0
+	if chunkLen > 0 {
+		if chunkLen == 1 {
+			return nil, ErrCorruptInput(chunkStart)
+		}
+
+		restLen = encodedChunkSize - chunkLen
+		for i := 0; i < restLen; i++ {
+			chunks = append(chunks, alphabet[len(alphabet)-1])
+		}
+
+		if string(chunks[len(chunks)-encodedChunkSize:]) > maxChunk {
+			return nil, ErrCorruptInput(chunkStart)
+		}
+	}
+
+	result := make([]byte, len(chunks)/encodedChunkSize*byteChunkSize)
+	_, err := encoding.Decode(result, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	return result[:len(result)-restLen], nil
+}
@@ -0,0 +1,205 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package ascii85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	stdascii85 "encoding/ascii85"
+	"github.com/xformerfhs/z85/ascii85"
+	"testing"
+)
+
+// ******** Private constants ********
+
+// iterationCount is the number of random tests.
+const iterationCount = 100
+
+// maxSliceSize is the maximum size of random data.
+const maxSliceSize = 128
+
+// ******** Test functions ********
+
+// TestEncodeLikeStandardLibrary tests if the encoding is the same as the one of encoding/ascii85.
+func TestEncodeLikeStandardLibrary(t *testing.T) {
+	for i := 0; i < iterationCount; i++ {
+		data := randomData(i)
+
+		expected := make([]byte, stdascii85.MaxEncodedLen(len(data)))
+		expected = expected[:stdascii85.Encode(expected, data)]
+
+		encoded := ascii85.Encode(data)
+		if encoded != string(expected) {
+			t.Fatalf(`Encoding of '% 02x' is '%s', but should be '%s'`, data, encoded, expected)
+		}
+
+		decoded, err := ascii85.Decode(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding of '%s' failed: %v`, encoded, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoding of '%s' is '% 02x', but should be '% 02x'`, encoded, decoded, data)
+		}
+	}
+}
+
+// TestKnownValues tests the encoding of known values.
+func TestKnownValues(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected string
+	}{
+		{``, ``},
+		{"\x00\x00\x00\x00", `z`},
+		{"\x00\x00\x00\x00\x00", `z!!`},
+		{`Man `, `9jqo^`},
+		{`sure.`, `F*2M7/c`},
+	}
+
+	for _, test := range tests {
+		encoded := ascii85.Encode([]byte(test.data))
+		if encoded != test.expected {
+			t.Fatalf(`Encoding of '%s' is '%s', but should be '%s'`, test.data, encoded, test.expected)
+		}
+	}
+}
+
+// TestDelimiters tests if the delimiters are written and accepted.
+func TestDelimiters(t *testing.T) {
+	encoded := ascii85.EncodeWithDelimiters([]byte(`sure.`))
+	if encoded != `<~F*2M7/c~>` {
+		t.Fatalf(`Encoding with delimiters is '%s'`, encoded)
+	}
+
+	for _, input := range []string{encoded, "  <~F*2M\n7/c~>\r\n", `F*2M7/c`, `<~~>`} {
+		decoded, err := ascii85.Decode(input)
+		if err != nil {
+			t.Fatalf(`Decoding of '%s' failed: %v`, input, err)
+		}
+
+		if input != `<~~>` && string(decoded) != `sure.` {
+			t.Fatalf(`Decoding of '%s' is '%s'`, input, decoded)
+		}
+	}
+}
+
+// TestDecodeInvalid tests if invalid data is rejected with the correct offset.
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		input  string
+		offset int64
+	}{
+		{`<~9jqo^`, 7},
+		{`9jqo^~>`, 5},
+		{`9jqo^v`, 5},
+		{`9jqo^F`, 5},
+		{`9jzo^`, 2},
+		{`uuuuu`, 0},
+		{` 9jqo^ s8W-"`, 7},
+	}
+
+	for _, test := range tests {
+		_, err := ascii85.Decode(test.input)
+		if !ascii85.IsErrCorruptInput(err) {
+			t.Fatalf(`Expected corrupt input error for '%s', but got: %v`, test.input, err)
+		}
+
+		if err != ascii85.ErrCorruptInput(test.offset) {
+			t.Fatalf(`Error for '%s' is '%v', but offset should be %d`, test.input, err, test.offset)
+		}
+	}
+}
+
+// TestDecodePDF tests if the PDF filter semantics are applied.
+func TestDecodePDF(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{`F*2M7/c~>`, `sure.`},
+		{"F*2M7/c~>\nendstream", `sure.`},
+		{"F *2\r\nM7\t/c ~ >", `sure.`},
+		{`<~F*2M7/c~>`, `sure.`},
+		{`F*2M7/c`, `sure.`},
+		{`z!!~>`, "\x00\x00\x00\x00\x00"},
+		{`~>`, ``},
+		{``, ``},
+	}
+
+	for _, test := range tests {
+		decoded, err := ascii85.DecodePDF(test.input)
+		if err != nil {
+			t.Fatalf(`Decoding of '%s' failed: %v`, test.input, err)
+		}
+
+		if string(decoded) != test.expected {
+			t.Fatalf(`Decoding of '%s' is '%s', but should be '%s'`, test.input, decoded, test.expected)
+		}
+	}
+}
+
+// TestDecodePDFInvalid tests if invalid PDF data is rejected.
+func TestDecodePDFInvalid(t *testing.T) {
+	tests := []struct {
+		input  string
+		offset int64
+	}{
+		{`F*2M7/c~`, 7},
+		{`F*2M7/c~x`, 7},
+		{`F*2{7/c~>`, 3},
+	}
+
+	for _, test := range tests {
+		_, err := ascii85.DecodePDF(test.input)
+		if err != ascii85.ErrCorruptInput(test.offset) {
+			t.Fatalf(`Error for '%s' is '%v', but should have offset %d`, test.input, err, test.offset)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// randomData returns random data of a random length with some zero chunks.
+func randomData(i int) []byte {
+	var sizeByte [1]byte
+	_, _ = crand.Read(sizeByte[:])
+
+	result := make([]byte, int(sizeByte[0])%maxSliceSize)
+	_, _ = crand.Read(result)
+
+	// Every other data contains zero chunks at varying positions.
+	if i&1 != 0 {
+		for j := i % 8; j+4 <= len(result); j += 12 {
+			copy(result[j:j+4], []byte{0, 0, 0, 0})
+		}
+	}
+
+	return result
+}
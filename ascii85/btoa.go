@@ -0,0 +1,132 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package ascii85
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ******** Private constants ********
+
+// btoaBegin is the line that starts the data of btoa.
+const btoaBegin = "xbtoa Begin\n"
+
+// btoaEnd is the start of the line that ends the data of btoa.
+const btoaEnd = "xbtoa End"
+
+// btoaTrailerFormat is the format of the line that ends the data of btoa.
+// It contains the length of the data in decimal and hexadecimal notation and the three checksums.
+const btoaTrailerFormat = "xbtoa End N %d %x E %x S %x R %x"
+
+// checksumMask is the mask for the checksum bits that are the same on all platforms.
+// btoa computes the checksums in a C long, which has 64 bits on some platforms.
+// The lower 32 bits are the same on all platforms.
+const checksumMask = 0xffff_ffff
+
+// rotateCarry is the bit that is rotated into the lowest bit of the rotating checksum.
+const rotateCarry = 0x8000_0000
+
+// ******** Public functions ********
+
+// DecodeBtoa decodes data that was encoded by the historical btoa tool in version 4.2.
+//
+// The data must be enclosed in the lines "xbtoa Begin" and "xbtoa End ...".
+// Lines before the begin line, e.g. mail headers, are ignored.
+// In addition to 'z' for a chunk of zero bytes, the shorthand 'y' for a chunk of 4 spaces is accepted.
+// The length and the checksums in the end line are verified.
+func DecodeBtoa(source string) ([]byte, error) {
+	start := strings.Index(source, btoaBegin)
+	if start < 0 || (start > 0 && source[start-1] != '\n') {
+		return nil, ErrCorruptInput(0)
+	}
+
+	// The line feed of the begin line may be the one before the end line.
+	start += len(btoaBegin)
+	end := strings.Index(source[start-1:], "\n"+btoaEnd)
+	if end < 0 {
+		return nil, ErrCorruptInput(len(source))
+	}
+
+	end += start
+
+	var length, lengthHex int64
+	var eor, sum, rotation uint64
+	_, err := fmt.Sscanf(source[end:], btoaTrailerFormat, &length, &lengthHex, &eor, &sum, &rotation)
+	if err != nil || length != lengthHex || length < 0 {
+		return nil, ErrCorruptInput(end)
+	}
+
+	result, err := decodeBody(source[start:end], int64(start), true)
+	if err != nil {
+		return nil, err
+	}
+
+	// btoa pads the last chunk with zero bytes.
+	if int64(len(result)) < length || int64(len(result))-length >= byteChunkSize {
+		return nil, ErrCorruptInput(end)
+	}
+
+	for _, b := range result[length:] {
+		if b != 0 {
+			return nil, ErrCorruptInput(end)
+		}
+	}
+
+	result = result[:length]
+
+	if !checksumsMatch(result, eor, sum, rotation) {
+		return nil, ErrCorruptInput(end)
+	}
+
+	return result, nil
+}
+
+// ******** Private functions ********
+
+// checksumsMatch reports whether the btoa checksums of data match the supplied ones.
+func checksumsMatch(data []byte, eor uint64, sum uint64, rotation uint64) bool {
+	var dataEor, dataSum, dataRotation uint32
+	for _, b := range data {
+		dataEor ^= uint32(b)
+		dataSum += uint32(b) + 1
+
+		if dataRotation&rotateCarry != 0 {
+			dataRotation = dataRotation<<1 + 1
+		} else {
+			dataRotation <<= 1
+		}
+
+		dataRotation += uint32(b)
+	}
+
+	return uint64(dataEor) == eor&checksumMask &&
+		uint64(dataSum) == sum&checksumMask &&
+		uint64(dataRotation) == rotation&checksumMask
+}
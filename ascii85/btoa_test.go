@@ -0,0 +1,119 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package ascii85_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xformerfhs/z85/ascii85"
+)
+
+// ******** Private constants ********
+
+// btoaData is the data that is encoded in btoaEncoded.
+const btoaData = "Hello, W    \x00\x00\x00\x00orld!"
+
+// btoaEncoded is the output of btoa for btoaData.
+const btoaEncoded = "xbtoa Begin\n" +
+	"87cURD_*#4yzDfTZ)+TMKB\n" +
+	"xbtoa End N 21 15 E 2d S 4fe R acbad19\n"
+
+// ******** Test functions ********
+
+// TestDecodeBtoa tests the decoding of btoa output with both shorthands and a padded last chunk.
+func TestDecodeBtoa(t *testing.T) {
+	decoded, err := ascii85.DecodeBtoa("From: archive\n\n" + btoaEncoded)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, []byte(btoaData)) {
+		t.Fatalf(`Decoded data is '% 02x', but should be '% 02x'`, decoded, btoaData)
+	}
+}
+
+// TestDecodeBtoaEmpty tests the decoding of empty btoa output.
+func TestDecodeBtoaEmpty(t *testing.T) {
+	decoded, err := ascii85.DecodeBtoa("xbtoa Begin\nxbtoa End N 0 0 E 0 S 0 R 0\n")
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if len(decoded) != 0 {
+		t.Fatalf(`Decoded data is '% 02x', but should be empty`, decoded)
+	}
+}
+
+// TestDecodeBtoa64BitChecksums tests if checksums of btoa on platforms with 64 bit longs are accepted.
+func TestDecodeBtoa64BitChecksums(t *testing.T) {
+	source := "xbtoa Begin\n" +
+		"87cURD_*#4yzDfTZ)+TMKB\n" +
+		"xbtoa End N 21 15 E 2d S 4fe R 10acbad19\n"
+
+	_, err := ascii85.DecodeBtoa(source)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+}
+
+// TestDecodeBtoaInvalid tests if invalid btoa output is rejected.
+func TestDecodeBtoaInvalid(t *testing.T) {
+	for _, source := range []string{
+		// No framing.
+		`87cURD_*#4yzDfTZ)+TMKB`,
+		// No end line.
+		"xbtoa Begin\n87cURD_*#4yzDfTZ)+TMKB\n",
+		// Wrong length.
+		"xbtoa Begin\n87cURD_*#4yzDfTZ)+TMKB\nxbtoa End N 20 14 E 2d S 4fe R acbad19\n",
+		// Different lengths in decimal and hexadecimal notation.
+		"xbtoa Begin\n87cURD_*#4yzDfTZ)+TMKB\nxbtoa End N 21 16 E 2d S 4fe R acbad19\n",
+		// Wrong checksums.
+		"xbtoa Begin\n87cURD_*#4yzDfTZ)+TMKB\nxbtoa End N 21 15 E 2e S 4fe R acbad19\n",
+		"xbtoa Begin\n87cURD_*#4yzDfTZ)+TMKB\nxbtoa End N 21 15 E 2d S 4ff R acbad19\n",
+		"xbtoa Begin\n87cURD_*#4yzDfTZ)+TMKB\nxbtoa End N 21 15 E 2d S 4fe R acbad18\n",
+		// Corrupted data.
+		"xbtoa Begin\n87cURD_*#4yzDfTZ)+TMKC\nxbtoa End N 21 15 E 2d S 4fe R acbad19\n",
+		// Shorthand inside a chunk.
+		"xbtoa Begin\n87cURD_*#4yzDfTZ)+TMyB\nxbtoa End N 21 15 E 2d S 4fe R acbad19\n",
+	} {
+		_, err := ascii85.DecodeBtoa(source)
+		if !ascii85.IsErrCorruptInput(err) {
+			t.Fatalf(`Invalid btoa output %q not detected: %v`, source, err)
+		}
+	}
+}
+
+// TestDecodeNoSpaceChunk tests if the btoa shorthand 'y' is not accepted in Ascii85 data.
+func TestDecodeNoSpaceChunk(t *testing.T) {
+	_, err := ascii85.Decode(`y`)
+	if !ascii85.IsErrCorruptInput(err) {
+		t.Fatalf(`Shorthand 'y' not rejected: %v`, err)
+	}
+}
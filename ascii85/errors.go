@@ -0,0 +1,56 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package ascii85
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ******** Private constants ********
+
+// corruptInputMessage contains the format for the error message of invalid Ascii85 data.
+const corruptInputMessage = `invalid ascii85 data at input byte %d`
+
+// ******** Public types and functions ********
+
+// ErrCorruptInput is returned when the encoded data is not valid.
+// Its value is the offset of the invalid data in the input.
+type ErrCorruptInput int64
+
+// Error returns the error message for a corrupt input error.
+func (e ErrCorruptInput) Error() string {
+	return fmt.Sprintf(corruptInputMessage, int64(e))
+}
+
+// IsErrCorruptInput reports whether the supplied error is the ErrCorruptInput error.
+func IsErrCorruptInput(err error) bool {
+	var expectedErr ErrCorruptInput
+	return errors.As(err, &expectedErr)
+}
@@ -0,0 +1,118 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package ascii85_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/xformerfhs/z85/ascii85"
+	"testing"
+)
+
+// ******** Private variables ********
+
+// decodeRegressions contains inputs that caused problems in the decoder and whether they are valid.
+// The inputs are also the seeds of FuzzDecode.
+var decodeRegressions = []struct {
+	input string
+	valid bool
+}{
+	{``, true},
+	{`9jqo^`, true},
+	{`z`, true},
+	// The largest chunk and overflowing chunks.
+	{`s8W-!`, true},
+	{`s8W-"`, false},
+	{`uuuuu`, false},
+	{`s8N`, true},
+	{`s8W`, false},
+	{`uuu`, false},
+	// Whitespace at the boundaries.
+	{" <~9jqo^~> \n", true},
+	{"9jqo^\r\n", true},
+	{"9 j q o ^", true},
+	// Truncated armor.
+	{`<~`, false},
+	{`<~9jqo^`, false},
+	{`<~9jqo^~`, false},
+	{`9jqo^~>`, false},
+	{`<~~>`, true},
+	// Misplaced shorthands and short chunks.
+	{`9z`, false},
+	{`9jqo^9`, false},
+}
+
+// ******** Test functions ********
+
+// TestDecodeRegressions tests if the regression inputs are accepted or rejected as expected.
+func TestDecodeRegressions(t *testing.T) {
+	for _, regression := range decodeRegressions {
+		_, err := ascii85.Decode(regression.input)
+
+		if regression.valid && err != nil {
+			t.Fatalf(`Decoding of %q failed: %v`, regression.input, err)
+		}
+
+		if !regression.valid && !ascii85.IsErrCorruptInput(err) {
+			t.Fatalf(`Expected corrupt input error for %q, but got: %v`, regression.input, err)
+		}
+	}
+}
+
+// FuzzDecode tests if decoding arbitrary strings never panics and if decoded data survives a round trip.
+func FuzzDecode(f *testing.F) {
+	for _, regression := range decodeRegressions {
+		f.Add(regression.input)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		decoded, err := ascii85.Decode(input)
+		if err != nil {
+			var offset ascii85.ErrCorruptInput
+			if !errors.As(err, &offset) {
+				t.Fatalf(`Decoding of %q did not return a corrupt input error, but: %v`, input, err)
+			}
+
+			if offset < 0 || int64(offset) > int64(len(input)) {
+				t.Fatalf(`Decoding of %q returned an offset outside of the input: %d`, input, offset)
+			}
+
+			return
+		}
+
+		redecoded, err := ascii85.Decode(ascii85.EncodeWithDelimiters(decoded))
+		if err != nil {
+			t.Fatalf(`Decoding of the encoding of %q failed: %v`, input, err)
+		}
+
+		if !bytes.Equal(redecoded, decoded) {
+			t.Fatalf(`Decoding of %q does not survive a round trip`, input)
+		}
+	})
+}
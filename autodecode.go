@@ -0,0 +1,149 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"errors"
+)
+
+// ******** Public types ********
+
+// AutoDecoder decodes a sequence of blocks of the same stream, whose formats are detected like Analyze does.
+// The format of the previous block is preferred, if a block matches it, as e.g. a block of Z85 is often
+// also valid RFC 1924.
+//
+// If Strict is true, a block whose format differs from the one of the first block is rejected
+// with an ErrMixedVariants error, so concatenated files from different tools are not mixed silently.
+// An AutoDecoder is not safe for concurrent use.
+type AutoDecoder struct {
+	// Strict makes Decode reject a block whose format differs from the one of the first block.
+	Strict bool
+
+	variant      string
+	rawCount     int64
+	encodedCount int64
+}
+
+// ******** Public creation functions ********
+
+// NewAutoDecoder creates a new AutoDecoder that rejects mixed formats, if strict is true.
+func NewAutoDecoder(strict bool) *AutoDecoder {
+	return &AutoDecoder{Strict: strict}
+}
+
+// ******** Public functions ********
+
+// Decode detects the format of the next block and decodes it.
+// The offsets in errors are the ones in the stream of all blocks.
+// An empty block matches every format and decodes to nothing.
+func (a *AutoDecoder) Decode(block string) ([]byte, error) {
+	if len(block) == 0 {
+		return nil, nil
+	}
+
+	variant := a.variant
+	if !matchesVariant(variant, block) {
+		_, variant = detectVariant(block)
+	}
+
+	if variant == `` {
+		// StdEncoding reports the first character or the length that does not fit.
+		_, err := StdEncoding.DecodeString(block)
+		return nil, a.moveError(err)
+	}
+
+	if a.Strict && a.variant != `` && variant != a.variant {
+		return nil, newMixedVariantsError(variant, a.rawCount, a.encodedCount)
+	}
+
+	var result []byte
+	var err error
+	switch variant {
+	case nameJSONSafe:
+		result, err = DecodeJSONSafe(block)
+	case namePadded:
+		result, err = PaddedEncoding.DecodeString(block)
+	case nameRFC1924:
+		result, err = RFC1924Encoding.DecodeString(block)
+	default:
+		result, err = StdEncoding.DecodeString(block)
+	}
+
+	if err != nil {
+		return nil, a.moveError(err)
+	}
+
+	if a.variant == `` || !a.Strict {
+		a.variant = variant
+	}
+
+	a.rawCount += int64(len(result))
+	a.encodedCount += int64(len(block))
+
+	return result, nil
+}
+
+// Variant returns the name of the format of the last decoded block as returned by Spec,
+// or of the first one in strict mode. It is empty, if no block has been decoded.
+func (a *AutoDecoder) Variant() string {
+	return a.variant
+}
+
+// ******** Private functions ********
+
+// matchesVariant reports whether s, which is not empty, is an encoding in the format with the name variant.
+func matchesVariant(variant string, s string) bool {
+	switch variant {
+	case nameZ85:
+		return len(s)%encodedChunkSize == 0 && isInAlphabet(StdEncoding, s)
+	case nameJSONSafe:
+		return len(s)%encodedChunkSize == 1 && s[0] == jsonSafePrefix && isInAlphabet(StdEncoding, s)
+	case namePadded:
+		return len(s)%encodedChunkSize == 1 && isInAlphabet(StdEncoding, s) &&
+			StdEncoding.decodeValue(s[len(s)-1]) < byteChunkSize
+	case nameRFC1924:
+		return len(s)%encodedChunkSize == 0 && isInAlphabet(RFC1924Encoding, s)
+	default:
+		return false
+	}
+}
+
+// moveError moves the offsets of a CodecError from the block to the stream.
+func (a *AutoDecoder) moveError(err error) error {
+	err = remapError(err, func(offset int64) int64 {
+		return offset + a.encodedCount
+	})
+
+	var codecErr *CodecError
+	if errors.As(err, &codecErr) {
+		codecErr.RawOffset += a.rawCount
+	}
+
+	return err
+}
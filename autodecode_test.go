@@ -0,0 +1,129 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestAutoDecoder tests if blocks of different formats are detected and decoded.
+func TestAutoDecoder(t *testing.T) {
+	// The encoding of 0xffffffff contains a '|', which is not part of the Z85 alphabet.
+	ones := []byte{0xff, 0xff, 0xff, 0xff}
+	rfc1924, _ := z85.RFC1924Encoding.EncodeToString(ones)
+	padded, _ := z85.PaddedEncoding.EncodeToString([]byte{1, 2, 3})
+
+	decoder := z85.NewAutoDecoder(false)
+	for _, test := range []struct {
+		block    string
+		expected []byte
+		variant  string
+	}{
+		{encodedTheOne, clearTheOne, `Z85`},
+		{`z` + encodedTheOne, clearTheOne, `Z85-JSONSafe`},
+		{padded, []byte{1, 2, 3}, `Z85P`},
+		{rfc1924, ones, `RFC1924`},
+		{``, nil, `RFC1924`},
+	} {
+		decoded, err := decoder.Decode(test.block)
+		if err != nil {
+			t.Fatalf(`Decoding of '%s' failed: %v`, test.block, err)
+		}
+
+		if !bytes.Equal(decoded, test.expected) || decoder.Variant() != test.variant {
+			t.Fatalf(`Decoding of '%s' is '% 02x' in format '%s'`, test.block, decoded, decoder.Variant())
+		}
+	}
+}
+
+// TestAutoDecoderPrefersVariant tests if a block that is valid in two formats is decoded in the format of the previous block.
+func TestAutoDecoderPrefersVariant(t *testing.T) {
+	// "aaaaa" is valid in both alphabets, but has different values.
+	rfc1924, _ := z85.RFC1924Encoding.EncodeToString([]byte{0xff, 0xff, 0xff, 0xff})
+	expected, _ := z85.RFC1924Encoding.DecodeString(`aaaaa`)
+
+	decoder := z85.NewAutoDecoder(true)
+	_, _ = decoder.Decode(rfc1924)
+	decoded, err := decoder.Decode(`aaaaa`)
+	if err != nil || !bytes.Equal(decoded, expected) {
+		t.Fatalf(`Ambiguous block is decoded to '% 02x': %v`, decoded, err)
+	}
+}
+
+// TestAutoDecoderStrict tests if a strict decoder rejects a block in another format.
+func TestAutoDecoderStrict(t *testing.T) {
+	decoder := z85.NewAutoDecoder(true)
+	if _, err := decoder.Decode(encodedTheOne); err != nil {
+		t.Fatalf(`Decoding of the first block failed: %v`, err)
+	}
+
+	_, err := decoder.Decode(`z` + encodedTheOne)
+	if !z85.IsErrMixedVariants(err) {
+		t.Fatalf(`Expected mixed variants error, but got: %v`, err)
+	}
+
+	var codecErr *z85.CodecError
+	if errors.As(err, &codecErr) {
+		checkCodecError(t, codecErr, z85.KindMixedVariants, 8, 10, 0)
+	}
+
+	if decoder.Variant() != `Z85` {
+		t.Fatalf(`Variant of the strict decoder is '%s'`, decoder.Variant())
+	}
+
+	lax := z85.NewAutoDecoder(false)
+	_, _ = lax.Decode(encodedTheOne)
+	if _, err = lax.Decode(`z` + encodedTheOne); err != nil {
+		t.Fatalf(`Decoder that is not strict rejects another format: %v`, err)
+	}
+}
+
+// TestAutoDecoderErrors tests if invalid blocks are reported at their offsets in the stream.
+func TestAutoDecoderErrors(t *testing.T) {
+	decoder := z85.NewAutoDecoder(false)
+	_, _ = decoder.Decode(encodedTheOne)
+
+	_, err := decoder.Decode(`Hello Word`)
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Expected codec error, but got: %v`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindInvalidByte, 12, 15, ' ')
+
+	if _, err = decoder.Decode(`Hell`); !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+}
@@ -0,0 +1,167 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Package base91 implements the basE91 encoding by Joachim Henke.
+//
+// basE91 encodes 13 or 14 bits into two characters of an alphabet of 91 characters.
+// This results in an overhead of at most 23% compared to 25% for Z85.
+// Data of any length can be encoded.
+package base91
+
+// ******** Private constants ********
+
+// alphabet contains the basE91 encoding characters.
+const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!#$%&()*+,./:;<=>?@[]^_`{|}~\""
+
+// codeSize is the number of encoding characters.
+const codeSize = 91
+
+// mask13 is the mask for a 13 bit value.
+const mask13 = 1<<13 - 1
+
+// mask14 is the mask for a 14 bit value.
+const mask14 = 1<<14 - 1
+
+// maxShortValue is the largest 13 bit value for which 14 bits are encoded,
+// because 14 bit values up to this value still fit into two characters.
+const maxShortValue = codeSize*codeSize - 1 - (1 << 13)
+
+// invalidValue marks characters that are not part of the alphabet.
+const invalidValue = 0xff
+
+// ******** Private variables ********
+
+// decodeTable maps characters to their values.
+var decodeTable = makeDecodeTable()
+
+// ******** Public functions ********
+
+// Encode encodes a byte slice of any length into a basE91 string.
+func Encode(source []byte) string {
+	result := make([]byte, 0, MaxEncodedLen(len(source)))
+
+	var bits uint32
+	var bitCount uint
+	for _, b := range source {
+		bits |= uint32(b) << bitCount
+		bitCount += 8
+
+		if bitCount > 13 {
+			value := bits & mask13
+			if value > maxShortValue {
+				bits >>= 13
+				bitCount -= 13
+			} else {
+				value = bits & mask14
+				bits >>= 14
+				bitCount -= 14
+			}
+
+			result = append(result, alphabet[value%codeSize], alphabet[value/codeSize])
+		}
+	}
+
+	if bitCount > 0 {
+		result = append(result, alphabet[bits%codeSize])
+		if bitCount > 7 || bits >= codeSize {
+			result = append(result, alphabet[bits/codeSize])
+		}
+	}
+
+	return string(result)
+}
+
+// Decode decodes a basE91 string into a byte slice.
+func Decode(source string) ([]byte, error) {
+	result := make([]byte, 0, MaxDecodedLen(len(source)))
+
+	var bits uint32
+	var bitCount uint
+	pending := -1
+	for i := 0; i < len(source); i++ {
+		value := decodeTable[source[i]]
+		if value == invalidValue {
+			return nil, ErrCorruptInput(i)
+		}
+
+		if pending < 0 {
+			pending = int(value)
+			continue
+		}
+
+		pending += int(value) * codeSize
+		bits |= uint32(pending) << bitCount
+		if pending&mask13 > maxShortValue {
+			bitCount += 13
+		} else {
+			bitCount += 14
+		}
+
+		for bitCount > 7 {
+			result = append(result, byte(bits))
+			bits >>= 8
+			bitCount -= 8
+		}
+
+		pending = -1
+	}
+
+	if pending >= 0 {
+		result = append(result, byte(bits|uint32(pending)<<bitCount))
+	}
+
+	return result, nil
+}
+
+// MaxEncodedLen returns the maximum length of the encoding of n bytes.
+func MaxEncodedLen(n int) int {
+	// Each pair of characters encodes at least 13 bits.
+	return (n*8+12)/13*2 + 1
+}
+
+// MaxDecodedLen returns the maximum length of the decoding of n characters.
+func MaxDecodedLen(n int) int {
+	// Each pair of characters encodes at most 14 bits.
+	return (n*7)/8 + 1
+}
+
+// ******** Private functions ********
+
+// makeDecodeTable creates the table that maps characters to their values.
+func makeDecodeTable() *[256]byte {
+	var result [256]byte
+	for i := range result {
+		result[i] = invalidValue
+	}
+
+	for i := 0; i < codeSize; i++ {
+		result[alphabet[i]] = byte(i)
+	}
+
+	return &result
+}
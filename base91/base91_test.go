@@ -0,0 +1,109 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package base91_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"github.com/xformerfhs/z85/base91"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestKnownValues tests the encoding of known values of the reference implementation.
+func TestKnownValues(t *testing.T) {
+	tests := []struct {
+		data     string
+		expected string
+	}{
+		{``, ``},
+		{`test`, `fPNKd`},
+		{`Hello, World!`, `>OwJh>}AQ;r@@Y?F`},
+	}
+
+	for _, test := range tests {
+		encoded := base91.Encode([]byte(test.data))
+		if encoded != test.expected {
+			t.Fatalf(`Encoding of '%s' is '%s', but should be '%s'`, test.data, encoded, test.expected)
+		}
+
+		decoded, err := base91.Decode(test.expected)
+		if err != nil {
+			t.Fatalf(`Decoding of '%s' failed: %v`, test.expected, err)
+		}
+
+		if string(decoded) != test.data {
+			t.Fatalf(`Decoding of '%s' is '%s', but should be '%s'`, test.expected, decoded, test.data)
+		}
+	}
+}
+
+// TestRoundTrip tests if random data of all lengths survives encoding and decoding.
+func TestRoundTrip(t *testing.T) {
+	for size := 0; size <= 256; size++ {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		// Runs of zero bytes and of 0xff bytes result in the extreme values of the bit groups.
+		if size&1 != 0 {
+			for i := 0; i < size/2; i++ {
+				data[i] = 0
+			}
+		} else {
+			for i := 0; i < size/2; i++ {
+				data[i] = 0xff
+			}
+		}
+
+		encoded := base91.Encode(data)
+		if len(encoded) > base91.MaxEncodedLen(size) {
+			t.Fatalf(`Encoding of size %d has length %d, which is larger than %d`, size, len(encoded), base91.MaxEncodedLen(size))
+		}
+
+		decoded, err := base91.Decode(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoding of size %d is '% 02x', but should be '% 02x'`, size, decoded, data)
+		}
+	}
+}
+
+// TestDecodeInvalid tests if characters outside of the alphabet are rejected.
+func TestDecodeInvalid(t *testing.T) {
+	for i, input := range []string{`fPN Kd`, `fPNKd'`, `-`, "fPNKd\n"} {
+		_, err := base91.Decode(input)
+		if !base91.IsErrCorruptInput(err) {
+			t.Fatalf(`Expected corrupt input error for '%s' in test %d, but got: %v`, input, i, err)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"encoding/ascii85"
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private variables ********
+
+// benchmarkSizes are the data sizes that the benchmarks use.
+// They cover a CURVE key, a typical message and a large buffer.
+var benchmarkSizes = []int{32, 1024, 64 * 1024}
+
+// ******** Benchmark functions ********
+
+// BenchmarkEncode benchmarks the Z85 encoding against the encodings of the standard library.
+func BenchmarkEncode(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		source := makeBenchmarkData(size)
+
+		b.Run(fmt.Sprintf(`z85/%d`, size), func(b *testing.B) {
+			destination := make([]byte, size/4*5)
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = z85.EncodeInto(destination, source)
+			}
+		})
+
+		b.Run(fmt.Sprintf(`base64/%d`, size), func(b *testing.B) {
+			destination := make([]byte, base64.StdEncoding.EncodedLen(size))
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				base64.StdEncoding.Encode(destination, source)
+			}
+		})
+
+		b.Run(fmt.Sprintf(`base32/%d`, size), func(b *testing.B) {
+			destination := make([]byte, base32.StdEncoding.EncodedLen(size))
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				base32.StdEncoding.Encode(destination, source)
+			}
+		})
+
+		b.Run(fmt.Sprintf(`ascii85/%d`, size), func(b *testing.B) {
+			destination := make([]byte, ascii85.MaxEncodedLen(size))
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				_ = ascii85.Encode(destination, source)
+			}
+		})
+	}
+}
+
+// BenchmarkDecode benchmarks the Z85 decoding against the decodings of the standard library.
+func BenchmarkDecode(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		source := makeBenchmarkData(size)
+		destination := make([]byte, size)
+
+		encoded := []byte(z85.MustEncode(source))
+		b.Run(fmt.Sprintf(`z85/%d`, size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = z85.StdEncoding.Decode(destination, encoded)
+			}
+		})
+
+		encoded = []byte(base64.StdEncoding.EncodeToString(source))
+		b.Run(fmt.Sprintf(`base64/%d`, size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				_, _ = base64.StdEncoding.Decode(destination, encoded)
+			}
+		})
+
+		encoded = []byte(base32.StdEncoding.EncodeToString(source))
+		b.Run(fmt.Sprintf(`base32/%d`, size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				_, _ = base32.StdEncoding.Decode(destination, encoded)
+			}
+		})
+
+		encoded = make([]byte, ascii85.MaxEncodedLen(size))
+		encoded = encoded[:ascii85.Encode(encoded, source)]
+		b.Run(fmt.Sprintf(`ascii85/%d`, size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for i := 0; i < b.N; i++ {
+				_, _, _ = ascii85.Decode(destination, encoded, true)
+			}
+		})
+	}
+}
+
+// BenchmarkDecodeTrusted benchmarks DecodeTrusted against Decode, which it has to be faster than on the portable code path.
+func BenchmarkDecodeTrusted(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		encoded := z85.MustEncode(makeBenchmarkData(size))
+
+		b.Run(fmt.Sprintf(`trusted/%d`, size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = z85.DecodeTrusted(encoded)
+			}
+		})
+
+		b.Run(fmt.Sprintf(`checked/%d`, size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = z85.Decode(encoded)
+			}
+		})
+	}
+}
+
+// BenchmarkFixedSizes benchmarks the sizes of UUIDs, keys and digests, which have unrolled code paths.
+func BenchmarkFixedSizes(b *testing.B) {
+	for _, size := range []int{4, 16, 32, 64} {
+		source := makeBenchmarkData(size)
+		encoded := []byte(z85.MustEncode(source))
+		destination := make([]byte, len(encoded))
+
+		b.Run(fmt.Sprintf(`encode/%d`, size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = z85.EncodeInto(destination, source)
+			}
+		})
+
+		b.Run(fmt.Sprintf(`decode/%d`, size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = z85.StdEncoding.Decode(destination, encoded)
+			}
+		})
+	}
+
+	encoded := z85.MustEncode(makeBenchmarkData(64))
+	b.Run(`Decode80`, func(b *testing.B) {
+		b.SetBytes(64)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = z85.Decode80(encoded)
+		}
+	})
+}
+
+// BenchmarkDecodeString benchmarks the Z85 decoding including the allocation of the result.
+func BenchmarkDecodeString(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		encoded := z85.MustEncode(makeBenchmarkData(size))
+
+		b.Run(fmt.Sprint(size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = z85.Decode(encoded)
+			}
+		})
+	}
+}
+
+// BenchmarkEncodeToString benchmarks the Z85 encoding including the allocation of the result.
+func BenchmarkEncodeToString(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		source := makeBenchmarkData(size)
+
+		b.Run(fmt.Sprint(size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = z85.Encode(source)
+			}
+		})
+	}
+}
+
+// ******** Private functions ********
+
+// makeBenchmarkData returns size bytes of data that contain all byte values.
+func makeBenchmarkData(size int) []byte {
+	result := make([]byte, size)
+	for i := range result {
+		result[i] = byte(i * 167)
+	}
+
+	return result
+}
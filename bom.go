@@ -0,0 +1,46 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"strings"
+)
+
+// ******** Private constants ********
+
+// utf8BOM is the UTF-8 encoding of the byte order mark.
+const utf8BOM = "\xef\xbb\xbf"
+
+// ******** Public functions ********
+
+// TrimBOM removes a leading UTF-8 byte order mark from source.
+// Some editors, mainly on Windows, write it at the start of text files.
+func TrimBOM(source string) string {
+	return strings.TrimPrefix(source, utf8BOM)
+}
@@ -0,0 +1,68 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"github.com/xformerfhs/z85"
+	"strings"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestTrimBOM tests if a leading byte order mark is removed.
+func TestTrimBOM(t *testing.T) {
+	decoded, err := z85.Decode(z85.TrimBOM("\xef\xbb\xbf" + encodedTheOne))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if len(decoded) != len(clearTheOne) {
+		t.Fatalf(`Decoding resulted in wrong length: %d`, len(decoded))
+	}
+
+	if z85.TrimBOM(encodedTheOne) != encodedTheOne {
+		t.Fatal(`String without byte order mark was changed`)
+	}
+}
+
+// TestDecodeControlCharacter tests if a control character results in a dedicated error.
+func TestDecodeControlCharacter(t *testing.T) {
+	_, err := z85.Decode("Hello\n1234")
+	if err == nil {
+		t.Fatal(`Control character did not result in an error`)
+	} else {
+		if !z85.IsErrControlCharacter(err) {
+			t.Fatalf(`Wrong error when decoding control character: '%v'`, err)
+		}
+		if !strings.HasSuffix(err.Error(), ` 5: '\n'`) {
+			t.Fatalf(`Correct error with wrong text: '%v'`, err)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Public types ********
+
+// EncodingBuilder collects the configuration of an Encoding and creates it with Build.
+// The configuration is only validated by Build.
+// An EncodingBuilder is not safe for concurrent use, but the encodings it builds are.
+type EncodingBuilder struct {
+	alphabet    string
+	padded      bool
+	wrap        int
+	maxLine     int
+	transparent []Range
+}
+
+// ******** Public creation functions ********
+
+// Builder returns a new EncodingBuilder that is initialized with the configuration of StdEncoding.
+// The configuration is set with fluent calls, e.g.
+//
+//	encoding, err := z85.Builder().Alphabet(alphabet).Wrap(76).Padding(true).Build()
+func Builder() *EncodingBuilder {
+	return &EncodingBuilder{alphabet: encodeTable}
+}
+
+// ******** Public functions ********
+
+// Alphabet sets the alphabet of the encoding.
+// It is validated by Build with the same rules as the ones of NewEncoding.
+func (b *EncodingBuilder) Alphabet(alphabet string) *EncodingBuilder {
+	b.alphabet = alphabet
+	return b
+}
+
+// Padding sets whether the encoding is padded like the ones returned by WithPadding.
+func (b *EncodingBuilder) Padding(padded bool) *EncodingBuilder {
+	b.padded = padded
+	return b
+}
+
+// Wrap sets the line length of the encoding like WithWrap.
+// A value of 0 or less switches wrapping off.
+func (b *EncodingBuilder) Wrap(n int) *EncodingBuilder {
+	b.wrap = n
+	return b
+}
+
+// MaxLineLength sets the maximum line length that the stream decoders accept like WithMaxLineLength.
+// A value of 0 or less switches the limit off.
+func (b *EncodingBuilder) MaxLineLength(n int) *EncodingBuilder {
+	b.maxLine = n
+	return b
+}
+
+// Transparent sets the ranges of the characters that the decoders skip like WithTransparent.
+// No ranges switch the skipping off.
+func (b *EncodingBuilder) Transparent(ranges []Range) *EncodingBuilder {
+	b.transparent = ranges
+	return b
+}
+
+// Build validates the configuration and creates a new Encoding.
+// Later changes to the builder do not affect the created Encoding.
+func (b *EncodingBuilder) Build() (*Encoding, error) {
+	result, err := NewEncoding(b.alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	result.padded = b.padded
+	result.wrap = max(b.wrap, 0)
+	result.maxLine = max(b.maxLine, 0)
+
+	return result.WithTransparent(b.transparent), nil
+}
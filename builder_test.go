@@ -0,0 +1,95 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestBuilderDefault tests if the default builder creates an encoding that is identical to StdEncoding.
+func TestBuilderDefault(t *testing.T) {
+	encoding, err := z85.Builder().Build()
+	if err != nil {
+		t.Fatalf(`Build failed: %v`, err)
+	}
+
+	if encoding.Alphabet() != z85.StdEncoding.Alphabet() || encoding.Padded() {
+		t.Fatal(`Default builder does not create the standard encoding`)
+	}
+
+	encoded, _ := encoding.EncodeToString(clearTheOne)
+	if encoded != encodedTheOne {
+		t.Fatalf(`Encoding is '%s', but should be '%s'`, encoded, encodedTheOne)
+	}
+}
+
+// TestBuilderConfiguration tests if the configuration is applied.
+func TestBuilderConfiguration(t *testing.T) {
+	encoding, err := z85.Builder().Alphabet(reversedAlphabet).Padding(true).Build()
+	if err != nil {
+		t.Fatalf(`Build failed: %v`, err)
+	}
+
+	if encoding.Alphabet() != reversedAlphabet {
+		t.Fatalf(`Alphabet is '%s', but should be '%s'`, encoding.Alphabet(), reversedAlphabet)
+	}
+
+	if !encoding.Padded() {
+		t.Fatal(`Encoding is not padded`)
+	}
+
+	encoding, _ = z85.Builder().Transparent(z85.InvisibleRanges).Build()
+	if len(encoding.Transparent()) != len(z85.InvisibleRanges) {
+		t.Fatalf(`Transparent ranges are %v, but should be %v`, encoding.Transparent(), z85.InvisibleRanges)
+	}
+}
+
+// TestBuilderImmutable tests if later changes to the builder do not affect a built encoding.
+func TestBuilderImmutable(t *testing.T) {
+	builder := z85.Builder().Padding(true)
+	encoding, _ := builder.Build()
+
+	builder.Padding(false).Alphabet(reversedAlphabet)
+
+	if !encoding.Padded() || encoding.Alphabet() != z85.StdEncoding.Alphabet() {
+		t.Fatal(`Built encoding was changed by the builder`)
+	}
+}
+
+// TestBuilderInvalidAlphabet tests if an invalid alphabet is rejected by Build.
+func TestBuilderInvalidAlphabet(t *testing.T) {
+	_, err := z85.Builder().Alphabet(`abc`).Build()
+	if !z85.IsErrInvalidAlphabet(err) {
+		t.Fatalf(`Invalid alphabet not detected: %v`, err)
+	}
+}
@@ -0,0 +1,108 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"sync"
+)
+
+// ******** Public types ********
+
+// DecodeCache decodes Z85 strings and returns the same result slice for identical inputs.
+// This saves memory when the same values are decoded over and over again.
+//
+// The returned slices are shared between all callers and must not be modified.
+// A DecodeCache is safe for concurrent use.
+type DecodeCache struct {
+	lock       sync.RWMutex
+	entries    map[string][]byte
+	maxEntries int
+}
+
+// ******** Public creation functions ********
+
+// NewDecodeCache creates a new DecodeCache that holds at most maxEntries results.
+// When the cache is full, new inputs are decoded without being cached.
+// A maxEntries value less than or equal to 0 means that the number of entries is not limited.
+func NewDecodeCache(maxEntries int) *DecodeCache {
+	return &DecodeCache{
+		entries:    make(map[string][]byte),
+		maxEntries: maxEntries,
+	}
+}
+
+// ******** Public functions ********
+
+// Decode decodes a Z85 string into a byte slice that is shared with all other callers
+// that decode the same string.
+// The returned slice must not be modified.
+func (c *DecodeCache) Decode(source string) ([]byte, error) {
+	c.lock.RLock()
+	result, found := c.entries[source]
+	c.lock.RUnlock()
+
+	if found {
+		return result, nil
+	}
+
+	result, err := Decode(source)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Another goroutine may have decoded the same string in the meantime.
+	if cached, found := c.entries[source]; found {
+		return cached, nil
+	}
+
+	if c.maxEntries <= 0 || len(c.entries) < c.maxEntries {
+		c.entries[source] = result
+	}
+
+	return result, nil
+}
+
+// Len returns the number of cached results.
+func (c *DecodeCache) Len() int {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return len(c.entries)
+}
+
+// Clear removes all cached results.
+func (c *DecodeCache) Clear() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	clear(c.entries)
+}
@@ -0,0 +1,105 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	"github.com/xformerfhs/z85"
+	"strings"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestDecodeCacheShares tests if identical inputs return the same slice.
+func TestDecodeCacheShares(t *testing.T) {
+	cache := z85.NewDecodeCache(0)
+
+	first, err := cache.Decode(encodedTheOne)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(first, clearTheOne) {
+		t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, first)
+	}
+
+	// Build an identical string that does not share memory with encodedTheOne.
+	var second []byte
+	second, err = cache.Decode(strings.Clone(encodedTheOne))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if &first[0] != &second[0] {
+		t.Fatal(`Identical inputs did not return the shared slice`)
+	}
+
+	if cache.Len() != 1 {
+		t.Fatalf(`Cache has %d entries instead of 1`, cache.Len())
+	}
+
+	cache.Clear()
+	if cache.Len() != 0 {
+		t.Fatalf(`Cleared cache has %d entries`, cache.Len())
+	}
+}
+
+// TestDecodeCacheLimit tests if the cache does not grow beyond its limit.
+func TestDecodeCacheLimit(t *testing.T) {
+	cache := z85.NewDecodeCache(1)
+
+	_, _ = cache.Decode(encodedTheOne)
+	result, err := cache.Decode(`00000`)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(result, []byte{0, 0, 0, 0}) {
+		t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, result)
+	}
+
+	if cache.Len() != 1 {
+		t.Fatalf(`Cache has %d entries instead of 1`, cache.Len())
+	}
+}
+
+// TestDecodeCacheError tests if errors are returned and not cached.
+func TestDecodeCacheError(t *testing.T) {
+	cache := z85.NewDecodeCache(0)
+
+	_, err := cache.Decode(`123~5`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Wrong error when decoding invalid character: '%v'`, err)
+	}
+
+	if cache.Len() != 0 {
+		t.Fatalf(`Error was cached`)
+	}
+}
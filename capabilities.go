@@ -0,0 +1,93 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Public constants ********
+
+// Version is the version of this package.
+const Version = `1.2.0`
+
+// AccelerationScalar is the name of the portable implementation in pure Go.
+const AccelerationScalar = `scalar`
+
+// AccelerationAVX2 is the name of the implementation that encodes with AVX2 instructions on amd64 processors.
+const AccelerationAVX2 = `avx2`
+
+// ******** Private variables ********
+
+// acceleration is the name of the code path that is used for encoding and decoding.
+var acceleration = selectAcceleration()
+
+// ******** Public types ********
+
+// CapabilityInfo describes what this build of the package is able to do.
+type CapabilityInfo struct {
+	// Version is the version of the package.
+	Version string
+	// Acceleration is the name of the code path that is used for encoding and decoding.
+	Acceleration string
+	// Variants contains the names of the encoding variants that are compiled in.
+	Variants []string
+	// SIMDThreshold is the size of the data in bytes from which on the accelerated code path is used.
+	SIMDThreshold int
+	// ParallelThreshold is the size of the data in bytes from which on it is encoded in parallel.
+	ParallelThreshold int
+}
+
+// ******** Public functions ********
+
+// Capabilities returns information about this build of the package.
+// It is meant to be included in bug reports and runtime diagnostics.
+func Capabilities() CapabilityInfo {
+	specs := Spec()
+	variants := make([]string, len(specs))
+	for i, spec := range specs {
+		variants[i] = spec.Name
+	}
+
+	return CapabilityInfo{
+		Version:           Version,
+		Acceleration:      acceleration,
+		Variants:          variants,
+		SIMDThreshold:     simdThreshold,
+		ParallelThreshold: parallelThreshold,
+	}
+}
+
+// RequireAcceleration returns an ErrAccelerationUnavailable error, if encoding and decoding use
+// the portable scalar implementation instead of an accelerated one.
+// Performance-critical deployments can call it at startup to detect builds
+// that silently fall back to the scalar implementation.
+func RequireAcceleration() error {
+	if acceleration == AccelerationScalar {
+		return newAccelerationUnavailableError(acceleration)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"github.com/xformerfhs/z85"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestCapabilities tests if the capabilities contain the expected information.
+func TestCapabilities(t *testing.T) {
+	capabilities := z85.Capabilities()
+
+	if capabilities.Version != z85.Version {
+		t.Fatalf(`Wrong version: '%s'`, capabilities.Version)
+	}
+
+	if capabilities.Acceleration != z85.AccelerationScalar && capabilities.Acceleration != z85.AccelerationAVX2 {
+		t.Fatalf(`Wrong acceleration: '%s'`, capabilities.Acceleration)
+	}
+
+	if len(capabilities.Variants) == 0 || capabilities.Variants[0] != `Z85` {
+		t.Fatalf(`Wrong variants: %v`, capabilities.Variants)
+	}
+}
+
+// TestRequireAcceleration tests if RequireAcceleration reports the scalar implementation.
+func TestRequireAcceleration(t *testing.T) {
+	err := z85.RequireAcceleration()
+
+	if z85.Capabilities().Acceleration == z85.AccelerationScalar {
+		if !z85.IsErrAccelerationUnavailable(err) {
+			t.Fatalf(`Expected acceleration unavailable error, but got: %v`, err)
+		}
+	} else if err != nil {
+		t.Fatalf(`Acceleration is available, but got: %v`, err)
+	}
+}
+
+// TestForceScalar tests if the environment variable forces the scalar implementation.
+// The test runs itself in a child process with the variable set.
+func TestForceScalar(t *testing.T) {
+	if os.Getenv(z85.EnvAcceleration) == z85.AccelerationScalar {
+		if z85.Capabilities().Acceleration != z85.AccelerationScalar {
+			t.Fatalf(`Acceleration is '%s', but should be forced to '%s'`,
+				z85.Capabilities().Acceleration, z85.AccelerationScalar)
+		}
+
+		return
+	}
+
+	cmd := exec.Command(os.Args[0], `-test.run=^TestForceScalar$`)
+	cmd.Env = append(os.Environ(), z85.EnvAcceleration+`=`+z85.AccelerationScalar)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf(`Child process failed: %v: %s`, err, output)
+	}
+}
+
+// TestThresholdOverride tests if the environment variables set the thresholds
+// and if the results do not depend on them.
+// The test runs itself and the tests of the accelerated code path in a child process with the variables set.
+func TestThresholdOverride(t *testing.T) {
+	if os.Getenv(z85.EnvSIMDThreshold) == `1000` {
+		capabilities := z85.Capabilities()
+		if capabilities.SIMDThreshold != 1000 || capabilities.ParallelThreshold != 4096 {
+			t.Fatalf(`Thresholds are %d and %d, but should be 1000 and 4096`,
+				capabilities.SIMDThreshold, capabilities.ParallelThreshold)
+		}
+
+		return
+	}
+
+	if z85.Capabilities().SIMDThreshold < 0 || z85.Capabilities().ParallelThreshold <= 0 {
+		t.Fatalf(`Invalid thresholds: %+v`, z85.Capabilities())
+	}
+
+	cmd := exec.Command(os.Args[0], `-test.run=^(TestThresholdOverride|TestEncodeAccelerated|TestDecodeAccelerated)$`)
+	cmd.Env = append(os.Environ(), z85.EnvSIMDThreshold+`=1000`, z85.EnvParallelThreshold+`=4096`)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf(`Child process failed: %v: %s`, err, output)
+	}
+}
@@ -0,0 +1,91 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"hash/crc32"
+)
+
+// ******** Public functions ********
+
+// EncodeCheck encodes a byte slice into a Z85 encoded string and appends the CRC-32 checksum of the data
+// as one additional chunk of 5 characters.
+// The checksum detects data that has been corrupted, e.g. by copying it by hand.
+// The length of the slice must be a multiple of 4.
+func EncodeCheck(source []byte) (string, error) {
+	sourceLen := len(source)
+	encodedLen, err := encodedLength(sourceLen)
+	if err != nil {
+		return ``, err
+	}
+
+	// The checksum is one additional chunk.
+	if sourceLen > MaxEncodeInputLen-byteChunkSize {
+		return ``, newInputTooLargeError(MaxEncodeInputLen - byteChunkSize)
+	}
+
+	result := make([]byte, encodedLen+encodedChunkSize)
+	StdEncoding.encodeChunks(result, source)
+	StdEncoding.encodeChunk(result[encodedLen:], crc32.ChecksumIEEE(source))
+
+	return string(result), nil
+}
+
+// DecodeCheck decodes a Z85 string that was created by EncodeCheck.
+// It returns an ErrChecksumMismatch error, if the checksum does not match the decoded data.
+// The length of the string must be a multiple of 5.
+func DecodeCheck(source string) ([]byte, error) {
+	if len(source) < encodedChunkSize {
+		return nil, newInvalidLengthError(encodedChunkSize, 0)
+	}
+
+	decodedLen, err := decodedLength(len(source))
+	if err != nil {
+		return nil, err
+	}
+
+	dataLen := decodedLen - byteChunkSize
+	checksumPosition := len(source) - encodedChunkSize
+	result := make([]byte, dataLen)
+	err = decodeChunks(StdEncoding, result, source[:checksumPosition], 0)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := StdEncoding.decodeChunkValue(source[checksumPosition:], uint(checksumPosition))
+	if err != nil {
+		return nil, err
+	}
+
+	if crc32.ChecksumIEEE(result) != checksum {
+		return nil, newChecksumMismatchError(checksum, dataLen)
+	}
+
+	return result, nil
+}
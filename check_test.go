@@ -0,0 +1,132 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private variables ********
+
+// checkedTheOne is the checked encoding of clearTheOne.
+var checkedTheOne = encodedTheOne + `>P<I{`
+
+// ******** Test functions ********
+
+// TestCheckKnownValue tests the checked encoding of a known value.
+func TestCheckKnownValue(t *testing.T) {
+	encoded, err := z85.EncodeCheck(clearTheOne)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if encoded != checkedTheOne {
+		t.Fatalf(`Encoding is '%s', but should be '%s'`, encoded, checkedTheOne)
+	}
+
+	decoded, err := z85.DecodeCheck(encoded)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, clearTheOne) {
+		t.Fatalf(`Decoded data is '% 02x', but should be '% 02x'`, decoded, clearTheOne)
+	}
+}
+
+// TestCheckRoundTrip tests if data survives the checked encoding.
+func TestCheckRoundTrip(t *testing.T) {
+	for size := 0; size <= maxSliceSize; size += 4 {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		encoded, err := z85.EncodeCheck(data)
+		if err != nil {
+			t.Fatalf(`Encoding of size %d failed: %v`, size, err)
+		}
+
+		decoded, err := z85.DecodeCheck(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoded data of size %d is '% 02x', but should be '% 02x'`, size, decoded, data)
+		}
+	}
+}
+
+// TestCheckCorrupted tests if a corrupted character is detected.
+func TestCheckCorrupted(t *testing.T) {
+	for i := 0; i < len(checkedTheOne); i++ {
+		corrupted := []byte(checkedTheOne)
+		if corrupted[i] == '0' {
+			corrupted[i] = '1'
+		} else {
+			corrupted[i] = '0'
+		}
+
+		_, err := z85.DecodeCheck(string(corrupted))
+		if !z85.IsErrChecksumMismatch(err) {
+			t.Fatalf(`Corruption at position %d not detected: %v`, i, err)
+		}
+
+		var codecErr *z85.CodecError
+		if !errors.As(err, &codecErr) {
+			t.Fatal(`Error is not a CodecError`)
+		}
+
+		checkCodecError(t, codecErr, z85.KindChecksumMismatch, 8, 10, 0)
+	}
+}
+
+// TestCheckInvalid tests if invalid input is rejected.
+func TestCheckInvalid(t *testing.T) {
+	_, err := z85.EncodeCheck(clearTheOne[:7])
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Invalid length not detected: %v`, err)
+	}
+
+	for _, source := range []string{``, `Hell`, encodedTheOne + `>P<I`} {
+		_, err = z85.DecodeCheck(source)
+		if !z85.IsErrInvalidLength(err) {
+			t.Fatalf(`Invalid length of '%s' not detected: %v`, source, err)
+		}
+	}
+
+	_, err = z85.DecodeCheck(encodedTheOne + `>P<I~`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Invalid byte not detected: %v`, err)
+	}
+}
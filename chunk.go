@@ -0,0 +1,63 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Private constants ********
+
+// invalidMarker is the bit that is set in the decoded value of an invalid character.
+// All valid decoded values are less than 0x80, and ivEc has this bit set.
+const invalidMarker = 0x80
+
+// ******** Public functions ********
+
+// EncodeChunkString encodes one 32 bit value into a Z85 string of 5 characters.
+// The value is interpreted as 4 bytes in big-endian order.
+func EncodeChunkString(value uint32) string {
+	var result [encodedChunkSize]byte
+	StdEncoding.encodeChunk(result[:], value)
+
+	return string(result[:])
+}
+
+// MustDecodeChunk decodes a Z85 string of 5 characters into a 32 bit value.
+// It is meant for hot paths where the input is known to be valid.
+//
+// It panics, if the string does not have a length of 5, contains an invalid character or exceeds 32 bits.
+func MustDecodeChunk(source string) uint32 {
+	if len(source) != encodedChunkSize {
+		panic(newUnexpectedLengthError(encodedChunkSize, uint(len(source))))
+	}
+
+	value, err := StdEncoding.decodeChunkValue(source, 0)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
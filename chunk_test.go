@@ -0,0 +1,80 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"github.com/xformerfhs/z85"
+	"math/rand"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestChunkRoundTrip tests if EncodeChunkString and MustDecodeChunk are inverse to each other.
+func TestChunkRoundTrip(t *testing.T) {
+	values := []uint32{0, 1, 84, 85, 0xffffffff}
+	for i := 0; i < iterationCount; i++ {
+		values = append(values, rand.Uint32())
+	}
+
+	for _, value := range values {
+		encoded := z85.EncodeChunkString(value)
+		if len(encoded) != 5 {
+			t.Fatalf(`Encoding of %08x has wrong length: '%s'`, value, encoded)
+		}
+
+		decoded := z85.MustDecodeChunk(encoded)
+		if decoded != value {
+			t.Fatalf(`Round trip of %08x resulted in %08x`, value, decoded)
+		}
+	}
+}
+
+// TestEncodeChunkStringTheOne tests if the chunk encoding matches the encoding of the test case.
+func TestEncodeChunkStringTheOne(t *testing.T) {
+	encoded := z85.EncodeChunkString(0x864fd26f)
+	if encoded != encodedTheOne[:5] {
+		t.Fatalf(`Encoding did not result in '%s', but '%s'`, encodedTheOne[:5], encoded)
+	}
+}
+
+// TestMustDecodeChunkPanics tests if MustDecodeChunk panics on invalid input.
+func TestMustDecodeChunkPanics(t *testing.T) {
+	for _, source := range []string{`1234`, `123456`, `12 45`} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf(`Invalid input '%s' did not panic`, source)
+				}
+			}()
+
+			_ = z85.MustDecodeChunk(source)
+		}()
+	}
+}
@@ -0,0 +1,715 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdh"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/xformerfhs/z85/keys85"
+)
+
+// ******** Private constants ********
+
+// secretCertSuffix is the suffix of the name of the secret certificate file, which is the one of czmq.
+const secretCertSuffix = `_secret`
+
+// publicFileMode is the permission of the file that contains a public certificate.
+const publicFileMode = 0o644
+
+// zplIndent is the indentation of one level in the ZPL format.
+const zplIndent = `    `
+
+// Names of the sections and keys in a certificate.
+const (
+	sectionMetadata = `metadata`
+	sectionCurve    = `curve`
+	namePublicKey   = `public-key`
+	nameSecretKey   = `secret-key`
+)
+
+// certTimeFormat is the format of the time in the header of a certificate.
+const certTimeFormat = `2006-01-02 15:04:05`
+
+// fingerprintPrefix is the prefix of the fingerprint of a public key, which names the digest.
+const fingerprintPrefix = `SHA256:`
+
+// ******** Private types ********
+
+// certField is a metadata field of a certificate.
+type certField struct {
+	name  string
+	value string
+}
+
+// certificate is a CurveZMQ certificate in the format of czmq.
+// The keys are Z85 strings and the secret key is empty in a public certificate.
+type certificate struct {
+	metadata []certField
+	public   string
+	secret   string
+}
+
+// metadataFlag collects the metadata fields given on the command line.
+type metadataFlag []certField
+
+// ******** Private functions ********
+
+// runCert executes the cert command, which creates, shows and converts CurveZMQ certificates.
+func runCert(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	subcommands := map[string]command{
+		`create`:      runCertCreate,
+		`show`:        runCertShow,
+		`fingerprint`: runCertFingerprint,
+		`convert`:     runCertConvert,
+		`import`:      runCertImport,
+	}
+
+	if len(args) > 0 {
+		if f, found := subcommands[args[0]]; found {
+			return f(args[1:], stdin, stdout, stderr)
+		}
+	}
+
+	fmt.Fprintf(stderr, "Usage: %s cert create|show|fingerprint|convert|import [arguments]\n\n", programName)
+	fmt.Fprintln(stderr, `Creates, shows and converts CurveZMQ certificate files in the format of czmq.`)
+	fmt.Fprintln(stderr, `The commands show and fingerprint also accept a directory of certificate files.`)
+
+	if len(args) > 0 && (args[0] == `-h` || args[0] == `-help` || args[0] == `--help`) {
+		return exitOK
+	}
+
+	return exitUsage
+}
+
+// runCertCreate executes the cert create command, which creates a new key pair and its certificate files.
+func runCertCreate(args []string, _ io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` cert create`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s cert create [-m name=value]... file\n\n", programName)
+		fmt.Fprintf(stderr, "Creates a new key pair and writes the public certificate to file and the secret one to file%s.\n", secretCertSuffix)
+		fmt.Fprintln(stderr, `Only the owner can read the secret certificate. Existing files are not overwritten.`)
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	var metadata metadataFlag
+	flags.Var(&metadata, `m`, `add the metadata field `+"`name=value`"+` (can be repeated)`)
+
+	if code, ok := parseWithOneFile(flags, args, stderr); !ok {
+		return code
+	}
+
+	keys, err := generateKeyPair()
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	public := keys85.Encode32(keys.public)
+	secret := keys85.Encode32(keys.secret)
+	cert := &certificate{metadata: metadata, public: string(public[:]), secret: string(secret[:])}
+
+	if err = writeCertificates(flags.Arg(0), cert, time.Now()); err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	fmt.Fprintf(stdout, "%s = \"%s\"\n", namePublicKey, cert.public)
+	return exitOK
+}
+
+// runCertShow executes the cert show command, which prints the keys and the metadata of certificates.
+func runCertShow(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` cert show`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s cert show file|directory\n\n", programName)
+		fmt.Fprintln(stderr, `Prints the keys and the metadata of a certificate file, or of each one in a directory, and checks the keys.`)
+		fmt.Fprintln(stderr, `The exit code is 1, if the keys are invalid or do not belong together.`)
+	}
+
+	if code, ok := parseWithOneFile(flags, args, stderr); !ok {
+		return code
+	}
+
+	return forEachCertificate(flags.Arg(0), stdin, stderr, func(name string, cert *certificate, many bool, first bool) error {
+		if many {
+			if !first {
+				fmt.Fprintln(stdout)
+			}
+
+			fmt.Fprintf(stdout, "file: %s\n", name)
+		}
+
+		kind := `public`
+		if cert.secret != `` {
+			kind = `secret`
+		}
+
+		fmt.Fprintf(stdout, "certificate: %s\n", kind)
+		fmt.Fprintf(stdout, "%s: %s\n", namePublicKey, cert.public)
+		if cert.secret != `` {
+			fmt.Fprintf(stdout, "%s: %s\n", nameSecretKey, cert.secret)
+		}
+
+		for _, field := range cert.metadata {
+			fmt.Fprintf(stdout, "%s: %s\n", field.name, field.value)
+		}
+
+		return cert.check()
+	})
+}
+
+// runCertFingerprint executes the cert fingerprint command, which prints the fingerprints of the public keys of certificates.
+func runCertFingerprint(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` cert fingerprint`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s cert fingerprint file|directory\n\n", programName)
+		fmt.Fprintln(stderr, `Prints the SHA-256 fingerprint of the public key and the name of a certificate file, or of each one in a directory.`)
+		fmt.Fprintln(stderr, `Public and secret certificates of the same key pair have the same fingerprint.`)
+	}
+
+	if code, ok := parseWithOneFile(flags, args, stderr); !ok {
+		return code
+	}
+
+	return forEachCertificate(flags.Arg(0), stdin, stderr, func(name string, cert *certificate, _ bool, _ bool) error {
+		fingerprint, err := cert.fingerprint()
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(stdout, "%s  %s\n", fingerprint, name)
+		return nil
+	})
+}
+
+// runCertConvert executes the cert convert command, which prints the keys of a certificate in another format
+// or writes them into plain key files.
+func runCertConvert(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` cert convert`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s cert convert -to format [-public file] [-secret file] cert\n\n", programName)
+		fmt.Fprintln(stderr, `Prints the keys of a certificate file in another format.`)
+		fmt.Fprintln(stderr, `With -public or -secret the keys are written into new plain key files instead, which may also be binary.`)
+		fmt.Fprintf(stderr, "Formats: %s\n\n", strings.Join(formatNames, `, `))
+		flags.PrintDefaults()
+	}
+
+	to := flags.String(`to`, ``, `the `+"`format`"+` of the keys`)
+	publicName := flags.String(`public`, ``, `write the public key into the new `+"`file`")
+	secretName := flags.String(`secret`, ``, `write the secret key into the new `+"`file`"+`, which only the owner can read`)
+
+	if code, ok := parseWithOneFile(flags, args, stderr); !ok {
+		return code
+	}
+
+	toFiles := *publicName != `` || *secretName != ``
+	if !isFormat(*to) || (*to == formatBinary && !toFiles) {
+		fmt.Fprintf(stderr, "%s: unknown format '%s'\n", programName, *to)
+		flags.Usage()
+		return exitUsage
+	}
+
+	cert, err := readCertificate(flags.Arg(0), stdin)
+	if err == nil {
+		err = cert.check()
+	}
+
+	if err == nil && *secretName != `` && cert.secret == `` {
+		err = fmt.Errorf(`%s: certificate does not contain a %s`, flags.Arg(0), nameSecretKey)
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	keys := []struct {
+		name    string
+		encoded string
+		file    string
+		mode    os.FileMode
+	}{
+		{namePublicKey, cert.public, *publicName, publicFileMode},
+		{nameSecretKey, cert.secret, *secretName, secretFileMode},
+	}
+
+	var written []string
+	for _, key := range keys {
+		if key.encoded == `` || (toFiles && key.file == ``) {
+			continue
+		}
+
+		decoded, _ := keys85.Decode32(key.encoded)
+
+		var converted bytes.Buffer
+		if err = convert(&converted, bytes.NewReader(decoded[:]), formatBinary, *to); err == nil && toFiles {
+			err = writeNewFile(key.file, converted.Bytes(), key.mode)
+		}
+
+		if err != nil {
+			for _, name := range written {
+				_ = os.Remove(name)
+			}
+
+			fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+			return exitError
+		}
+
+		if toFiles {
+			written = append(written, key.file)
+		} else {
+			fmt.Fprintf(stdout, "%s = %s", key.name, converted.Bytes())
+		}
+	}
+
+	return exitOK
+}
+
+// runCertImport executes the cert import command, which creates certificate files from plain key files.
+func runCertImport(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` cert import`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s cert import [-from format] [-m name=value]... [-public file] [-secret file] cert\n\n", programName)
+		fmt.Fprintln(stderr, `Reads the keys from plain key files and writes the public certificate to cert.`)
+		fmt.Fprintf(stderr, "With -secret the secret certificate is written to cert%s, too, and the public key may be omitted.\n", secretCertSuffix)
+		fmt.Fprintf(stderr, "Formats: %s\n\n", strings.Join(formatNames, `, `))
+		flags.PrintDefaults()
+	}
+
+	from := flags.String(`from`, formatZ85, `the `+"`format`"+` of the key files`)
+	publicName := flags.String(`public`, ``, `read the public key from `+"`file`")
+	secretName := flags.String(`secret`, ``, `read the secret key from `+"`file`")
+
+	var metadata metadataFlag
+	flags.Var(&metadata, `m`, `add the metadata field `+"`name=value`"+` (can be repeated)`)
+
+	if code, ok := parseWithOneFile(flags, args, stderr); !ok {
+		return code
+	}
+
+	if !isFormat(*from) {
+		fmt.Fprintf(stderr, "%s: unknown format '%s'\n", programName, *from)
+		flags.Usage()
+		return exitUsage
+	}
+
+	if *publicName == `` && *secretName == `` {
+		fmt.Fprintf(stderr, "%s: expected a public or a secret key file\n", programName)
+		flags.Usage()
+		return exitUsage
+	}
+
+	cert, err := importCertificate(*publicName, *secretName, *from, stdin)
+	if err == nil {
+		cert.metadata = metadata
+		err = writeCertificates(flags.Arg(0), cert, time.Now())
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	fmt.Fprintf(stdout, "%s = \"%s\"\n", namePublicKey, cert.public)
+	return exitOK
+}
+
+// importCertificate builds a certificate from the plain key files publicName and secretName in the format from.
+// Either name may be empty and the public key is derived from the secret key, if it is missing.
+func importCertificate(publicName string, secretName string, from string, stdin io.Reader) (*certificate, error) {
+	cert := &certificate{}
+
+	if secretName != `` {
+		secret, err := readKeyFile(secretName, from, stdin)
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := ecdh.X25519().NewPrivateKey(secret[:])
+		if err != nil {
+			return nil, fmt.Errorf(`%s: %w`, secretName, err)
+		}
+
+		encoded := keys85.Encode32(secret)
+		cert.secret = string(encoded[:])
+		encoded = keys85.Encode32([32]byte(key.PublicKey().Bytes()))
+		cert.public = string(encoded[:])
+	}
+
+	if publicName != `` {
+		public, err := readKeyFile(publicName, from, stdin)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded := keys85.Encode32(public)
+		if cert.secret != `` && cert.public != string(encoded[:]) {
+			return nil, fmt.Errorf(`%s does not belong to the %s`, nameSecretKey, namePublicKey)
+		}
+
+		cert.public = string(encoded[:])
+	}
+
+	return cert, nil
+}
+
+// readKeyFile reads a key of 32 bytes in the format from from the file name, or from stdin, if name is "-".
+func readKeyFile(name string, from string, stdin io.Reader) ([32]byte, error) {
+	var result [32]byte
+
+	data, err := readInput(name, stdin)
+	if err != nil {
+		return result, err
+	}
+
+	key, err := io.ReadAll(newFormatDecoder(from, bytes.NewReader(data)))
+	if err != nil {
+		return result, fmt.Errorf(`%s: %w`, name, err)
+	}
+
+	if len(key) != len(result) {
+		return result, fmt.Errorf(`%s: key has %d bytes instead of %d`, name, len(key), len(result))
+	}
+
+	copy(result[:], key)
+	return result, nil
+}
+
+// forEachCertificate calls f for the certificate in the file name, or for each one in the directory name.
+// many is true for a directory and first is true for its first certificate.
+// Errors are reported with the name of the file and do not stop the processing,
+// but the exit code is 1, if a certificate could not be read or f failed.
+func forEachCertificate(name string, stdin io.Reader, stderr io.Writer, f func(name string, cert *certificate, many bool, first bool) error) int {
+	names, many, err := certificateFiles(name)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	result := exitOK
+	for i, certName := range names {
+		cert, err := readCertificate(certName, stdin)
+		if err == nil {
+			if err = f(certName, cert, many, i == 0); err != nil {
+				err = fmt.Errorf(`%s: %w`, certName, err)
+			}
+		}
+
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+			result = exitError
+		}
+	}
+
+	return result
+}
+
+// certificateFiles returns the name, if it is a file, or the sorted names of the regular files in the directory name,
+// which are not hidden. The flag is true for a directory.
+func certificateFiles(name string) ([]string, bool, error) {
+	if name == stdioName {
+		return []string{name}, false, nil
+	}
+
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !info.IsDir() {
+		return []string{name}, false, nil
+	}
+
+	entries, err := os.ReadDir(name)
+	if err != nil {
+		return nil, true, err
+	}
+
+	var result []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && !strings.HasPrefix(entry.Name(), `.`) {
+			result = append(result, filepath.Join(name, entry.Name()))
+		}
+	}
+
+	return result, true, nil
+}
+
+// parseWithOneFile parses args with flags and checks that exactly one file name follows.
+// It returns the exit code and false, if the arguments are invalid or only the help has been requested.
+func parseWithOneFile(flags *flag.FlagSet, args []string, stderr io.Writer) (int, bool) {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK, false
+		}
+
+		return exitUsage, false
+	}
+
+	if flags.NArg() != 1 {
+		fmt.Fprintf(stderr, "%s: expected one file, but got %d arguments\n", programName, flags.NArg())
+		flags.Usage()
+		return exitUsage, false
+	}
+
+	return exitOK, true
+}
+
+// writeCertificates writes the public certificate of cert to the file name
+// and the secret certificate, if cert has a secret key, to the file name with the secret suffix.
+// The secret certificate can only be read by the owner and no existing file is overwritten.
+func writeCertificates(name string, cert *certificate, now time.Time) error {
+	var public, secret bytes.Buffer
+	cert.write(&public, false, now)
+	cert.write(&secret, true, now)
+
+	if err := writeNewFile(name, public.Bytes(), publicFileMode); err != nil {
+		return err
+	}
+
+	if cert.secret == `` {
+		return nil
+	}
+
+	if err := writeNewFile(name+secretCertSuffix, secret.Bytes(), secretFileMode); err != nil {
+		_ = os.Remove(name)
+		return err
+	}
+
+	return nil
+}
+
+// readCertificate reads the certificate from the file name, or from stdin, if name is "-".
+func readCertificate(name string, stdin io.Reader) (*certificate, error) {
+	text, err := readInput(name, stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := parseCertificate(text)
+	if err != nil {
+		return nil, fmt.Errorf(`%s: %w`, name, err)
+	}
+
+	return cert, nil
+}
+
+// parseCertificate parses a certificate in the ZPL format.
+// Sections other than the metadata and the keys are ignored.
+func parseCertificate(text []byte) (*certificate, error) {
+	cert := &certificate{}
+
+	section := ``
+	scanner := bufio.NewScanner(bytes.NewReader(text))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		content := strings.TrimLeft(line, ` `)
+		if content == `` || content[0] == '#' {
+			continue
+		}
+
+		indent := len(line) - len(content)
+		if indent%len(zplIndent) != 0 {
+			return nil, fmt.Errorf(`line %d: indentation is not a multiple of %d spaces`, lineNumber, len(zplIndent))
+		}
+
+		name, value, err := parseZPLLine(content)
+		if err != nil {
+			return nil, fmt.Errorf(`line %d: %w`, lineNumber, err)
+		}
+
+		switch indent / len(zplIndent) {
+		case 0:
+			section = name
+		case 1:
+			cert.set(section, name, value)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if cert.public == `` {
+		return nil, fmt.Errorf(`certificate does not contain a %s`, namePublicKey)
+	}
+
+	return cert, nil
+}
+
+// parseZPLLine splits the content of a ZPL line into the name and the value, which may be quoted.
+func parseZPLLine(content string) (string, string, error) {
+	name, value, found := strings.Cut(content, `=`)
+	name = strings.TrimSpace(name)
+	if name == `` {
+		return ``, ``, errors.New(`name is missing`)
+	}
+
+	if !found {
+		return name, ``, nil
+	}
+
+	value = strings.TrimSpace(value)
+	if value == `` || (value[0] != '"' && value[0] != '\'') {
+		// An unquoted value ends at a comment.
+		value, _, _ = strings.Cut(value, `#`)
+		return name, strings.TrimSpace(value), nil
+	}
+
+	end := strings.IndexByte(value[1:], value[0])
+	if end < 0 {
+		return ``, ``, fmt.Errorf(`value of '%s' has no closing quote`, name)
+	}
+
+	return name, value[1 : end+1], nil
+}
+
+// set sets the field name in section to value.
+func (c *certificate) set(section string, name string, value string) {
+	switch section {
+	case sectionMetadata:
+		c.metadata = append(c.metadata, certField{name: name, value: value})
+	case sectionCurve:
+		switch name {
+		case namePublicKey:
+			c.public = value
+		case nameSecretKey:
+			c.secret = value
+		}
+	}
+}
+
+// check checks whether the keys are valid and the secret key belongs to the public key.
+func (c *certificate) check() error {
+	public, err := keys85.Decode32(c.public)
+	if err != nil {
+		return fmt.Errorf(`invalid %s: %w`, namePublicKey, err)
+	}
+
+	if c.secret == `` {
+		return nil
+	}
+
+	secret, err := keys85.Decode32(c.secret)
+	if err != nil {
+		return fmt.Errorf(`invalid %s: %w`, nameSecretKey, err)
+	}
+
+	key, err := ecdh.X25519().NewPrivateKey(secret[:])
+	if err != nil {
+		return fmt.Errorf(`invalid %s: %w`, nameSecretKey, err)
+	}
+
+	if !bytes.Equal(key.PublicKey().Bytes(), public[:]) {
+		return fmt.Errorf(`%s does not belong to the %s`, nameSecretKey, namePublicKey)
+	}
+
+	return nil
+}
+
+// fingerprint returns the fingerprint of the public key, which is the hexadecimal SHA-256 digest of its bytes.
+func (c *certificate) fingerprint() (string, error) {
+	public, err := keys85.Decode32(c.public)
+	if err != nil {
+		return ``, fmt.Errorf(`invalid %s: %w`, namePublicKey, err)
+	}
+
+	digest := sha256.Sum256(public[:])
+	return fingerprintPrefix + hex.EncodeToString(digest[:]), nil
+}
+
+// write writes the public or the secret certificate in the layout of czmq to w.
+func (c *certificate) write(w io.Writer, secret bool, now time.Time) {
+	fmt.Fprintf(w, "#   ****  Generated on %s by %s  ****\n", now.Format(certTimeFormat), programName)
+	if secret {
+		fmt.Fprintln(w, `#   ZeroMQ CURVE **Secret** Certificate`)
+		fmt.Fprintln(w, `#   DO NOT PROVIDE THIS FILE TO OTHER USERS nor change its permissions.`)
+	} else {
+		fmt.Fprintln(w, `#   ZeroMQ CURVE Public Certificate`)
+		fmt.Fprintln(w, `#   Exchange securely, or use a secure mechanism to verify the contents`)
+		fmt.Fprintln(w, `#   of this file after exchange. Store public certificates in your home`)
+		fmt.Fprintln(w, `#   directory, in the .curve subdirectory.`)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, sectionMetadata)
+	for _, field := range c.metadata {
+		fmt.Fprintf(w, "%s%s = \"%s\"\n", zplIndent, field.name, field.value)
+	}
+
+	fmt.Fprintln(w, sectionCurve)
+	fmt.Fprintf(w, "%s%s = \"%s\"\n", zplIndent, namePublicKey, c.public)
+	if secret {
+		fmt.Fprintf(w, "%s%s = \"%s\"\n", zplIndent, nameSecretKey, c.secret)
+	}
+}
+
+// String returns the metadata fields.
+func (m *metadataFlag) String() string {
+	fields := make([]string, len(*m))
+	for i, field := range *m {
+		fields[i] = field.name + `=` + field.value
+	}
+
+	return strings.Join(fields, `,`)
+}
+
+// Set adds the metadata field in the form name=value.
+func (m *metadataFlag) Set(s string) error {
+	name, value, found := strings.Cut(s, `=`)
+	switch {
+	case !found || name == ``:
+		return fmt.Errorf(`metadata '%s' is not of the form name=value`, s)
+	case strings.ContainsAny(name, " \t\r\n=#\"'"):
+		return fmt.Errorf(`metadata name '%s' contains invalid characters`, name)
+	case strings.ContainsAny(value, "\r\n\""):
+		return fmt.Errorf(`metadata value '%s' contains invalid characters`, value)
+	}
+
+	*m = append(*m, certField{name: name, value: value})
+	return nil
+}
@@ -0,0 +1,161 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"io"
+
+	"github.com/xformerfhs/z85"
+)
+
+// The checksum is the CRC-32 of the data that is appended as one additional chunk,
+// exactly as z85.EncodeCheck does it.
+
+// ******** Private constants ********
+
+// checksumSize is the size of the checksum in bytes.
+const checksumSize = 4
+
+// ******** Private variables ********
+
+// errMissingChecksum is returned when the decoded data is too short to contain a checksum.
+var errMissingChecksum = errors.New(`data does not contain a checksum`)
+
+// ******** Private types ********
+
+// checksumReader calculates the checksum of the data that is read through it.
+type checksumReader struct {
+	r     io.Reader
+	hash  hash.Hash32
+	count int64
+}
+
+// verifyWriter writes all data but the last checksumSize bytes to w and calculates their checksum.
+// The last bytes are kept back, as they are the checksum.
+type verifyWriter struct {
+	w        io.Writer
+	hash     hash.Hash32
+	tail     [checksumSize]byte
+	tailSize int
+	dataLen  int64
+}
+
+// ******** Private creation functions ********
+
+// newChecksumReader creates a new checksumReader that reads from r.
+func newChecksumReader(r io.Reader) *checksumReader {
+	return &checksumReader{r: r, hash: crc32.NewIEEE()}
+}
+
+// newVerifyWriter creates a new verifyWriter that writes to w.
+func newVerifyWriter(w io.Writer) *verifyWriter {
+	return &verifyWriter{w: w, hash: crc32.NewIEEE()}
+}
+
+// ******** Private functions ********
+
+// Read reads data from the underlying reader and adds it to the checksum.
+func (c *checksumReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	_, _ = c.hash.Write(p[:n])
+	c.count += int64(n)
+
+	return n, err
+}
+
+// writeChecksum writes the checksum of the data read so far to w.
+// Nothing is written, if the length of the data is not a multiple of the chunk size,
+// as the data can not be encoded then.
+func (c *checksumReader) writeChecksum(w io.Writer) error {
+	if c.count%checksumSize != 0 {
+		return nil
+	}
+
+	_, err := w.Write(binary.BigEndian.AppendUint32(nil, c.hash.Sum32()))
+	return err
+}
+
+// Write writes p to the underlying writer, except for the last checksumSize bytes written so far.
+func (v *verifyWriter) Write(p []byte) (int, error) {
+	n := len(p)
+
+	// Bytes that are followed by at least checksumSize bytes are data.
+	// The kept back bytes are older than the bytes in p, so they are written first.
+	if flushSize := min(v.tailSize, v.tailSize+len(p)-checksumSize); flushSize > 0 {
+		if err := v.writeData(v.tail[:flushSize]); err != nil {
+			return 0, err
+		}
+
+		v.tailSize = copy(v.tail[:], v.tail[flushSize:v.tailSize])
+	}
+
+	if dataSize := len(p) - checksumSize; dataSize > 0 {
+		if err := v.writeData(p[:dataSize]); err != nil {
+			return 0, err
+		}
+
+		p = p[dataSize:]
+	}
+
+	v.tailSize += copy(v.tail[v.tailSize:], p)
+
+	return n, nil
+}
+
+// writeData writes data to the underlying writer and adds it to the checksum.
+func (v *verifyWriter) writeData(data []byte) error {
+	_, _ = v.hash.Write(data)
+	v.dataLen += int64(len(data))
+	_, err := v.w.Write(data)
+
+	return err
+}
+
+// verify checks whether the kept back bytes are the checksum of the data.
+// The errors are CodecErrors like the ones of z85.DecodeCheck.
+func (v *verifyWriter) verify() error {
+	if v.tailSize < checksumSize {
+		return &z85.CodecError{Kind: z85.KindUnexpectedLength, Err: errMissingChecksum}
+	}
+
+	if checksum := binary.BigEndian.Uint32(v.tail[:]); checksum != v.hash.Sum32() {
+		return &z85.CodecError{
+			Kind:          z85.KindChecksumMismatch,
+			RawOffset:     v.dataLen,
+			EncodedOffset: v.dataLen / checksumSize * groupSize,
+			Err:           z85.ErrChecksumMismatch(checksum),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ******** Private constants ********
+
+// cmpRowSize is the number of bytes in a row of the hex dump of a difference.
+const cmpRowSize = 16
+
+// cmpDataColumn is the column of the first byte in a row of the hex dump.
+const cmpDataColumn = 10
+
+// ******** Private types ********
+
+// difference is the first difference of two inputs.
+// The rows contain the bytes of both inputs in the row of the hex dump that contains the difference.
+type difference struct {
+	offset    int64
+	rowOffset int64
+	rows      [2][]byte
+}
+
+// ******** Private functions ********
+
+// runCmp executes the cmp command, which compares the decoded data of two inputs.
+func runCmp(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` cmp`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s cmp [-from format] [-from2 format] input [input2]\n\n", programName)
+		fmt.Fprintln(stderr, `Decodes input and input2 or standard input and compares the decoded data.`)
+		fmt.Fprintln(stderr, `The first difference is printed as a hex dump and the exit code is 1,`)
+		fmt.Fprintln(stderr, `if the data differs or an input can not be decoded.`)
+		fmt.Fprintf(stderr, "Formats: %s\n\n", strings.Join(formatNames, `, `))
+		flags.PrintDefaults()
+	}
+
+	from := flags.String(`from`, formatZ85, `the `+"`format`"+` of input`)
+	from2 := flags.String(`from2`, ``, `the `+"`format`"+` of input2, if it differs from the format of input`)
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	if *from2 == `` {
+		*from2 = *from
+	}
+
+	for _, format := range []string{*from, *from2} {
+		if !isFormat(format) {
+			fmt.Fprintf(stderr, "%s: unknown format '%s'\n", programName, format)
+			flags.Usage()
+			return exitUsage
+		}
+	}
+
+	switch {
+	case flags.NArg() == 0:
+		fmt.Fprintf(stderr, "%s: missing input\n", programName)
+		flags.Usage()
+		return exitUsage
+	case flags.NArg() > 2:
+		fmt.Fprintf(stderr, "%s: unexpected argument '%s'\n", programName, flags.Arg(2))
+		flags.Usage()
+		return exitUsage
+	}
+
+	names := [2]string{flags.Arg(0), flags.Arg(1)}
+	if names[1] == `` {
+		names[1] = stdioName
+	}
+
+	if names[0] == stdioName && names[1] == stdioName {
+		fmt.Fprintf(stderr, "%s: standard input can not be compared with itself\n", programName)
+		return exitUsage
+	}
+
+	d, length, err := compareInputs(names, [2]string{*from, *from2}, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	if d == nil {
+		fmt.Fprintf(stdout, "%s and %s are identical: %d bytes\n", names[0], names[1], length)
+		return exitOK
+	}
+
+	writeDifference(stdout, names, d)
+
+	return exitError
+}
+
+// compareInputs decodes the inputs names in their formats and compares them.
+// It returns the first difference, or nil and the length of the data, if both inputs are identical.
+func compareInputs(names [2]string, formats [2]string, stdin io.Reader) (*difference, int64, error) {
+	var readers [2]io.Reader
+	for i, name := range names {
+		input, err := openInput(name, stdin)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		defer input.Close()
+
+		readers[i] = bufio.NewReaderSize(newFormatDecoder(formats[i], input), bufferSize)
+	}
+
+	var rowOffset int64
+	var rows [2][cmpRowSize]byte
+	for {
+		var lengths [2]int
+		for i, r := range readers {
+			n, err := io.ReadFull(r, rows[i][:])
+			if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, 0, fmt.Errorf(`%s: %w`, names[i], err)
+			}
+
+			lengths[i] = n
+		}
+
+		a, b := rows[0][:lengths[0]], rows[1][:lengths[1]]
+		if !bytes.Equal(a, b) {
+			index := 0
+			for index < len(a) && index < len(b) && a[index] == b[index] {
+				index++
+			}
+
+			return &difference{offset: rowOffset + int64(index), rowOffset: rowOffset, rows: [2][]byte{a, b}}, 0, nil
+		}
+
+		if len(a) < cmpRowSize {
+			return nil, rowOffset + int64(len(a)), nil
+		}
+
+		rowOffset += cmpRowSize
+	}
+}
+
+// writeDifference writes the difference d of the inputs names with the hex dump of its row to w.
+// The differing byte is marked in a line below the rows.
+// If one input is shorter than the other, the mark is below its end.
+func writeDifference(w io.Writer, names [2]string, d *difference) {
+	index := int(d.offset - d.rowOffset)
+	switch {
+	case index == len(d.rows[0]):
+		fmt.Fprintf(w, "%s ends at offset %d (0x%x), %s is longer\n", names[0], d.offset, d.offset, names[1])
+	case index == len(d.rows[1]):
+		fmt.Fprintf(w, "%s ends at offset %d (0x%x), %s is longer\n", names[1], d.offset, d.offset, names[0])
+	default:
+		fmt.Fprintf(w, "%s and %s differ at offset %d (0x%x)\n", names[0], names[1], d.offset, d.offset)
+	}
+
+	for i, row := range d.rows {
+		fmt.Fprintf(w, "%s  %s\n", hexRow(d.rowOffset, row), names[i])
+	}
+
+	column := cmpDataColumn + 3*index
+	if index >= cmpRowSize/2 {
+		column++
+	}
+
+	fmt.Fprintf(w, "%s^^\n", strings.Repeat(` `, column))
+}
+
+// hexRow returns the row of the hex dump of data at offset, in the format of "hexdump -C".
+func hexRow(offset int64, data []byte) string {
+	var result strings.Builder
+	fmt.Fprintf(&result, "%08x  ", offset)
+	for i := 0; i < cmpRowSize; i++ {
+		if i < len(data) {
+			fmt.Fprintf(&result, "%02x ", data[i])
+		} else {
+			result.WriteString(`   `)
+		}
+
+		if i == cmpRowSize/2-1 {
+			result.WriteByte(' ')
+		}
+	}
+
+	result.WriteString(` |`)
+	for _, b := range data {
+		if b < ' ' || b > '~' {
+			b = '.'
+		}
+
+		result.WriteByte(b)
+	}
+
+	result.WriteString(`|`)
+	result.WriteString(strings.Repeat(` `, cmpRowSize-len(data)))
+
+	return result.String()
+}
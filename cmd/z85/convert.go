@@ -0,0 +1,211 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// Names of the formats of the convert command.
+const (
+	formatBase32 = `base32`
+	formatBase64 = `base64`
+	formatBinary = `binary`
+	formatHex    = `hex`
+	formatZ85    = `z85`
+	formatZ85P   = `z85p`
+)
+
+// ******** Private variables ********
+
+// formatNames are the names of all formats in the order of the usage message.
+var formatNames = []string{formatBase32, formatBase64, formatBinary, formatHex, formatZ85, formatZ85P}
+
+// ******** Private types ********
+
+// nopWriteCloser is a writer with a Close method that does nothing.
+type nopWriteCloser struct {
+	io.Writer
+}
+
+// inputOffsetReader reads from a decoder of the text of a textReader
+// and reports errors at their offsets in the input of the textReader.
+type inputOffsetReader struct {
+	r    io.Reader
+	text *textReader
+}
+
+// ******** Private functions ********
+
+// runConvert executes the convert command, which converts data between textual encodings.
+func runConvert(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` convert`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s convert -from format -to format [-o output] [input]\n\n", programName)
+		fmt.Fprintln(stderr, `Converts input or standard input from one encoding into another.`)
+		fmt.Fprintf(stderr, "Formats: %s\n\n", strings.Join(formatNames, `, `))
+		flags.PrintDefaults()
+	}
+
+	from := flags.String(`from`, ``, `the `+"`format`"+` of the input`)
+	to := flags.String(`to`, ``, `the `+"`format`"+` of the output`)
+	output := flags.String(`o`, ``, `write the result to the `+"`file`"+` instead of standard output`)
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	for _, format := range []string{*from, *to} {
+		if !isFormat(format) {
+			fmt.Fprintf(stderr, "%s: unknown format '%s'\n", programName, format)
+			flags.Usage()
+			return exitUsage
+		}
+	}
+
+	if flags.NArg() > 1 {
+		fmt.Fprintf(stderr, "%s: unexpected argument '%s'\n", programName, flags.Arg(1))
+		flags.Usage()
+		return exitUsage
+	}
+
+	transform := func(w io.Writer, r io.Reader) error {
+		return convert(w, r, *from, *to)
+	}
+
+	if err := process(flags.Arg(0), *output, stdin, stdout, transform); err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+// isFormat reports whether name is the name of a format.
+func isFormat(name string) bool {
+	for _, format := range formatNames {
+		if name == format {
+			return true
+		}
+	}
+
+	return false
+}
+
+// convert reads data in the format from from r and writes it in the format to to w.
+// Text is followed by a line feed.
+func convert(w io.Writer, r io.Reader, from string, to string) error {
+	encoder := newFormatEncoder(to, w)
+	if _, err := io.Copy(encoder, newFormatDecoder(from, r)); err != nil {
+		return err
+	}
+
+	if err := encoder.Close(); err != nil {
+		if z85.IsErrInvalidLength(err) {
+			return fmt.Errorf(`%w (use the format %s for data of any length)`, err, formatZ85P)
+		}
+
+		return err
+	}
+
+	if to == formatBinary {
+		return nil
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// newFormatDecoder returns a reader that decodes the text in format from r.
+func newFormatDecoder(format string, r io.Reader) io.Reader {
+	if format == formatBinary {
+		return r
+	}
+
+	text := newTextReader(r)
+	switch format {
+	case formatBase32:
+		return base32.NewDecoder(base32.StdEncoding, text)
+	case formatBase64:
+		return base64.NewDecoder(base64.StdEncoding, text)
+	case formatHex:
+		return hex.NewDecoder(text)
+	case formatZ85P:
+		return &inputOffsetReader{r: z85.PaddedEncoding.NewDecoder(text), text: text}
+	default:
+		return &inputOffsetReader{r: z85.NewDecoder(text), text: text}
+	}
+}
+
+// Read reads from the decoder and moves the offsets of its errors from the text to the input.
+func (i *inputOffsetReader) Read(p []byte) (int, error) {
+	n, err := i.r.Read(p)
+
+	return n, i.text.moveError(err)
+}
+
+// newFormatEncoder returns a writer that encodes the data written to it in format and writes it to w.
+// It must be closed to write the end of the encoding.
+func newFormatEncoder(format string, w io.Writer) io.WriteCloser {
+	switch format {
+	case formatBase32:
+		return base32.NewEncoder(base32.StdEncoding, w)
+	case formatBase64:
+		return base64.NewEncoder(base64.StdEncoding, w)
+	case formatHex:
+		return nopWriteCloser{hex.NewEncoder(w)}
+	case formatZ85:
+		return z85.NewEncoder(w)
+	case formatZ85P:
+		return z85.PaddedEncoding.NewEncoder(w)
+	default:
+		return nopWriteCloser{w}
+	}
+}
+
+// Close does nothing.
+func (nopWriteCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,169 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ******** Private constants ********
+
+// stdioName is the file name that stands for standard input or standard output.
+const stdioName = `-`
+
+// bufferSize is the size of the buffers for reading and writing files.
+const bufferSize = 64 * 1024
+
+// newFileMode is the permission of a new file before the umask is applied, which is the one of os.Create.
+const newFileMode = 0o666
+
+// ******** Private variables ********
+
+// umask returns the file mode creation mask of the process, which is only read once.
+var umask = sync.OnceValue(readUmask)
+
+// ******** Private types ********
+
+// output is the destination of the result.
+// A named file is written to a temporary file in the same directory that replaces the file only
+// when all data has been written, so an error never leaves a partially written file behind.
+// The temporary file can only be read by the owner until it gets the permissions of the file.
+type output struct {
+	w    *bufio.Writer
+	file *os.File
+	name string
+	mode os.FileMode
+}
+
+// limitedInput is a reader that fails, if the underlying reader has more than limit bytes.
+// It reads one byte more than the limit, so input of exactly limit bytes is accepted.
+type limitedInput struct {
+	r     io.LimitedReader
+	limit int64
+}
+
+// ******** Private creation functions ********
+
+// openInput opens the file name for reading, or returns stdin, if name is empty or "-".
+func openInput(name string, stdin io.Reader) (io.ReadCloser, error) {
+	if name == `` || name == stdioName {
+		return io.NopCloser(stdin), nil
+	}
+
+	return os.Open(name)
+}
+
+// newLimitedInput creates a new limitedInput that reads at most limit bytes from r.
+func newLimitedInput(r io.Reader, limit int64) *limitedInput {
+	return &limitedInput{r: io.LimitedReader{R: r, N: limit + 1}, limit: limit}
+}
+
+// createOutput creates the output to the file name, or to stdout, if name is empty or "-".
+func createOutput(name string, stdout io.Writer) (*output, error) {
+	if name == `` || name == stdioName {
+		return &output{w: bufio.NewWriterSize(stdout, bufferSize)}, nil
+	}
+
+	// An existing file keeps its permissions and a new one gets the ones of os.Create.
+	mode := newFileMode &^ umask()
+	if info, err := os.Stat(name); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(name), `.`+filepath.Base(name)+`.*`)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output{w: bufio.NewWriterSize(file, bufferSize), file: file, name: name, mode: mode}, nil
+}
+
+// ******** Private functions ********
+
+// Read reads from the underlying reader and returns an error instead of the byte after the limit.
+func (l *limitedInput) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	if l.r.N == 0 {
+		return max(n-1, 0), fmt.Errorf(`input is larger than the maximum size of %d bytes`, l.limit)
+	}
+
+	return n, err
+}
+
+// Write writes p to the output.
+func (o *output) Write(p []byte) (int, error) {
+	return o.w.Write(p)
+}
+
+// commit flushes the output and moves a temporary file to its final name.
+func (o *output) commit() error {
+	err := o.w.Flush()
+	if o.file == nil {
+		return err
+	}
+
+	if err != nil {
+		o.abort()
+		return err
+	}
+
+	if err = o.file.Chmod(o.mode); err != nil {
+		o.abort()
+		return err
+	}
+
+	if err = o.file.Close(); err != nil {
+		_ = os.Remove(o.file.Name())
+		return err
+	}
+
+	if err = os.Rename(o.file.Name(), o.name); err != nil {
+		_ = os.Remove(o.file.Name())
+		return err
+	}
+
+	return nil
+}
+
+// abort discards the output.
+// A temporary file is removed, so nothing is left of a failed run.
+func (o *output) abort() {
+	if o.file == nil {
+		_ = o.w.Flush()
+		return
+	}
+
+	_ = o.file.Close()
+	_ = os.Remove(o.file.Name())
+}
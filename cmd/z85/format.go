@@ -0,0 +1,145 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ******** Private constants ********
+
+// armorDashes enclose the labels of the armor lines.
+const armorDashes = `-----`
+
+// separators are the characters that can separate groups.
+// None of them is part of the Z85 alphabet, so the decoder can remove them.
+const separators = ` _,;`
+
+// ******** Private types ********
+
+// formatter is a writer that splits the encoded text into groups and lines.
+type formatter struct {
+	w         io.Writer
+	wrap      int
+	group     int
+	separator string
+	column    int
+	buf       []byte
+}
+
+// ******** Private creation functions ********
+
+// newFormatter creates a new formatter that writes to w with the format of opts.
+func newFormatter(w io.Writer, opts options) *formatter {
+	return &formatter{w: w, wrap: opts.wrap, group: opts.group, separator: opts.separator}
+}
+
+// ******** Private functions ********
+
+// Write writes p to the underlying writer and inserts separators and line feeds.
+func (f *formatter) Write(p []byte) (int, error) {
+	if f.wrap == 0 && f.group == 0 {
+		return f.w.Write(p)
+	}
+
+	f.buf = f.buf[:0]
+	for _, b := range p {
+		if f.column > 0 {
+			switch {
+			case f.wrap > 0 && f.column == f.wrap:
+				f.buf = append(f.buf, '\n')
+				f.column = 0
+			case f.group > 0 && f.column%f.group == 0:
+				f.buf = append(f.buf, f.separator...)
+			}
+		}
+
+		f.buf = append(f.buf, b)
+		f.column++
+	}
+
+	if _, err := f.w.Write(f.buf); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// armorLine returns the armor line of kind for the encoding that opts select.
+func armorLine(kind string, opts options) string {
+	return armorDashes + kind + ` ` + encodingName(opts) + armorDashes + "\n"
+}
+
+// isArmorLine reports whether line is an armor line.
+// Armor lines contain a space, so they can not be mistaken for encoded data that starts with dashes.
+func isArmorLine(line []byte) bool {
+	trimmed := bytes.TrimSpace(line)
+
+	return (bytes.HasPrefix(trimmed, []byte(armorDashes+`BEGIN `)) || bytes.HasPrefix(trimmed, []byte(armorDashes+`END `))) &&
+		bytes.HasSuffix(trimmed, []byte(armorDashes))
+}
+
+// checkFormat checks the formatting options.
+func checkFormat(opts options) error {
+	switch {
+	case opts.wrap < 0:
+		return fmt.Errorf(`line length %d is negative`, opts.wrap)
+	case opts.group < 0:
+		return fmt.Errorf(`group size %d is negative`, opts.group)
+	case opts.jobs < 0:
+		return fmt.Errorf(`number of workers %d is negative`, opts.jobs)
+	case opts.maxSize < 0:
+		return fmt.Errorf(`maximum size %d is negative`, opts.maxSize)
+	case opts.maxSize > 0 && !opts.decode:
+		return fmt.Errorf(`--max-size can only be used when decoding`)
+	case len(opts.separator) != 1 || !strings.Contains(separators, opts.separator):
+		return fmt.Errorf(`separator '%s' is not one of '%s'`, opts.separator, separators)
+	case opts.raw && (opts.wrap > 0 || opts.group > 0 || opts.armor):
+		return fmt.Errorf(`raw output can not be combined with wrapping, grouping or armor`)
+	case opts.newline && (opts.noNewline || opts.raw):
+		return fmt.Errorf(`--newline can not be combined with --no-newline or -r`)
+	case opts.noNewline && !opts.decode && (opts.wrap > 0 || opts.group > 0 || opts.armor):
+		return fmt.Errorf(`--no-newline can not be combined with wrapping, grouping or armor when encoding`)
+	case opts.check && opts.decode:
+		return fmt.Errorf(`--check can only be used when encoding, use --verify when decoding`)
+	case opts.verify && !opts.decode:
+		return fmt.Errorf(`--verify can only be used when decoding, use --check when encoding`)
+	case (opts.check || opts.verify) && opts.padded:
+		return fmt.Errorf(`checksums can not be combined with the padded encoding`)
+	case opts.qr && opts.decode:
+		return fmt.Errorf(`--qr can only be used when encoding`)
+	case opts.qr && opts.json:
+		return fmt.Errorf(`--qr can not be combined with --json`)
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// groupSize is the number of characters of a group.
+const groupSize = 5
+
+// maxPadding is the maximum number of padding bytes of the padded encoding.
+const maxPadding = 3
+
+// inspectHeader is the header of the table of groups.
+const inspectHeader = "position  group  value     bytes        data offset\n"
+
+// groupColumn is the column of the group in the table of groups.
+const groupColumn = 10
+
+// ******** Private types ********
+
+// location is the offset, line and column of a character in the inspected input.
+type location struct {
+	offset int
+	line   int
+	column int
+}
+
+// inspection contains the encoded characters of a text and their locations.
+type inspection struct {
+	text      []byte
+	locations []location
+}
+
+// ******** Private functions ********
+
+// runInspect executes the inspect command, which prints the groups of an encoded text.
+func runInspect(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` inspect`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s inspect [-p] [input]\n\n", programName)
+		fmt.Fprintln(stderr, `Prints the groups of the encoded text in input or standard input with their values, bytes and offsets.`)
+		fmt.Fprintln(stderr, `The exit code is 1, if the text is no valid encoding.`)
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	padded := flags.Bool(`p`, false, `inspect the padded encoding Z85P`)
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	if flags.NArg() > 1 {
+		fmt.Fprintf(stderr, "%s: unexpected argument '%s'\n", programName, flags.Arg(1))
+		flags.Usage()
+		return exitUsage
+	}
+
+	text, err := readInput(flags.Arg(0), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	var report strings.Builder
+	valid := inspect(&report, newInspection(text), *padded)
+	_, _ = io.WriteString(stdout, report.String())
+
+	if !valid {
+		return exitError
+	}
+
+	return exitOK
+}
+
+// readInput reads all data from the file name, or from stdin, if name is empty or "-".
+func readInput(name string, stdin io.Reader) ([]byte, error) {
+	input, err := openInput(name, stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	defer input.Close()
+
+	return io.ReadAll(input)
+}
+
+// newInspection extracts the encoded characters from text.
+// A leading byte order mark, armor lines, white space and separators are skipped as when decoding.
+func newInspection(text []byte) *inspection {
+	result := &inspection{}
+
+	offset := bomLen(text)
+	for lineIndex, line := range bytes.SplitAfter(text[offset:], []byte{'\n'}) {
+		if !isArmorLine(line) {
+			for i, b := range line {
+				if !isNonData(b) {
+					result.text = append(result.text, b)
+					result.locations = append(result.locations, location{offset: offset + i, line: lineIndex + 1, column: i + 1})
+				}
+			}
+		}
+
+		offset += len(line)
+	}
+
+	return result
+}
+
+// inspect writes the table of the groups of in to w and reports whether in is a valid encoding.
+func inspect(w io.Writer, in *inspection, padded bool) bool {
+	valid := true
+	dataLen := len(in.text)
+	if padded && dataLen%groupSize == 1 {
+		dataLen--
+	}
+
+	fmt.Fprint(w, inspectHeader)
+	for position := 0; position < dataLen; position += groupSize {
+		group := string(in.text[position:min(position+groupSize, dataLen)])
+		if !inspectGroup(w, in, position, group) {
+			valid = false
+		}
+	}
+
+	if dataLen < len(in.text) {
+		padding := in.text[dataLen]
+		count := strings.IndexByte(z85.Alphabet, padding)
+		switch {
+		case count < 0 || count > maxPadding:
+			fmt.Fprintf(w, "%8d  %-5c  invalid padding count %q at %s\n", dataLen, padding, padding, in.locations[dataLen])
+			valid = false
+		default:
+			fmt.Fprintf(w, "%8d  %-5c  padding of %d bytes\n", dataLen, padding, count)
+		}
+	}
+
+	if padded && len(in.text)%groupSize != 1 {
+		fmt.Fprintf(w, "padded encoding has a length of %d instead of a multiple of 5 plus 1\n", len(in.text))
+		valid = false
+	}
+
+	report := z85.Analyze(string(in.text))
+	if !valid || report.Variant == `` {
+		fmt.Fprintf(w, "\ncharacters: %d, groups: %d, variant: none\n", len(in.text), report.GroupCount)
+		return valid
+	}
+
+	fmt.Fprintf(w, "\ncharacters: %d, groups: %d, variant: %s, canonical: %t\n", len(in.text), report.GroupCount, report.Variant, report.Canonical)
+
+	return valid
+}
+
+// inspectGroup writes the line of the group at position to w and reports whether it is valid.
+func inspectGroup(w io.Writer, in *inspection, position int, group string) bool {
+	if len(group) < groupSize {
+		fmt.Fprintf(w, "%8d  %-5s  incomplete group of %d characters\n", position, group, len(group))
+		return false
+	}
+
+	decoded, err := z85.StdEncoding.DecodeString(group)
+	if err == nil {
+		fmt.Fprintf(w, "%8d  %s  %08x  % x  %11d\n", position, group, binary.BigEndian.Uint32(decoded), decoded, position/groupSize*4)
+		return true
+	}
+
+	var codecErr *z85.CodecError
+	if errors.As(err, &codecErr) && codecErr.Kind == z85.KindInvalidByte {
+		offset := int(codecErr.EncodedOffset)
+		fmt.Fprintf(w, "%8d  %s  invalid character %q at %s\n", position, group, codecErr.Byte, in.locations[position+offset])
+		fmt.Fprintf(w, "%s^\n", strings.Repeat(` `, groupColumn+offset))
+		return false
+	}
+
+	if errors.As(err, &codecErr) && codecErr.Kind == z85.KindOverflow {
+		fmt.Fprintf(w, "%8d  %s  value exceeds 32 bits\n", position, group)
+		return false
+	}
+
+	fmt.Fprintf(w, "%8d  %s  %v\n", position, group, err)
+	return false
+}
+
+// String returns the offset, the line and the column of l.
+func (l location) String() string {
+	return fmt.Sprintf(`position %d, line %d, column %d`, l.offset, l.line, l.column)
+}
@@ -0,0 +1,174 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// Codes of the errors that are not CodecErrors in the JSON representation.
+// CodecErrors have the codes of the z85 package.
+const (
+	jsonCodeUsage = `usage`
+	jsonCodeFile  = `file`
+	jsonCodeError = `error`
+)
+
+// ******** Private types ********
+
+// jsonResult is the JSON representation of the result of an encoding or a decoding.
+// The result is only contained, if it has been written to standard output.
+type jsonResult struct {
+	Operation    string  `json:"operation"`
+	Encoding     string  `json:"encoding"`
+	InputLength  int64   `json:"inputLength"`
+	OutputLength int64   `json:"outputLength"`
+	Encoded      *string `json:"encoded,omitempty"`
+	Decoded      *[]byte `json:"decoded,omitempty"`
+}
+
+// jsonError is the JSON representation of an error that is not a CodecError.
+type jsonError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// jsonErrorReport is the JSON object that is written to standard error.
+type jsonErrorReport struct {
+	Error any `json:"error"`
+}
+
+// countingReader counts the bytes that are read through it.
+type countingReader struct {
+	r     io.Reader
+	count int64
+}
+
+// countingWriter counts the bytes that are written through it.
+type countingWriter struct {
+	w     io.Writer
+	count int64
+}
+
+// ******** Private functions ********
+
+// runJSON transforms the file inputName like run does and writes the result as JSON to stdout.
+func runJSON(inputName string, opts options, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	result := jsonResult{Operation: `encode`, Encoding: encodingName(opts)}
+	if opts.decode {
+		result.Operation = `decode`
+	}
+
+	transform := func(w io.Writer, r io.Reader) error {
+		counted := &countingReader{r: r}
+		written := &countingWriter{w: w}
+		err := transformer(opts)(written, counted)
+		result.InputLength = counted.count
+		result.OutputLength = written.count
+
+		return err
+	}
+
+	var buffer bytes.Buffer
+	if err := process(inputName, opts.output, stdin, &buffer, transform); err != nil {
+		return reportError(stderr, err, true, exitError)
+	}
+
+	if opts.output == `` || opts.output == stdioName {
+		if opts.decode {
+			decoded := buffer.Bytes()
+			result.Decoded = &decoded
+		} else {
+			encoded := buffer.String()
+			result.Encoded = &encoded
+		}
+	}
+
+	if err := json.NewEncoder(stdout).Encode(result); err != nil {
+		return reportError(stderr, err, true, exitError)
+	}
+
+	return exitOK
+}
+
+// reportError writes err to stderr as text or as JSON and returns exitCode.
+func reportError(stderr io.Writer, err error, asJSON bool, exitCode int) int {
+	if !asJSON {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitCode
+	}
+
+	_ = json.NewEncoder(stderr).Encode(jsonErrorReport{Error: errorRepresentation(err, exitCode)})
+
+	return exitCode
+}
+
+// errorRepresentation returns the value that represents err in JSON.
+func errorRepresentation(err error, exitCode int) any {
+	var codecErr *z85.CodecError
+	if errors.As(err, &codecErr) {
+		return codecErr
+	}
+
+	var pathErr *fs.PathError
+
+	switch {
+	case exitCode == exitUsage:
+		return jsonError{Code: jsonCodeUsage, Message: err.Error()}
+	case errors.As(err, &pathErr):
+		return jsonError{Code: jsonCodeFile, Message: err.Error()}
+	default:
+		return jsonError{Code: jsonCodeError, Message: err.Error()}
+	}
+}
+
+// Read reads from the underlying reader and counts the bytes.
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.count += int64(n)
+
+	return n, err
+}
+
+// Write writes to the underlying writer and counts the bytes.
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.count += int64(n)
+
+	return n, err
+}
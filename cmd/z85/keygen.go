@@ -0,0 +1,146 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"crypto/ecdh"
+	crand "crypto/rand"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/xformerfhs/z85/keys85"
+)
+
+// ******** Private constants ********
+
+// secretFileMode is the permission of the file that contains a secret key.
+const secretFileMode = 0o600
+
+// Headers of the keys in the output of the keygen command, which are the ones of curve_keygen of libzmq.
+const (
+	publicKeyHeader = `== CURVE PUBLIC KEY ==`
+	secretKeyHeader = `== CURVE SECRET KEY ==`
+)
+
+// ******** Private types ********
+
+// keyPair is a Curve25519 key pair.
+type keyPair struct {
+	public [32]byte
+	secret [32]byte
+}
+
+// ******** Private functions ********
+
+// runKeygen executes the keygen command, which generates a CURVE key pair.
+func runKeygen(args []string, _ io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` keygen`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s keygen [-secret file]\n\n", programName)
+		fmt.Fprintln(stderr, `Generates a Curve25519 key pair for CurveZMQ and prints its keys as Z85 strings.`)
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	secretName := flags.String(`secret`, ``, `write the secret key to the new `+"`file`"+` that only the owner can read instead of printing it`)
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "%s: unexpected argument '%s'\n", programName, flags.Arg(0))
+		flags.Usage()
+		return exitUsage
+	}
+
+	keys, err := generateKeyPair()
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	public := keys85.Encode32(keys.public)
+	secret := keys85.Encode32(keys.secret)
+
+	if *secretName != `` {
+		if err = writeNewFile(*secretName, append(secret[:], '\n'), secretFileMode); err != nil {
+			fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+			return exitError
+		}
+
+		fmt.Fprintf(stdout, "%s\n%s\n", publicKeyHeader, public)
+		return exitOK
+	}
+
+	fmt.Fprintf(stdout, "%s\n%s\n%s\n%s\n", publicKeyHeader, public, secretKeyHeader, secret)
+	return exitOK
+}
+
+// generateKeyPair generates a new Curve25519 key pair.
+func generateKeyPair() (keyPair, error) {
+	key, err := ecdh.X25519().GenerateKey(crand.Reader)
+	if err != nil {
+		return keyPair{}, err
+	}
+
+	var result keyPair
+	copy(result.public[:], key.PublicKey().Bytes())
+	copy(result.secret[:], key.Bytes())
+
+	return result, nil
+}
+
+// writeNewFile writes data to the new file name with the permissions mode.
+// An existing file is not overwritten, so a key can not be lost by accident.
+func writeNewFile(name string, data []byte, mode os.FileMode) error {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(name)
+	}
+
+	return err
+}
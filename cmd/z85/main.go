@@ -0,0 +1,442 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Command z85 encodes and decodes data with the Z85 encoding, analogous to the base64 utility.
+//
+// Usage:
+//
+//	z85 [-d [-i] [--verify] [--max-size n]] [--check] [-p] [-w n] [-g n] [-s separator] [-a | -r] [--newline | --no-newline] [--json] [-j n] [--qr] [-o output] [input]
+//	z85 cmp [-from format] [-from2 format] input [input2]
+//	z85 convert -from format -to format [-o output] [input]
+//	z85 inspect [-p] [input]
+//	z85 keygen [-secret file]
+//	z85 cert create [-m name=value]... file
+//	z85 cert show file|directory
+//	z85 cert fingerprint file|directory
+//	z85 cert convert -to format [-public file] [-secret file] cert
+//	z85 cert import [-from format] [-m name=value]... [-public file] [-secret file] cert
+//	z85 manifest create [-digest] [-o output] directory
+//	z85 manifest restore [-dir directory] [manifest]
+//	z85 manifest verify [-dir directory] [manifest]
+//	z85 scan [-keys] [-min n] [-redact] [path...]
+//	z85 selftest
+//
+// Without flags, z85 reads binary data and writes its Z85 encoding in one line followed by a line feed.
+// The flag -w wraps the lines after n characters, -g separates groups of n characters with a space
+// or the separator given with -s, -a encloses the text in BEGIN and END lines and -r omits the final line feed.
+//
+// The flags --newline and --no-newline control the final line feed explicitly for scripts that embed the result
+// in here-documents or CI variables. When encoding, --newline is the default and --no-newline is the same as -r.
+// When decoding, the data is written unchanged by default, --newline adds a line feed, if the data does not end
+// with one, and --no-newline removes a final line feed of the data.
+//
+// With -d it reads Z85 text and writes the decoded data.
+// A leading byte order mark, armor lines, white space and separators of the text are ignored,
+// so all formats can be decoded without flags.
+// With -i or --ignore-garbage all characters outside the Z85 alphabet are ignored,
+// so text with stray punctuation, e.g. from logs or quotes, can be decoded.
+// With --max-size decoding fails as soon as the input has more than n bytes,
+// which protects automation that pipes untrusted data through z85 from filling the memory or the disk.
+//
+// The data is read from the file input, or from standard input, if no file or "-" is given.
+// The result is written to the file given with -o, or to standard output.
+// Files are processed as streams, so their size is not limited by the memory.
+// The output file is only replaced when all data has been processed,
+// so an error does not leave a partially written file behind.
+// An existing output file keeps its permissions and a new one gets the ones of the umask.
+//
+// With --check the CRC-32 checksum of the data is appended as one additional chunk, like z85.EncodeCheck does.
+// With -d --verify the checksum is verified and removed, and a mismatch results in a nonzero exit code.
+// Only an output file is left unchanged then, as the data on standard output has already been written.
+//
+// With --json the result is written as a JSON object to standard output and errors are written as JSON objects
+// with a code, the byte offsets and the offending character to standard error.
+//
+// With -j the data is split into blocks of 1 MiB that are encoded or decoded by n parallel workers
+// and written in their order, which speeds up large streams on machines with several CPUs.
+//
+// With --qr the encoded text is written as a QR code, so keys can be moved to air-gapped machines and phones
+// with a camera. The code is drawn with block characters for terminals with a dark background,
+// or written as a PNG image, if the output file ends with ".png".
+// Only data whose encoding has at most 2331 characters fits into a QR code.
+//
+// Z85 can only encode data whose length is a multiple of 4. The flag -p selects the padded variant Z85P,
+// which encodes data of any length.
+//
+// The command cmp decodes two inputs, or one input and standard input, and reports whether their data is identical.
+// The first difference is printed as a hex dump of both inputs and results in the exit code 1.
+//
+// The command convert converts data between the formats hex, base64, base32, z85, z85p and binary.
+//
+// The command inspect prints each group of an encoded text with its value, bytes and offsets,
+// and marks the characters that make the text invalid.
+//
+// The command keygen generates a Curve25519 key pair for CurveZMQ and prints its keys as Z85 strings.
+// With -secret the secret key is written to a new file that only the owner can read.
+//
+// The command cert creates CurveZMQ certificate files in the format of czmq, shows their keys and metadata
+// and converts their keys into other formats. show and fingerprint also process each file of a directory.
+// convert writes the keys into plain key files with -public and -secret and import creates certificates from them.
+//
+// The command manifest writes the paths, sizes and Z85P encodings of all files in a directory tree
+// into a text manifest with short lines, restores the files from it and verifies a tree against it.
+// With -digest the manifest only contains the SHA-256 digests of the files, which can only be verified.
+//
+// The command scan searches files, directory trees or standard input for CURVE keys and other encoded data
+// and prints the file, line, column and byte offset of each candidate.
+//
+// The command selftest runs the test vectors of the specification and round trip and negative tests
+// against this build and exits with 1, if a test fails.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// Exit codes of the program.
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// programName is the name of the program in messages.
+const programName = `z85`
+
+// ******** Private types ********
+
+// command is the function of a subcommand.
+// It is called with the arguments after the name of the subcommand and returns the exit code.
+type command func(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int
+
+// options contains the values of the command line flags.
+type options struct {
+	decode    bool
+	ignore    bool
+	check     bool
+	verify    bool
+	padded    bool
+	output    string
+	wrap      int
+	group     int
+	separator string
+	armor     bool
+	raw       bool
+	json      bool
+	jobs      int
+	qr        bool
+	maxSize   int64
+	newline   bool
+	noNewline bool
+}
+
+// ******** Private variables ********
+
+// commands maps the names of the subcommands to their functions.
+var commands = map[string]command{
+	`cert`:     runCert,
+	`cmp`:      runCmp,
+	`convert`:  runConvert,
+	`inspect`:  runInspect,
+	`keygen`:   runKeygen,
+	`manifest`: runManifest,
+	`scan`:     runScan,
+	`selftest`: runSelftest,
+}
+
+// ******** Main function ********
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// ******** Private functions ********
+
+// run executes the program with the arguments args and returns the exit code.
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) > 0 {
+		if f, found := commands[args[0]]; found {
+			return f(args[1:], stdin, stdout, stderr)
+		}
+	}
+
+	flags := flag.NewFlagSet(programName, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s [-d [-i] [--verify] [--max-size n]] [--check] [-p] [-w n] [-g n] [-s separator] [-a | -r] [--newline | --no-newline] [--json] [-j n] [--qr] [-o output] [input]\n", programName)
+		fmt.Fprintf(stderr, "       %s command [arguments]\n\n", programName)
+		fmt.Fprintln(stderr, `Encodes input or standard input with Z85 or decodes it with -d.`)
+		fmt.Fprintln(stderr, `The result is written to the output file or to standard output.`)
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+		fmt.Fprintln(stderr)
+		fmt.Fprintf(stderr, "Commands: %s\n", strings.Join(commandNames(), `, `))
+	}
+
+	var opts options
+	flags.BoolVar(&opts.decode, `d`, false, `decode data`)
+	flags.BoolVar(&opts.ignore, `i`, false, `ignore all characters outside the Z85 alphabet when decoding`)
+	flags.BoolVar(&opts.ignore, `ignore-garbage`, false, `the same as -i`)
+	flags.BoolVar(&opts.check, `check`, false, `append a checksum when encoding`)
+	flags.BoolVar(&opts.verify, `verify`, false, `verify and remove the checksum when decoding`)
+	flags.Int64Var(&opts.maxSize, `max-size`, 0, `fail when decoding input of more than `+"`n`"+` bytes (0 means no limit)`)
+	flags.BoolVar(&opts.padded, `p`, false, `use the padded encoding Z85P for data of any length`)
+	flags.StringVar(&opts.output, `o`, ``, `write the result to the `+"`file`"+` instead of standard output`)
+	flags.IntVar(&opts.wrap, `w`, 0, `wrap encoded lines after `+"`n`"+` characters (0 means no wrapping)`)
+	flags.IntVar(&opts.group, `g`, 0, `separate groups of `+"`n`"+` characters`)
+	flags.StringVar(&opts.separator, `s`, ` `, `the `+"`separator`"+` of groups, one of '`+separators+`'`)
+	flags.BoolVar(&opts.armor, `a`, false, `enclose the encoded text in BEGIN and END lines`)
+	flags.BoolVar(&opts.raw, `r`, false, `write the encoded text without a final line feed`)
+	flags.BoolVar(&opts.newline, `newline`, false, `end the encoded text with a line feed (the default), or the decoded data, if it does not end with one`)
+	flags.BoolVar(&opts.noNewline, `no-newline`, false, `write the encoded text without a final line feed, or remove one from the end of the decoded data`)
+	flags.BoolVar(&opts.json, `json`, false, `write the result and errors as JSON`)
+	flags.IntVar(&opts.jobs, `j`, 1, `encode or decode with `+"`n`"+` parallel workers (0 means one per CPU)`)
+	flags.BoolVar(&opts.qr, `qr`, false, `write the encoded text as a QR code, as a PNG image if the output file ends with `+qrImageExtension)
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	if err := checkFormat(opts); err != nil {
+		return reportError(stderr, err, opts.json, exitUsage)
+	}
+
+	if flags.NArg() > 1 {
+		if opts.json {
+			return reportError(stderr, fmt.Errorf(`unexpected argument '%s'`, flags.Arg(1)), true, exitUsage)
+		}
+
+		fmt.Fprintf(stderr, "%s: unexpected argument '%s'\n", programName, flags.Arg(1))
+		flags.Usage()
+		return exitUsage
+	}
+
+	if opts.json {
+		return runJSON(flags.Arg(0), opts, stdin, stdout, stderr)
+	}
+
+	if err := process(flags.Arg(0), opts.output, stdin, stdout, transformer(opts)); err != nil {
+		return reportError(stderr, err, false, exitError)
+	}
+
+	return exitOK
+}
+
+// commandNames returns the sorted names of the subcommands.
+func commandNames() []string {
+	result := make([]string, 0, len(commands))
+	for name := range commands {
+		result = append(result, name)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// process transforms the file inputName into the file outputName.
+// Empty names or "-" stand for standard input and standard output.
+func process(inputName string, outputName string, stdin io.Reader, stdout io.Writer, transform func(io.Writer, io.Reader) error) error {
+	input, err := openInput(inputName, stdin)
+	if err != nil {
+		return err
+	}
+
+	defer input.Close()
+
+	out, err := createOutput(outputName, stdout)
+	if err != nil {
+		return err
+	}
+
+	if err = transform(out, input); err != nil {
+		out.abort()
+		return err
+	}
+
+	return out.commit()
+}
+
+// transformer returns the function that encodes or decodes as opts select.
+func transformer(opts options) func(io.Writer, io.Reader) error {
+	return func(w io.Writer, r io.Reader) error {
+		switch {
+		case opts.decode:
+			return decode(w, r, opts)
+		case opts.qr:
+			return writeQR(w, r, opts)
+		default:
+			return encode(w, r, opts)
+		}
+	}
+}
+
+// encoding returns the encoding that opts select.
+func encoding(opts options) *z85.Encoding {
+	if opts.padded {
+		return z85.PaddedEncoding
+	}
+
+	return z85.StdEncoding
+}
+
+// encodingName returns the name of the encoding that opts select.
+func encodingName(opts options) string {
+	if opts.padded {
+		return `Z85P`
+	}
+
+	return `Z85`
+}
+
+// encode reads all data from r and writes its encoding in the format of opts to w.
+func encode(w io.Writer, r io.Reader, opts options) error {
+	if opts.armor {
+		if _, err := io.WriteString(w, armorLine(`BEGIN`, opts)); err != nil {
+			return err
+		}
+	}
+
+	var checksum *checksumReader
+	if opts.check {
+		checksum = newChecksumReader(r)
+		r = checksum
+	}
+
+	encoder := newEncoder(newFormatter(w, opts), opts)
+	if _, err := io.Copy(encoder, r); err != nil {
+		return err
+	}
+
+	if checksum != nil {
+		if err := checksum.writeChecksum(encoder); err != nil {
+			return err
+		}
+	}
+
+	if err := encoder.Close(); err != nil {
+		if z85.IsErrInvalidLength(err) && !opts.padded && !opts.check {
+			return fmt.Errorf(`%w (use -p for data of any length)`, err)
+		}
+
+		return err
+	}
+
+	if opts.raw || opts.noNewline {
+		return nil
+	}
+
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return err
+	}
+
+	if opts.armor {
+		_, err := io.WriteString(w, armorLine(`END`, opts))
+		return err
+	}
+
+	return nil
+}
+
+// decode reads the encoded text from r and writes its decoding to w.
+// The final line feed of the data is added or removed, if opts request it.
+func decode(w io.Writer, r io.Reader, opts options) error {
+	if !opts.newline && !opts.noNewline {
+		return decodeData(w, r, opts)
+	}
+
+	lineEnd := newLineEndWriter(w, opts.newline)
+	if err := decodeData(lineEnd, r, opts); err != nil {
+		return err
+	}
+
+	return lineEnd.finish()
+}
+
+// decodeData reads the encoded text from r and writes the decoded data to w.
+func decodeData(w io.Writer, r io.Reader, opts options) error {
+	if opts.maxSize > 0 {
+		r = newLimitedInput(r, opts.maxSize)
+	}
+
+	var text *textReader
+	if opts.ignore {
+		text = newGarbageTextReader(r)
+	} else {
+		text = newTextReader(r)
+	}
+
+	if !opts.verify {
+		return text.moveError(copyDecoded(w, text, opts))
+	}
+
+	verifier := newVerifyWriter(w)
+	if err := copyDecoded(verifier, text, opts); err != nil {
+		return text.moveError(err)
+	}
+
+	return verifier.verify()
+}
+
+// newEncoder returns the encoder that opts select, which writes to w.
+func newEncoder(w io.Writer, opts options) io.WriteCloser {
+	if opts.jobs != 1 {
+		return newParallelEncoder(w, opts)
+	}
+
+	return encoding(opts).NewEncoder(w)
+}
+
+// copyDecoded decodes the text from r as opts select and writes the decoded data to w.
+func copyDecoded(w io.Writer, r io.Reader, opts options) error {
+	if opts.jobs == 1 {
+		_, err := io.Copy(w, encoding(opts).NewDecoder(r))
+		return err
+	}
+
+	decoder := newParallelDecoder(w, opts)
+	if _, err := io.Copy(decoder, r); err != nil {
+		return err
+	}
+
+	return decoder.Close()
+}
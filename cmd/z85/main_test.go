@@ -0,0 +1,1460 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/png"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+	"github.com/xformerfhs/z85/internal/qr"
+	"github.com/xformerfhs/z85/keys85"
+)
+
+// ******** Private constants ********
+
+// encodedTheOne is the encoding of clearTheOne.
+const encodedTheOne = `HelloWorld`
+
+// czmqSecretCert is a secret certificate in the format of czmq with the server keys of the CURVE tests of libzmq.
+const czmqSecretCert = `#   ****  Generated on 2026-10-17 12:00:00 by CZMQ  ****
+#   ZeroMQ CURVE **Secret** Certificate
+#   DO NOT PROVIDE THIS FILE TO OTHER USERS nor change its permissions.
+
+metadata
+    # A comment in a section
+    name = "server # not a comment"
+    version = 1 # a comment
+curve
+    public-key = "rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7"
+    secret-key = "JTKVSB%%)wK0E.X)V>+}o?pNmC{O&4W4b!Ni{Lh6"
+`
+
+// ******** Private variables ********
+
+// clearTheOne is the data of the test case of the Z85 specification.
+var clearTheOne = []byte{0x86, 0x4f, 0xd2, 0x6f, 0xb5, 0x59, 0xf7, 0x5b}
+
+// ******** Test functions ********
+
+// TestEncode tests if the program encodes standard input.
+func TestEncode(t *testing.T) {
+	stdout, stderr, code := runWith(t, string(clearTheOne))
+	if code != exitOK {
+		t.Fatalf(`Encoding failed with exit code %d: %s`, code, stderr)
+	}
+
+	if stdout != encodedTheOne+"\n" {
+		t.Fatalf(`Encoding resulted in '%s' instead of '%s'`, stdout, encodedTheOne)
+	}
+}
+
+// TestDecode tests if the program decodes standard input with surrounding white space and a byte order mark.
+func TestDecode(t *testing.T) {
+	for _, input := range []string{
+		encodedTheOne,
+		encodedTheOne + "\n",
+		"\xef\xbb\xbf" + encodedTheOne + "\r\n",
+		"  " + encodedTheOne + "\t",
+	} {
+		stdout, stderr, code := runWith(t, input, `-d`)
+		if code != exitOK {
+			t.Fatalf(`Decoding of '%q' failed with exit code %d: %s`, input, code, stderr)
+		}
+
+		if stdout != string(clearTheOne) {
+			t.Fatalf(`Decoding of '%q' resulted in '% 02x'`, input, stdout)
+		}
+	}
+}
+
+// TestPadded tests if the padded encoding works with data of any length.
+func TestPadded(t *testing.T) {
+	for _, data := range []string{``, `a`, `ab`, `abc`, `abcd`, `abcde`} {
+		encoded, stderr, code := runWith(t, data, `-p`)
+		if code != exitOK {
+			t.Fatalf(`Padded encoding of '%s' failed with exit code %d: %s`, data, code, stderr)
+		}
+
+		decoded, stderr, code := runWith(t, encoded, `-d`, `-p`)
+		if code != exitOK {
+			t.Fatalf(`Padded decoding of '%s' failed with exit code %d: %s`, encoded, code, stderr)
+		}
+
+		if decoded != data {
+			t.Fatalf(`Round trip of '%s' resulted in '%s'`, data, decoded)
+		}
+	}
+}
+
+// TestErrors tests if errors result in the right exit codes and messages.
+func TestErrors(t *testing.T) {
+	for _, test := range []struct {
+		input   string
+		args    []string
+		code    int
+		message string
+	}{
+		{`abc`, nil, exitError, `-p`},
+		{`Hello~orld`, []string{`-d`}, exitError, `invalid`},
+		{`Hell`, []string{`-d`}, exitError, `multiple of 5`},
+		{``, []string{`-x`}, exitUsage, `Usage`},
+		{``, []string{`input`, `extra`}, exitUsage, `unexpected argument`},
+		{``, []string{`does-not-exist`}, exitError, `does-not-exist`},
+	} {
+		_, stderr, code := runWith(t, test.input, test.args...)
+		if code != test.code {
+			t.Fatalf(`Arguments %v with input '%s' resulted in exit code %d instead of %d`, test.args, test.input, code, test.code)
+		}
+
+		if !strings.Contains(stderr, test.message) {
+			t.Fatalf(`Message for arguments %v with input '%s' does not contain '%s': %s`, test.args, test.input, test.message, stderr)
+		}
+	}
+}
+
+// TestErrorOffsets tests if errors are reported at their offsets in the input and not in the filtered text.
+func TestErrorOffsets(t *testing.T) {
+	wrapped := strings.Repeat("HelloWorld\r\n", 3) + `Hello` + "\r\n" + `Wo~ld`
+	for _, test := range []struct {
+		input string
+		args  []string
+		want  string
+	}{
+		{"-----BEGIN Z85-----\nHelloWo~ld\n-----END Z85-----\n", []string{`-d`}, `position 27`},
+		{`Hello Wo~ld`, []string{`-d`}, `position 8`},
+		{"\ufeffHel lo  Wo~ld", []string{`-d`}, `position 13`},
+		{wrapped, []string{`-d`}, `position 45`},
+		{wrapped, []string{`-d`, `-j`, `2`}, `position 45`},
+		{`Hel~lo #####`, []string{`-d`, `-i`}, `position 7`},
+		{"-----BEGIN Z85-----\nHello Wo~ld\n", []string{`convert`, `--from`, `z85`, `--to`, `hex`}, `position 28`},
+	} {
+		_, stderr, code := runWith(t, test.input, test.args...)
+		if code != exitError || !strings.Contains(stderr, test.want) {
+			t.Fatalf(`Input %q with arguments %v resulted in exit code %d and error '%s' instead of '%s'`, test.input, test.args, code, stderr, test.want)
+		}
+	}
+}
+
+// TestTextReaderOffsets tests if the offsets of wrapped text are mapped to the input with a few runs.
+func TestTextReaderOffsets(t *testing.T) {
+	input := "-----BEGIN Z85-----\n" + strings.Repeat("HelloWorld\n", 10000) + "-----END Z85-----\n"
+	text := newTextReader(strings.NewReader(input))
+	if _, err := io.Copy(io.Discard, text); err != nil {
+		t.Fatalf(`Reading failed: %v`, err)
+	}
+
+	if len(text.runs) > 2 {
+		t.Fatalf(`Wrapped text needs %d runs`, len(text.runs))
+	}
+
+	for _, offset := range []int64{0, 9, 10, 12345, 99999} {
+		expected := 20 + offset + offset/10
+		if actual := text.inputOffset(offset); actual != expected {
+			t.Fatalf(`Offset %d is mapped to %d instead of %d`, offset, actual, expected)
+		}
+	}
+}
+
+// TestFiles tests if the program encodes and decodes named files.
+func TestFiles(t *testing.T) {
+	dir := t.TempDir()
+	clearName := filepath.Join(dir, `clear.bin`)
+	encodedName := filepath.Join(dir, `encoded.txt`)
+	decodedName := filepath.Join(dir, `decoded.bin`)
+
+	data := make([]byte, 3*bufferSize+4)
+	_, _ = crand.Read(data)
+	if err := os.WriteFile(clearName, data, 0o600); err != nil {
+		t.Fatalf(`Writing test file failed: %v`, err)
+	}
+
+	if _, stderr, code := runWith(t, ``, `-o`, encodedName, clearName); code != exitOK {
+		t.Fatalf(`Encoding of file failed with exit code %d: %s`, code, stderr)
+	}
+
+	if _, stderr, code := runWith(t, ``, `-d`, `-o`, decodedName, encodedName); code != exitOK {
+		t.Fatalf(`Decoding of file failed with exit code %d: %s`, code, stderr)
+	}
+
+	decoded, err := os.ReadFile(decodedName)
+	if err != nil {
+		t.Fatalf(`Reading decoded file failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, data) {
+		t.Fatal(`Round trip of file did not result in the original data`)
+	}
+
+	checkDirectory(t, dir, 3)
+}
+
+// TestFilesCleanup tests if an error does not leave an output file behind and does not change an existing one.
+func TestFilesCleanup(t *testing.T) {
+	dir := t.TempDir()
+	invalidName := filepath.Join(dir, `invalid.txt`)
+	outputName := filepath.Join(dir, `output.bin`)
+	existingName := filepath.Join(dir, `existing.bin`)
+
+	invalid := strings.Repeat(encodedTheOne, bufferSize) + `~~~~~`
+	if err := os.WriteFile(invalidName, []byte(invalid), 0o600); err != nil {
+		t.Fatalf(`Writing test file failed: %v`, err)
+	}
+
+	if _, _, code := runWith(t, ``, `-d`, `-o`, outputName, invalidName); code != exitError {
+		t.Fatalf(`Decoding of invalid file resulted in exit code %d`, code)
+	}
+
+	checkDirectory(t, dir, 1)
+
+	if err := os.WriteFile(existingName, clearTheOne, 0o600); err != nil {
+		t.Fatalf(`Writing test file failed: %v`, err)
+	}
+
+	if _, _, code := runWith(t, ``, `-d`, `-o`, existingName, invalidName); code != exitError {
+		t.Fatalf(`Decoding of invalid file resulted in exit code %d`, code)
+	}
+
+	existing, _ := os.ReadFile(existingName)
+	if !bytes.Equal(existing, clearTheOne) {
+		t.Fatal(`Failed decoding changed the existing output file`)
+	}
+
+	checkDirectory(t, dir, 2)
+}
+
+// TestFilesPermissions tests if a new output file gets the permissions of os.Create
+// and if an existing one keeps its permissions.
+func TestFilesPermissions(t *testing.T) {
+	if runtime.GOOS == `windows` {
+		t.Skip(`Windows does not have Unix permissions`)
+	}
+
+	dir := t.TempDir()
+	newName := filepath.Join(dir, `new.txt`)
+	existingName := filepath.Join(dir, `existing.txt`)
+
+	if err := os.WriteFile(existingName, nil, 0o640); err != nil {
+		t.Fatalf(`Writing test file failed: %v`, err)
+	}
+
+	for _, test := range []struct {
+		name string
+		mode os.FileMode
+	}{
+		{newName, newFileMode &^ umask()},
+		{existingName, 0o640},
+	} {
+		if _, stderr, code := runWith(t, string(clearTheOne), `-o`, test.name); code != exitOK {
+			t.Fatalf(`Encoding into '%s' failed with exit code %d: %s`, test.name, code, stderr)
+		}
+
+		info, _ := os.Stat(test.name)
+		if info.Mode().Perm() != test.mode {
+			t.Fatalf(`File '%s' has the permissions %v instead of %v`, test.name, info.Mode().Perm(), test.mode)
+		}
+	}
+}
+
+// TestDecodeWhiteSpace tests if white space anywhere in the encoded text is ignored.
+func TestDecodeWhiteSpace(t *testing.T) {
+	stdout, stderr, code := runWith(t, "Hello\r\n Wor\tld\n", `-d`)
+	if code != exitOK {
+		t.Fatalf(`Decoding failed with exit code %d: %s`, code, stderr)
+	}
+
+	if stdout != string(clearTheOne) {
+		t.Fatalf(`Decoding resulted in '% 02x'`, stdout)
+	}
+}
+
+// TestFormats tests if the output formats are written as expected and can be decoded.
+func TestFormats(t *testing.T) {
+	data := string(clearTheOne) + string(clearTheOne)
+
+	for _, test := range []struct {
+		args     []string
+		expected string
+	}{
+		{nil, "HelloWorldHelloWorld\n"},
+		{[]string{`-r`}, `HelloWorldHelloWorld`},
+		{[]string{`-w`, `8`}, "HelloWor\nldHelloW\norld\n"},
+		{[]string{`-g`, `5`}, "Hello World Hello World\n"},
+		{[]string{`-g`, `5`, `-s`, `_`}, "Hello_World_Hello_World\n"},
+		{[]string{`-g`, `5`, `-w`, `10`}, "Hello World\nHello World\n"},
+		{[]string{`-a`}, "-----BEGIN Z85-----\nHelloWorldHelloWorld\n-----END Z85-----\n"},
+		{[]string{`-a`, `-p`}, "-----BEGIN Z85P-----\nHelloWorldHelloWorld0\n-----END Z85P-----\n"},
+	} {
+		stdout, stderr, code := runWith(t, data, test.args...)
+		if code != exitOK {
+			t.Fatalf(`Encoding with %v failed with exit code %d: %s`, test.args, code, stderr)
+		}
+
+		if stdout != test.expected {
+			t.Fatalf(`Encoding with %v resulted in '%q' instead of '%q'`, test.args, stdout, test.expected)
+		}
+
+		decodeArgs := []string{`-d`}
+		if len(test.args) > 1 && test.args[1] == `-p` {
+			decodeArgs = append(decodeArgs, `-p`)
+		}
+
+		decoded, stderr, code := runWith(t, stdout, decodeArgs...)
+		if code != exitOK {
+			t.Fatalf(`Decoding of '%q' failed with exit code %d: %s`, stdout, code, stderr)
+		}
+
+		if decoded != data {
+			t.Fatalf(`Decoding of '%q' resulted in '% 02x'`, stdout, decoded)
+		}
+	}
+}
+
+// TestArmorDashes tests if encoded lines that start with dashes are not mistaken for armor lines.
+func TestArmorDashes(t *testing.T) {
+	encoded := "-----HelloWorld\n-----\n"
+	stdout, stderr, code := runWith(t, encoded, `-d`)
+	if code != exitOK {
+		t.Fatalf(`Decoding failed with exit code %d: %s`, code, stderr)
+	}
+
+	if len(stdout) != 16 {
+		t.Fatalf(`Decoding resulted in %d bytes instead of 16`, len(stdout))
+	}
+}
+
+// TestFormatErrors tests if invalid formatting options are rejected.
+func TestFormatErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{`-w`, `-1`},
+		{`-g`, `-1`},
+		{`-s`, `x`},
+		{`-s`, `__`},
+		{`-r`, `-a`},
+		{`-r`, `-w`, `10`},
+		{`-j`, `-1`},
+		{`--check`, `-d`},
+		{`--verify`},
+		{`--check`, `-p`},
+		{`-d`, `--verify`, `-p`},
+	} {
+		_, _, code := runWith(t, ``, args...)
+		if code != exitUsage {
+			t.Fatalf(`Arguments %v resulted in exit code %d instead of %d`, args, code, exitUsage)
+		}
+	}
+}
+
+// TestIgnoreGarbage tests if characters outside the Z85 alphabet are only ignored with -i.
+func TestIgnoreGarbage(t *testing.T) {
+	const input = "\"Hello\\\n`World`\",\x80~|'\n"
+
+	for _, flag := range []string{`-i`, `--ignore-garbage`} {
+		stdout, stderr, code := runWith(t, input, `-d`, flag)
+		if code != exitOK {
+			t.Fatalf(`Decoding with %s failed with exit code %d: %s`, flag, code, stderr)
+		}
+
+		if stdout != string(clearTheOne) {
+			t.Fatalf(`Decoding with %s did not result in expected bytes, but '% 02x'`, flag, stdout)
+		}
+	}
+
+	_, stderr, code := runWith(t, input, `-d`)
+	if code != exitError || !strings.Contains(stderr, `invalid`) {
+		t.Fatalf(`Decoding without -i resulted in exit code %d: %s`, code, stderr)
+	}
+}
+
+// TestChecksum tests if a checksum is appended and verified like z85.EncodeCheck and z85.DecodeCheck do it.
+func TestChecksum(t *testing.T) {
+	data := make([]byte, 64)
+	_, _ = crand.Read(data)
+
+	encoded, stderr, code := runWith(t, string(data), `--check`, `-w`, `20`)
+	if code != exitOK {
+		t.Fatalf(`Encoding with checksum failed with exit code %d: %s`, code, stderr)
+	}
+
+	expected, _ := z85.EncodeCheck(data)
+	if actual := strings.ReplaceAll(encoded, "\n", ``); actual != expected {
+		t.Fatalf(`Encoding with checksum resulted in '%s' instead of '%s'`, actual, expected)
+	}
+
+	decoded, stderr, code := runWith(t, encoded, `-d`, `--verify`)
+	if code != exitOK {
+		t.Fatalf(`Decoding with checksum failed with exit code %d: %s`, code, stderr)
+	}
+
+	if decoded != string(data) {
+		t.Fatalf(`Decoding with checksum did not result in expected bytes, but '% 02x'`, decoded)
+	}
+
+	for _, test := range []struct {
+		input   string
+		message string
+	}{
+		{expected[:10] + `0` + expected[11:], `does not match`},
+		{expected[:len(expected)-5], `does not match`},
+		{``, `checksum`},
+	} {
+		_, stderr, code = runWith(t, test.input, `-d`, `--verify`)
+		if code != exitError || !strings.Contains(stderr, test.message) {
+			t.Fatalf(`Verifying '%s' resulted in exit code %d: %s`, test.input, code, stderr)
+		}
+	}
+}
+
+// TestVerifyWriterSplits tests if the checksum is found independently of the sizes of the writes.
+func TestVerifyWriterSplits(t *testing.T) {
+	data := make([]byte, 32)
+	_, _ = crand.Read(data)
+
+	encoded, _ := z85.EncodeCheck(data)
+	checked, _ := z85.Decode(encoded)
+
+	for size := 1; size <= len(checked); size++ {
+		var buffer bytes.Buffer
+		verifier := newVerifyWriter(&buffer)
+		for start := 0; start < len(checked); start += size {
+			_, _ = verifier.Write(checked[start:min(start+size, len(checked))])
+		}
+
+		if err := verifier.verify(); err != nil {
+			t.Fatalf(`Verification with writes of %d bytes failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(buffer.Bytes(), data) {
+			t.Fatalf(`Writes of %d bytes did not result in expected bytes, but '% 02x'`, size, buffer.Bytes())
+		}
+	}
+}
+
+// TestMaxSize tests if decoding fails when the input is larger than the maximum size.
+func TestMaxSize(t *testing.T) {
+	input := encodedTheOne + "\n"
+	for _, jobs := range []string{`1`, `2`} {
+		stdout, stderr, code := runWith(t, input, `-d`, `-j`, jobs, `--max-size`, fmt.Sprint(len(input)))
+		if code != exitOK || stdout != string(clearTheOne) {
+			t.Fatalf(`Input of the maximum size resulted in exit code %d: %s`, code, stderr)
+		}
+
+		_, stderr, code = runWith(t, input, `-d`, `-j`, jobs, `--max-size`, fmt.Sprint(len(input)-1))
+		if code != exitError || !strings.Contains(stderr, `larger than the maximum size of 10 bytes`) {
+			t.Fatalf(`Input above the maximum size resulted in exit code %d: %s`, code, stderr)
+		}
+	}
+
+	dir := t.TempDir()
+	encodedName := filepath.Join(dir, `encoded.txt`)
+	if err := os.WriteFile(encodedName, []byte(strings.Repeat(encodedTheOne, bufferSize)), 0o600); err != nil {
+		t.Fatalf(`Writing test file failed: %v`, err)
+	}
+
+	if _, _, code := runWith(t, ``, `-d`, `--max-size`, `1000`, `-o`, filepath.Join(dir, `decoded.bin`), encodedName); code != exitError {
+		t.Fatalf(`Large file resulted in exit code %d`, code)
+	}
+
+	checkDirectory(t, dir, 1)
+
+	for _, args := range [][]string{
+		{`--max-size`, `10`},
+		{`-d`, `--max-size`, `-1`},
+	} {
+		if _, _, code := runWith(t, input, args...); code != exitUsage {
+			t.Fatalf(`Arguments %v resulted in exit code %d instead of %d`, args, code, exitUsage)
+		}
+	}
+}
+
+// TestNewline tests if --newline and --no-newline control the final line feed of the encoded text and the decoded data.
+func TestNewline(t *testing.T) {
+	for _, test := range []struct {
+		args     []string
+		input    string
+		expected string
+	}{
+		{[]string{}, string(clearTheOne), encodedTheOne + "\n"},
+		{[]string{`--newline`}, string(clearTheOne), encodedTheOne + "\n"},
+		{[]string{`--no-newline`}, string(clearTheOne), encodedTheOne},
+		{[]string{`-d`}, "nm^5N\n", "Hey\n"},
+		{[]string{`-d`, `--newline`}, "nm^5N\n", "Hey\n"},
+		{[]string{`-d`, `--no-newline`}, "nm^5N\n", "Hey"},
+		{[]string{`-d`, `-j`, `2`, `--no-newline`}, "nm^5N\n", "Hey"},
+		{[]string{`-d`, `--newline`}, encodedTheOne, string(clearTheOne) + "\n"},
+		{[]string{`-d`, `--no-newline`}, encodedTheOne, string(clearTheOne)},
+		{[]string{`-d`, `--newline`}, ``, "\n"},
+		{[]string{`-d`, `--no-newline`}, ``, ``},
+	} {
+		stdout, stderr, code := runWith(t, test.input, test.args...)
+		if code != exitOK || stdout != test.expected {
+			t.Fatalf(`Arguments %v resulted in exit code %d and '%q' instead of '%q': %s`, test.args, code, stdout, test.expected, stderr)
+		}
+	}
+
+	for _, args := range [][]string{
+		{`--newline`, `--no-newline`},
+		{`--newline`, `-r`},
+		{`--no-newline`, `-w`, `10`},
+		{`--no-newline`, `-a`},
+	} {
+		if _, _, code := runWith(t, string(clearTheOne), args...); code != exitUsage {
+			t.Fatalf(`Arguments %v resulted in exit code %d instead of %d`, args, code, exitUsage)
+		}
+	}
+}
+
+// TestLineEndWriterSplits tests if a final line feed is removed, regardless of how the data is split into writes.
+func TestLineEndWriterSplits(t *testing.T) {
+	data := []byte("line\n\nend\n")
+	for size := 1; size <= len(data); size++ {
+		var buffer bytes.Buffer
+		lineEnd := newLineEndWriter(&buffer, false)
+		for start := 0; start < len(data); start += size {
+			_, _ = lineEnd.Write(data[start:min(start+size, len(data))])
+		}
+
+		if err := lineEnd.finish(); err != nil || buffer.String() != "line\n\nend" {
+			t.Fatalf(`Writes of %d bytes resulted in '%q'`, size, buffer.String())
+		}
+	}
+}
+
+// TestInspect tests if the inspect command prints the groups and marks invalid characters.
+func TestInspect(t *testing.T) {
+	stdout, stderr, code := runWith(t, "\xef\xbb\xbf"+encodedTheOne+"\n", `inspect`)
+	if code != exitOK {
+		t.Fatalf(`Inspection failed with exit code %d: %s`, code, stderr)
+	}
+
+	for _, expected := range []string{
+		"       0  Hello  864fd26f  86 4f d2 6f            0\n",
+		"       5  World  b559f75b  b5 59 f7 5b            4\n",
+		`variant: Z85, canonical: true`,
+	} {
+		if !strings.Contains(stdout, expected) {
+			t.Fatalf(`Inspection does not contain '%s': %s`, expected, stdout)
+		}
+	}
+
+	stdout, _, code = runWith(t, "-----BEGIN Z85-----\nHello\n Wor~d%%%%%ab\n", `inspect`)
+	if code != exitError {
+		t.Fatalf(`Inspection of invalid text resulted in exit code %d instead of %d`, code, exitError)
+	}
+
+	for _, expected := range []string{
+		"       5  Wor~d  invalid character '~' at position 30, line 3, column 5\n             ^\n",
+		`value exceeds 32 bits`,
+		`incomplete group of 2 characters`,
+		`variant: none`,
+	} {
+		if !strings.Contains(stdout, expected) {
+			t.Fatalf(`Inspection of invalid text does not contain '%s': %s`, expected, stdout)
+		}
+	}
+
+	if strings.Contains(stdout, `canonical`) {
+		t.Fatalf(`Inspection of invalid text reports a canonical form: %s`, stdout)
+	}
+
+	stdout, _, code = runWith(t, `k%^{C0`, `inspect`, `-p`)
+	if code != exitOK || !strings.Contains(stdout, `padding of 0 bytes`) {
+		t.Fatalf(`Inspection of padded text resulted in exit code %d: %s`, code, stdout)
+	}
+
+	stdout, _, code = runWith(t, `k%^{C4`, `inspect`, `-p`)
+	if code != exitError || !strings.Contains(stdout, `invalid padding count '4'`) {
+		t.Fatalf(`Inspection of invalid padding resulted in exit code %d: %s`, code, stdout)
+	}
+}
+
+// TestSelftest tests if the selftest command passes and reports failing tests.
+func TestSelftest(t *testing.T) {
+	stdout, _, code := runWith(t, ``, `selftest`)
+	if code != exitOK || !strings.Contains(stdout, `all 11 tests passed`) {
+		t.Fatalf(`Self test resulted in exit code %d: %s`, code, stdout)
+	}
+
+	savedTests := selfTests
+	defer func() { selfTests = savedTests }()
+
+	selfTests = []selfTest{
+		{`passing`, func() error { return nil }},
+		{`failing`, func() error { return errors.New(`broken`) }},
+	}
+
+	stdout, _, code = runWith(t, ``, `selftest`)
+	if code != exitError || !strings.Contains(stdout, "FAIL  failing: broken\n") || !strings.Contains(stdout, `1 of 2 tests failed`) {
+		t.Fatalf(`Self test with a failing test resulted in exit code %d: %s`, code, stdout)
+	}
+}
+
+// TestJSON tests if results and errors are written as JSON.
+func TestJSON(t *testing.T) {
+	for _, test := range []struct {
+		input    string
+		args     []string
+		expected string
+	}{
+		{string(clearTheOne), []string{`-r`}, `{"operation":"encode","encoding":"Z85","inputLength":8,"outputLength":10,"encoded":"HelloWorld"}`},
+		{encodedTheOne, []string{`-d`}, `{"operation":"decode","encoding":"Z85","inputLength":10,"outputLength":8,"decoded":"hk/Sb7VZ91s="}`},
+	} {
+		stdout, stderr, code := runWith(t, test.input, append([]string{`--json`}, test.args...)...)
+		if code != exitOK {
+			t.Fatalf(`Arguments %v resulted in exit code %d: %s`, test.args, code, stderr)
+		}
+
+		if stdout != test.expected+"\n" {
+			t.Fatalf(`Arguments %v resulted in '%s' instead of '%s'`, test.args, stdout, test.expected)
+		}
+	}
+
+	for _, test := range []struct {
+		input string
+		args  []string
+		code  int
+		kind  string
+	}{
+		{`Hello Wor~d`, []string{`-d`}, exitError, `invalid_byte`},
+		{`abc`, nil, exitError, `invalid_length`},
+		{encodedTheOne, []string{`-d`, `--verify`}, exitError, `checksum_mismatch`},
+		{``, []string{`does-not-exist`}, exitError, jsonCodeFile},
+		{``, []string{`-w`, `-1`}, exitUsage, jsonCodeUsage},
+		{``, []string{`input`, `extra`}, exitUsage, jsonCodeUsage},
+	} {
+		stdout, stderr, code := runWith(t, test.input, append([]string{`--json`}, test.args...)...)
+		if code != test.code || stdout != `` {
+			t.Fatalf(`Arguments %v resulted in exit code %d instead of %d and output '%s'`, test.args, code, test.code, stdout)
+		}
+
+		var report struct {
+			Error struct {
+				Code          string `json:"code"`
+				EncodedOffset int64  `json:"encodedOffset"`
+				Byte          byte   `json:"byte"`
+			} `json:"error"`
+		}
+
+		if err := json.Unmarshal([]byte(stderr), &report); err != nil {
+			t.Fatalf(`Error of arguments %v is no JSON: %s`, test.args, stderr)
+		}
+
+		if report.Error.Code != test.kind {
+			t.Fatalf(`Error of arguments %v has the code '%s' instead of '%s'`, test.args, report.Error.Code, test.kind)
+		}
+
+		if test.kind == `invalid_byte` && (report.Error.EncodedOffset != 9 || report.Error.Byte != '~') {
+			t.Fatalf(`Invalid byte was reported at offset %d with byte %q`, report.Error.EncodedOffset, report.Error.Byte)
+		}
+	}
+}
+
+// TestParallel tests if parallel workers result in the same output as one worker, also at block boundaries.
+func TestParallel(t *testing.T) {
+	data := make([]byte, 2*parallelBlockSize+8)
+	_, _ = crand.Read(data)
+
+	for _, size := range []int{0, 4, parallelBlockSize, 2 * parallelBlockSize, 2*parallelBlockSize + 4, 2*parallelBlockSize + 7} {
+		for _, padded := range []bool{false, true} {
+			if size%4 != 0 && !padded {
+				continue
+			}
+
+			args := []string{`-w`, `76`}
+			if padded {
+				args = append(args, `-p`)
+			}
+
+			expected, _, _ := runWith(t, string(data[:size]), args...)
+			encoded, stderr, code := runWith(t, string(data[:size]), append(args, `-j`, `3`)...)
+			if code != exitOK || encoded != expected {
+				t.Fatalf(`Parallel encoding of %d bytes with arguments %v differs with exit code %d: %s`, size, args, code, stderr)
+			}
+
+			decoded, stderr, code := runWith(t, encoded, append(args, `-d`, `-j`, `2`)...)
+			if code != exitOK || decoded != string(data[:size]) {
+				t.Fatalf(`Parallel decoding of %d bytes with arguments %v differs with exit code %d: %s`, size, args, code, stderr)
+			}
+		}
+	}
+
+	encoded, _ := z85.StdEncoding.EncodeToString(data[:2*parallelBlockSize])
+	position := parallelEncodedBlockSize + 7
+	for _, invalid := range []byte{'~', 0x01} {
+		_, stderr, code := runWith(t, encoded[:position]+string([]byte{invalid})+encoded[position+1:], `-d`, `-j`, `2`)
+		expected := fmt.Sprintf(`at position %d: %q`, position, invalid)
+		if code != exitError || !strings.Contains(stderr, expected) {
+			t.Fatalf(`Invalid character %q in the second block resulted in exit code %d: %s`, invalid, code, stderr)
+		}
+	}
+
+	position = parallelEncodedBlockSize + 5
+	_, stderr, code := runWith(t, encoded[:position]+`%%%%%`+encoded[position+5:], `-d`, `-j`, `2`)
+	if code != exitError || !strings.Contains(stderr, fmt.Sprintf(`chunk at position %d exceeds`, position)) {
+		t.Fatalf(`Overflow in the second block resulted in exit code %d: %s`, code, stderr)
+	}
+}
+
+// TestKeygen tests if the keygen command generates matching keys and writes the secret key to a private file.
+func TestKeygen(t *testing.T) {
+	stdout, stderr, code := runWith(t, ``, `keygen`)
+	if code != exitOK {
+		t.Fatalf(`Key generation failed with exit code %d: %s`, code, stderr)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(stdout, "\n"), "\n")
+	if len(lines) != 4 || lines[0] != publicKeyHeader || lines[2] != secretKeyHeader {
+		t.Fatalf(`Key generation resulted in unexpected output: %s`, stdout)
+	}
+
+	checkKeyPair(t, lines[1], lines[3])
+
+	secretName := filepath.Join(t.TempDir(), `secret.key`)
+	stdout, stderr, code = runWith(t, ``, `keygen`, `-secret`, secretName)
+	if code != exitOK {
+		t.Fatalf(`Key generation with a secret file failed with exit code %d: %s`, code, stderr)
+	}
+
+	lines = strings.Split(strings.TrimSuffix(stdout, "\n"), "\n")
+	if len(lines) != 2 || lines[0] != publicKeyHeader {
+		t.Fatalf(`Key generation with a secret file resulted in unexpected output: %s`, stdout)
+	}
+
+	secret, err := os.ReadFile(secretName)
+	if err != nil {
+		t.Fatalf(`Reading the secret file failed: %v`, err)
+	}
+
+	checkKeyPair(t, lines[1], strings.TrimSuffix(string(secret), "\n"))
+
+	if runtime.GOOS != `windows` {
+		info, _ := os.Stat(secretName)
+		if info.Mode().Perm() != secretFileMode {
+			t.Fatalf(`Secret file has the permissions %v`, info.Mode().Perm())
+		}
+	}
+
+	_, stderr, code = runWith(t, ``, `keygen`, `-secret`, secretName)
+	if code != exitError || !strings.Contains(stderr, `exists`) {
+		t.Fatalf(`Existing secret file resulted in exit code %d: %s`, code, stderr)
+	}
+
+	if changed, _ := os.ReadFile(secretName); !bytes.Equal(changed, secret) {
+		t.Fatal(`Existing secret file has been overwritten`)
+	}
+}
+
+// TestCertCreate tests if the cert create command writes a public and a secret certificate that can be read again.
+func TestCertCreate(t *testing.T) {
+	name := filepath.Join(t.TempDir(), `server.cert`)
+	stdout, stderr, code := runWith(t, ``, `cert`, `create`, `-m`, `name=server`, `-m`, `organization=Example Inc.`, name)
+	if code != exitOK {
+		t.Fatalf(`Creating certificates failed with exit code %d: %s`, code, stderr)
+	}
+
+	public, err := readCertificate(name, nil)
+	if err != nil {
+		t.Fatalf(`Reading the public certificate failed: %v`, err)
+	}
+
+	secret, err := readCertificate(name+secretCertSuffix, nil)
+	if err != nil {
+		t.Fatalf(`Reading the secret certificate failed: %v`, err)
+	}
+
+	if stdout != `public-key = "`+public.public+"\"\n" || public.secret != `` || secret.public != public.public {
+		t.Fatalf(`Certificates do not contain the expected keys: %s`, stdout)
+	}
+
+	checkKeyPair(t, secret.public, secret.secret)
+
+	expected := []certField{{`name`, `server`}, {`organization`, `Example Inc.`}}
+	for _, cert := range []*certificate{public, secret} {
+		if fmt.Sprint(cert.metadata) != fmt.Sprint(expected) {
+			t.Fatalf(`Certificate contains the metadata %v instead of %v`, cert.metadata, expected)
+		}
+	}
+
+	if runtime.GOOS != `windows` {
+		info, _ := os.Stat(name + secretCertSuffix)
+		if info.Mode().Perm() != secretFileMode {
+			t.Fatalf(`Secret certificate has the permissions %v`, info.Mode().Perm())
+		}
+	}
+
+	for _, args := range [][]string{
+		{name},
+		{`-m`, `name`, name + `.other`},
+		{`-m`, `name=a"b`, name + `.other`},
+		{},
+	} {
+		_, _, code = runWith(t, ``, append([]string{`cert`, `create`}, args...)...)
+		if code == exitOK {
+			t.Fatalf(`Arguments %v did not result in an error`, args)
+		}
+	}
+
+	checkDirectory(t, filepath.Dir(name), 2)
+}
+
+// TestCertShow tests if the cert show command reads certificates of czmq and checks their keys.
+func TestCertShow(t *testing.T) {
+	stdout, stderr, code := runWith(t, czmqSecretCert, `cert`, `show`, `-`)
+	if code != exitOK {
+		t.Fatalf(`Showing the certificate failed with exit code %d: %s`, code, stderr)
+	}
+
+	expected := "certificate: secret\n" +
+		"public-key: rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7\n" +
+		"secret-key: JTKVSB%%)wK0E.X)V>+}o?pNmC{O&4W4b!Ni{Lh6\n" +
+		"name: server # not a comment\n" +
+		"version: 1\n"
+	if stdout != expected {
+		t.Fatalf(`Showing the certificate resulted in '%s' instead of '%s'`, stdout, expected)
+	}
+
+	for _, test := range []struct {
+		cert    string
+		message string
+	}{
+		{strings.Replace(czmqSecretCert, `JTKVSB`, `JTKVSC`, 1), `does not belong`},
+		{strings.Replace(czmqSecretCert, `rq:rM`, `rq:r~`, 1), `invalid public-key`},
+		{strings.Replace(czmqSecretCert, `    public-key`, `   public-key`, 1), `line 10: indentation`},
+		{strings.Replace(czmqSecretCert, `"server # not a comment"`, `"server`, 1), `line 7: value of 'name' has no closing quote`},
+		{"metadata\n", `does not contain a public-key`},
+	} {
+		_, stderr, code = runWith(t, test.cert, `cert`, `show`, `-`)
+		if code != exitError || !strings.Contains(stderr, test.message) {
+			t.Fatalf(`Invalid certificate resulted in exit code %d instead of an error with '%s': %s`, code, test.message, stderr)
+		}
+	}
+}
+
+// TestCertConvert tests if the cert convert command prints the keys in other formats.
+func TestCertConvert(t *testing.T) {
+	stdout, stderr, code := runWith(t, czmqSecretCert, `cert`, `convert`, `-to`, formatHex, `-`)
+	if code != exitOK {
+		t.Fatalf(`Converting the certificate failed with exit code %d: %s`, code, stderr)
+	}
+
+	public, _ := keys85.Decode32(`rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7`)
+	secret, _ := keys85.Decode32(`JTKVSB%%)wK0E.X)V>+}o?pNmC{O&4W4b!Ni{Lh6`)
+	expected := fmt.Sprintf("public-key = %x\nsecret-key = %x\n", public, secret)
+	if stdout != expected {
+		t.Fatalf(`Converting the certificate resulted in '%s' instead of '%s'`, stdout, expected)
+	}
+
+	for _, format := range []string{formatBinary, `z86`} {
+		_, _, code = runWith(t, czmqSecretCert, `cert`, `convert`, `-to`, format, `-`)
+		if code != exitUsage {
+			t.Fatalf(`Format '%s' resulted in exit code %d instead of %d`, format, code, exitUsage)
+		}
+	}
+}
+
+// TestCertDirectory tests if the cert show and fingerprint commands process each certificate file of a directory.
+func TestCertDirectory(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, `server.cert`)
+	if _, stderr, code := runWith(t, ``, `cert`, `create`, name); code != exitOK {
+		t.Fatalf(`Creating certificates failed with exit code %d: %s`, code, stderr)
+	}
+
+	_ = os.WriteFile(filepath.Join(dir, `.hidden`), []byte(`not a certificate`), 0o644)
+	_ = os.Mkdir(filepath.Join(dir, `sub`), 0o755)
+
+	stdout, stderr, code := runWith(t, ``, `cert`, `fingerprint`, dir)
+	if code != exitOK {
+		t.Fatalf(`Fingerprinting the directory failed with exit code %d: %s`, code, stderr)
+	}
+
+	cert, _ := readCertificate(name, nil)
+	public, _ := keys85.Decode32(cert.public)
+	fingerprint := fmt.Sprintf("%s%x", fingerprintPrefix, sha256.Sum256(public[:]))
+	expected := fingerprint + `  ` + name + "\n" + fingerprint + `  ` + name + secretCertSuffix + "\n"
+	if stdout != expected {
+		t.Fatalf(`Fingerprinting the directory resulted in '%s' instead of '%s'`, stdout, expected)
+	}
+
+	stdout, stderr, code = runWith(t, ``, `cert`, `show`, dir)
+	if code != exitOK {
+		t.Fatalf(`Showing the directory failed with exit code %d: %s`, code, stderr)
+	}
+
+	if !strings.HasPrefix(stdout, `file: `+name+"\ncertificate: public\n") ||
+		!strings.Contains(stdout, "\n\nfile: "+name+secretCertSuffix+"\ncertificate: secret\n") {
+		t.Fatalf(`Showing the directory resulted in '%s'`, stdout)
+	}
+
+	_ = os.WriteFile(filepath.Join(dir, `invalid.cert`), []byte("metadata\n"), 0o644)
+	stdout, stderr, code = runWith(t, ``, `cert`, `fingerprint`, dir)
+	if code != exitError || !strings.Contains(stderr, `invalid.cert`) || strings.Count(stdout, fingerprint) != 2 {
+		t.Fatalf(`Invalid certificate in the directory resulted in exit code %d: '%s' '%s'`, code, stdout, stderr)
+	}
+
+	stdout, _, _ = runWith(t, czmqSecretCert, `cert`, `fingerprint`, `-`)
+	if !strings.HasPrefix(stdout, fingerprintPrefix) || !strings.HasSuffix(stdout, "  -\n") {
+		t.Fatalf(`Fingerprinting stdin resulted in '%s'`, stdout)
+	}
+}
+
+// TestCertKeyFiles tests if the cert convert command writes plain key files and the cert import command reads them again.
+func TestCertKeyFiles(t *testing.T) {
+	dir := t.TempDir()
+	publicName := filepath.Join(dir, `server.key`)
+	secretName := filepath.Join(dir, `server.key_secret`)
+
+	for _, format := range []string{formatBinary, formatHex, formatZ85} {
+		_ = os.Remove(publicName)
+		_ = os.Remove(secretName)
+
+		_, stderr, code := runWith(t, czmqSecretCert, `cert`, `convert`, `-to`, format, `-public`, publicName, `-secret`, secretName, `-`)
+		if code != exitOK {
+			t.Fatalf(`Converting into %s key files failed with exit code %d: %s`, format, code, stderr)
+		}
+
+		if runtime.GOOS != `windows` {
+			info, _ := os.Stat(secretName)
+			if info.Mode().Perm() != secretFileMode {
+				t.Fatalf(`Secret key file has the permissions %v`, info.Mode().Perm())
+			}
+		}
+
+		for _, args := range [][]string{
+			{`-public`, publicName, `-secret`, secretName},
+			{`-secret`, secretName},
+			{`-public`, publicName},
+		} {
+			cert := filepath.Join(dir, format+`.cert`)
+			_ = os.Remove(cert)
+			_ = os.Remove(cert + secretCertSuffix)
+
+			stdout, stderr, code := runWith(t, ``, append([]string{`cert`, `import`, `-from`, format, `-m`, `name=server`}, append(args, cert)...)...)
+			if code != exitOK || stdout != "public-key = \"rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7\"\n" {
+				t.Fatalf(`Importing %v in %s resulted in exit code %d: '%s' '%s'`, args, format, code, stdout, stderr)
+			}
+
+			imported, err := readCertificate(cert, nil)
+			if err != nil || imported.metadata[0] != (certField{`name`, `server`}) {
+				t.Fatalf(`Imported certificate is invalid: %v`, err)
+			}
+
+			_, err = os.Stat(cert + secretCertSuffix)
+			if hasSecret := args[0] == `-secret` || len(args) > 2; hasSecret != (err == nil) {
+				t.Fatalf(`Importing %v resulted in a secret certificate: %v`, args, err == nil)
+			}
+
+			if err == nil {
+				secret, _ := readCertificate(cert+secretCertSuffix, nil)
+				if secret.secret != `JTKVSB%%)wK0E.X)V>+}o?pNmC{O&4W4b!Ni{Lh6` {
+					t.Fatalf(`Imported secret certificate contains the secret key '%s'`, secret.secret)
+				}
+			}
+		}
+	}
+
+	otherName := filepath.Join(dir, `other.key`)
+	_ = os.WriteFile(otherName, []byte(encodedTheOne), 0o644)
+	for _, test := range []struct {
+		args    []string
+		code    int
+		message string
+	}{
+		{[]string{`-public`, otherName}, exitError, `key has 8 bytes`},
+		{[]string{`-public`, filepath.Join(dir, `missing.key`)}, exitError, `missing.key`},
+		{[]string{`-from`, `z86`, `-public`, publicName}, exitUsage, `unknown format`},
+		{[]string{}, exitUsage, `expected a public or a secret key file`},
+	} {
+		_, stderr, code := runWith(t, ``, append([]string{`cert`, `import`}, append(test.args, filepath.Join(dir, `failed.cert`))...)...)
+		if code != test.code || !strings.Contains(stderr, test.message) {
+			t.Fatalf(`Arguments %v resulted in exit code %d instead of %d with '%s': %s`, test.args, code, test.code, test.message, stderr)
+		}
+	}
+
+	_, stderr, code := runWith(t, strings.Replace(czmqSecretCert, "    secret-key", "#", 1), `cert`, `convert`, `-to`, formatHex, `-secret`, filepath.Join(dir, `new.key`), `-`)
+	if code != exitError || !strings.Contains(stderr, `does not contain a secret-key`) {
+		t.Fatalf(`Public certificate resulted in exit code %d: %s`, code, stderr)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, `failed.cert`)); err == nil {
+		t.Fatal(`Failed import has written a certificate`)
+	}
+}
+
+// TestScan tests if the scan command finds keys and other encoded data, but no code.
+func TestScan(t *testing.T) {
+	blob, _ := z85.StdEncoding.EncodeToString(randomData(64))
+	input := "# Configuration\n" +
+		"server_key = \"rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7\"\n" +
+		"SECRET=JTKVSB%%)wK0E.X)V>+}o?pNmC{O&4W4b!Ni{Lh6\n" +
+		"v.reset(OpAMD64VCVTTPD2DQXMasked128load) context.WithCancel(context.Background())\n" +
+		"0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#\n" +
+		`{"blob": "` + blob + "\"}\n"
+
+	stdout, stderr, code := runWith(t, input, `scan`)
+	if code != exitError {
+		t.Fatalf(`Scan resulted in exit code %d instead of %d: %s`, code, exitError, stderr)
+	}
+
+	expected := "-:2:15: offset 30: key: rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7\n" +
+		"-:3:8: offset 79: secret-key: JTKVSB%%)wK0E.X)V>+}o?pNmC{O&4W4b!Ni{Lh6\n" +
+		fmt.Sprintf("-:6:11: offset %d: data: %s\n", strings.Index(input, blob), blob)
+	if stdout != expected {
+		t.Fatalf(`Scan resulted in '%s' instead of '%s'`, stdout, expected)
+	}
+
+	stdout, _, _ = runWith(t, input, `scan`, `-keys`, `-redact`)
+	expected = "-:2:15: offset 30: key: rq:r...]yf7\n" +
+		"-:3:8: offset 79: secret-key: JTKV...{Lh6\n"
+	if stdout != expected {
+		t.Fatalf(`Scan of keys resulted in '%s' instead of '%s'`, stdout, expected)
+	}
+
+	stdout, _, code = runWith(t, input, `scan`, `-min`, `100`)
+	if code != exitError || strings.Contains(stdout, `data`) {
+		t.Fatalf(`Scan with a minimum length resulted in exit code %d: %s`, code, stdout)
+	}
+
+	stdout, _, code = runWith(t, "no keys here\n", `scan`)
+	if code != exitOK || stdout != `` {
+		t.Fatalf(`Scan without keys resulted in exit code %d: %s`, code, stdout)
+	}
+}
+
+// TestScanDirectory tests if the scan command searches directory trees and skips version control directories.
+func TestScanDirectory(t *testing.T) {
+	dir := t.TempDir()
+	key := "key = rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7\n"
+	for _, name := range []string{`a.txt`, filepath.Join(`sub`, `b.txt`), filepath.Join(`.git`, `c.txt`)} {
+		path := filepath.Join(dir, name)
+		_ = os.MkdirAll(filepath.Dir(path), 0o755)
+		if err := os.WriteFile(path, []byte(key), 0o644); err != nil {
+			t.Fatalf(`Writing file failed: %v`, err)
+		}
+	}
+
+	stdout, stderr, code := runWith(t, ``, `scan`, `-keys`, dir, filepath.Join(dir, `does-not-exist`))
+	if code != exitError || !strings.Contains(stderr, `does-not-exist`) {
+		t.Fatalf(`Scan of a directory resulted in exit code %d: %s`, code, stderr)
+	}
+
+	expected := filepath.Join(dir, `a.txt`) + ":1:7: offset 6: key: rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7\n" +
+		filepath.Join(dir, `sub`, `b.txt`) + ":1:7: offset 6: key: rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7\n"
+	if stdout != expected {
+		t.Fatalf(`Scan of a directory resulted in '%s' instead of '%s'`, stdout, expected)
+	}
+}
+
+// TestScanErrors tests if errors in a directory tree are reported and the scan continues with the next entry.
+func TestScanErrors(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, `removed.txt`)
+	_ = os.WriteFile(name, nil, 0o644)
+	info, _ := os.Stat(name)
+	dirInfo, _ := os.Stat(dir)
+	_ = os.Remove(name)
+
+	var errs []error
+	walk := scanEntry(dir, scanOptions{}, nil, func(err error) { errs = append(errs, err) })
+
+	errTest := errors.New(`test error`)
+	for _, test := range []struct {
+		entry    fs.DirEntry
+		err      error
+		expected error
+	}{
+		{nil, errTest, nil},
+		{fs.FileInfoToDirEntry(dirInfo), errTest, filepath.SkipDir},
+		{fs.FileInfoToDirEntry(info), nil, nil},
+	} {
+		if result := walk(name, test.entry, test.err); result != test.expected {
+			t.Fatalf(`Walk function returned '%v' instead of '%v'`, result, test.expected)
+		}
+	}
+
+	if len(errs) != 3 || errs[0] != errTest || errs[1] != errTest || !errors.Is(errs[2], fs.ErrNotExist) {
+		t.Fatalf(`Walk function reported the errors %v`, errs)
+	}
+
+	// Permissions do not restrict the administrator.
+	if runtime.GOOS == `windows` || os.Geteuid() == 0 {
+		return
+	}
+
+	locked := filepath.Join(dir, `a`)
+	_ = os.Mkdir(locked, 0o000)
+	defer os.Chmod(locked, 0o755)
+
+	key := "key = rq:rM>}U?@Lns47E1%kR.o@n%FcmmsL/@{H8]yf7\n"
+	_ = os.WriteFile(filepath.Join(dir, `b.txt`), []byte(key), 0o644)
+
+	stdout, stderr, code := runWith(t, ``, `scan`, `-keys`, dir)
+	if code != exitError || !strings.Contains(stderr, locked) || !strings.Contains(stdout, `b.txt`) {
+		t.Fatalf(`Scan of a tree with an unreadable directory resulted in exit code %d: '%s' '%s'`, code, stdout, stderr)
+	}
+}
+
+// TestQR tests if the encoded text is written as a QR code in text and as a PNG image.
+func TestQR(t *testing.T) {
+	code, err := qr.Encode([]byte(encodedTheOne), qrLevel)
+	if err != nil {
+		t.Fatalf(`Encoding of QR code failed: %v`, err)
+	}
+
+	stdout, stderr, exitCode := runWith(t, string(clearTheOne), `--qr`)
+	if exitCode != exitOK {
+		t.Fatalf(`QR code resulted in exit code %d: %s`, exitCode, stderr)
+	}
+
+	if stdout != qrText(code) {
+		t.Fatalf(`QR code resulted in '%s' instead of '%s'`, stdout, qrText(code))
+	}
+
+	width := code.Size + 2*qrQuietZone
+	lines := strings.Split(strings.TrimSuffix(stdout, "\n"), "\n")
+	if len(lines) != (width+1)/2 || lines[0] != strings.Repeat(qrBoth, width) {
+		t.Fatalf(`QR code has %d lines instead of %d or no quiet zone`, len(lines), (width+1)/2)
+	}
+
+	name := filepath.Join(t.TempDir(), `key.PNG`)
+	if _, stderr, exitCode = runWith(t, string(clearTheOne), `--qr`, `-o`, name); exitCode != exitOK {
+		t.Fatalf(`QR image resulted in exit code %d: %s`, exitCode, stderr)
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		t.Fatalf(`Opening image failed: %v`, err)
+	}
+
+	defer file.Close()
+
+	img, err := png.Decode(file)
+	if err != nil {
+		t.Fatalf(`Decoding image failed: %v`, err)
+	}
+
+	if size := img.Bounds().Dx(); size != width*qrModuleSize || img.Bounds().Dy() != size {
+		t.Fatalf(`Image has size %v instead of %d`, img.Bounds(), width*qrModuleSize)
+	}
+
+	for y := 0; y < width; y++ {
+		for x := 0; x < width; x++ {
+			r, _, _, _ := img.At(x*qrModuleSize, y*qrModuleSize).RGBA()
+			if (r == 0) != code.Dark(x-qrQuietZone, y-qrQuietZone) {
+				t.Fatalf(`Module %d, %d of the image has the wrong colour`, x, y)
+			}
+		}
+	}
+
+	for _, args := range [][]string{{`--qr`, `-d`}, {`--qr`, `--json`}} {
+		if _, _, exitCode = runWith(t, encodedTheOne, args...); exitCode != exitUsage {
+			t.Fatalf(`Flags %v resulted in exit code %d instead of %d`, args, exitCode, exitUsage)
+		}
+	}
+
+	_, stderr, exitCode = runWith(t, strings.Repeat(`x`, 2000), `--qr`)
+	if exitCode != exitError || !strings.Contains(stderr, `too large`) {
+		t.Fatalf(`Too much data resulted in exit code %d: %s`, exitCode, stderr)
+	}
+}
+
+// TestCmp tests if the cmp command compares decoded data and prints the first difference.
+func TestCmp(t *testing.T) {
+	dir := t.TempDir()
+	encodedName := filepath.Join(dir, `key.txt`)
+	if err := os.WriteFile(encodedName, []byte(encodedTheOne+"\n"), 0o644); err != nil {
+		t.Fatalf(`Writing file failed: %v`, err)
+	}
+
+	stdout, stderr, code := runWith(t, string(clearTheOne), `cmp`, `-from2`, formatBinary, encodedName)
+	if code != exitOK || stdout != encodedName+" and - are identical: 8 bytes\n" {
+		t.Fatalf(`Comparison of identical data resulted in exit code %d: %s%s`, code, stdout, stderr)
+	}
+
+	changed := bytes.Clone(clearTheOne)
+	changed[5] ^= 0xff
+	stdout, _, code = runWith(t, string(changed), `cmp`, `-from2`, formatBinary, encodedName)
+	expected := encodedName + " and - differ at offset 5 (0x5)\n" +
+		"00000000  86 4f d2 6f b5 59 f7 5b                           |.O.o.Y.[|          " + encodedName + "\n" +
+		"00000000  86 4f d2 6f b5 a6 f7 5b                           |.O.o...[|          -\n" +
+		"                         ^^\n"
+	if code != exitError || stdout != expected {
+		t.Fatalf(`Comparison of different data resulted in exit code %d and '%s' instead of '%s'`, code, stdout, expected)
+	}
+
+	stdout, _, code = runWith(t, `hk/Sb7VZ`, `cmp`, `-from2`, formatBase64, encodedName)
+	if code != exitError || !strings.HasPrefix(stdout, "- ends at offset 6 (0x6), "+encodedName+" is longer\n") {
+		t.Fatalf(`Comparison of shorter data resulted in exit code %d: %s`, code, stdout)
+	}
+
+	_, stderr, code = runWith(t, `Hello~World`, `cmp`, encodedName)
+	if code != exitError || !strings.Contains(stderr, `-: invalid byte`) {
+		t.Fatalf(`Comparison of invalid data resulted in exit code %d: %s`, code, stderr)
+	}
+
+	for _, args := range [][]string{{`cmp`}, {`cmp`, `-`, `-`}, {`cmp`, `-from`, `z84`, encodedName}, {`cmp`, `a`, `b`, `c`}} {
+		if _, _, code = runWith(t, ``, args...); code != exitUsage {
+			t.Fatalf(`Arguments %v resulted in exit code %d instead of %d`, args, code, exitUsage)
+		}
+	}
+}
+
+// TestManifest tests if a directory tree is written into a manifest, restored and verified.
+func TestManifest(t *testing.T) {
+	source := t.TempDir()
+	files := map[string][]byte{
+		`a.txt`:                                    []byte(`hello`),
+		filepath.Join(`sub`, `b.bin`):              randomData(300),
+		filepath.Join(`with space`, `empty`):       {},
+		filepath.Join(`sub`, `deeper`, `key.data`): clearTheOne,
+	}
+
+	for name, data := range files {
+		path := filepath.Join(source, name)
+		_ = os.MkdirAll(filepath.Dir(path), 0o755)
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatalf(`Writing file failed: %v`, err)
+		}
+	}
+
+	manifest, stderr, code := runWith(t, ``, `manifest`, `create`, source)
+	if code != exitOK {
+		t.Fatalf(`Creating manifest resulted in exit code %d: %s`, code, stderr)
+	}
+
+	encodedKey, _ := z85.PaddedEncoding.EncodeToString(clearTheOne)
+	expected := manifestHeader + "data 5 a.txt\n xK#0@zVx+q3\n"
+	if !strings.HasPrefix(manifest, expected) || !strings.Contains(manifest, "data 8 sub/deeper/key.data\n "+encodedKey+"\n") {
+		t.Fatalf(`Manifest does not start with '%s': %s`, expected, manifest)
+	}
+
+	for _, line := range strings.Split(manifest, "\n") {
+		if len(line) > manifestLineLength+1 {
+			t.Fatalf(`Manifest contains a line of %d characters`, len(line))
+		}
+	}
+
+	target := t.TempDir()
+	if _, stderr, code = runWith(t, manifest, `manifest`, `restore`, `-dir`, target); code != exitOK {
+		t.Fatalf(`Restoring manifest resulted in exit code %d: %s`, code, stderr)
+	}
+
+	for name, data := range files {
+		restored, err := os.ReadFile(filepath.Join(target, name))
+		if err != nil || !bytes.Equal(restored, data) {
+			t.Fatalf(`File %s was not restored: %v`, name, err)
+		}
+	}
+
+	stdout, _, code := runWith(t, manifest, `manifest`, `verify`, `-dir`, target)
+	if code != exitOK || stdout != "4 files verified\n" {
+		t.Fatalf(`Verifying manifest resulted in exit code %d: %s`, code, stdout)
+	}
+
+	digests, _, _ := runWith(t, ``, `manifest`, `create`, `-digest`, source)
+	_ = os.WriteFile(filepath.Join(target, `a.txt`), []byte(`hellO`), 0o644)
+	_ = os.Remove(filepath.Join(target, `sub`, `b.bin`))
+	for _, input := range []string{manifest, digests} {
+		stdout, _, code = runWith(t, input, `manifest`, `verify`, `-dir`, target)
+		if code != exitError || stdout != "a.txt: content differs\nsub/b.bin: missing\n" {
+			t.Fatalf(`Verifying changed files resulted in exit code %d: %s`, code, stdout)
+		}
+	}
+
+	_, stderr, code = runWith(t, digests, `manifest`, `restore`, `-dir`, target)
+	if code != exitError || !strings.Contains(stderr, `only contains a digest`) {
+		t.Fatalf(`Restoring digests resulted in exit code %d: %s`, code, stderr)
+	}
+
+	for _, invalid := range []string{"data 5 ../a.txt\n xK#0@zVx+q3\n", "data 6 a.txt\n xK#0@zVx+q3\n", " xK#0@zVx+q3\n"} {
+		if _, stderr, code = runWith(t, invalid, `manifest`, `restore`, `-dir`, target); code != exitError {
+			t.Fatalf(`Invalid manifest '%s' resulted in exit code %d: %s`, invalid, code, stderr)
+		}
+	}
+}
+
+// TestConvert tests if the convert command converts between all formats.
+func TestConvert(t *testing.T) {
+	texts := map[string]string{
+		formatBase32: "QZH5E35VLH3VW===\n",
+		formatBase64: "hk/Sb7VZ91s=\n",
+		formatBinary: string(clearTheOne),
+		formatHex:    "864fd26fb559f75b\n",
+		formatZ85:    encodedTheOne + "\n",
+	}
+
+	for from, input := range texts {
+		for to, expected := range texts {
+			stdout, stderr, code := runWith(t, input, `convert`, `-from`, from, `--to`, to)
+			if code != exitOK {
+				t.Fatalf(`Conversion from %s to %s failed with exit code %d: %s`, from, to, code, stderr)
+			}
+
+			if stdout != expected {
+				t.Fatalf(`Conversion from %s to %s resulted in '%s' instead of '%s'`, from, to, stdout, expected)
+			}
+		}
+	}
+
+	stdout, _, _ := runWith(t, "414243\n", `convert`, `-from`, formatHex, `-to`, formatZ85P)
+	stdout, _, _ = runWith(t, stdout, `convert`, `-from`, formatZ85P, `-to`, formatBinary)
+	if stdout != `ABC` {
+		t.Fatalf(`Conversion with %s resulted in '%s' instead of 'ABC'`, formatZ85P, stdout)
+	}
+}
+
+// TestConvertErrors tests if the convert command rejects invalid arguments and data.
+func TestConvertErrors(t *testing.T) {
+	for _, test := range []struct {
+		input   string
+		args    []string
+		code    int
+		message string
+	}{
+		{``, []string{`-from`, `hex`}, exitUsage, `unknown format ''`},
+		{``, []string{`-from`, `hex`, `-to`, `z86`}, exitUsage, `unknown format 'z86'`},
+		{``, []string{`-from`, `hex`, `-to`, `z85`, `input`, `extra`}, exitUsage, `unexpected argument`},
+		{`41`, []string{`-from`, `hex`, `-to`, `z85`}, exitError, formatZ85P},
+		{`4x`, []string{`-from`, `hex`, `-to`, `z85`}, exitError, `invalid`},
+	} {
+		args := append([]string{`convert`}, test.args...)
+		_, stderr, code := runWith(t, test.input, args...)
+		if code != test.code {
+			t.Fatalf(`Arguments %v with input '%s' resulted in exit code %d instead of %d`, args, test.input, code, test.code)
+		}
+
+		if !strings.Contains(stderr, test.message) {
+			t.Fatalf(`Message for arguments %v with input '%s' does not contain '%s': %s`, args, test.input, test.message, stderr)
+		}
+	}
+}
+
+// TestWasip1 tests if the command builds for WASI, so it can run in WASM plugin sandboxes.
+// If wazero or wasmtime is installed, the module is also run with it.
+func TestWasip1(t *testing.T) {
+	if testing.Short() {
+		t.Skip(`Building for WASI takes too long in short mode`)
+	}
+
+	goTool, err := exec.LookPath(`go`)
+	if err != nil {
+		t.Skip(`The go command is not available`)
+	}
+
+	module := filepath.Join(t.TempDir(), `z85.wasm`)
+	build := exec.Command(goTool, `build`, `-o`, module, `.`)
+	build.Env = append(os.Environ(), `GOOS=wasip1`, `GOARCH=wasm`)
+	if output, err := build.CombinedOutput(); err != nil {
+		t.Fatalf(`Build for wasip1 failed: %v: %s`, err, output)
+	}
+
+	var runner *exec.Cmd
+	if path, err := exec.LookPath(`wazero`); err == nil {
+		runner = exec.Command(path, `run`, module, `-r`)
+	} else if path, err := exec.LookPath(`wasmtime`); err == nil {
+		runner = exec.Command(path, `run`, module, `-r`)
+	} else {
+		return
+	}
+
+	runner.Stdin = bytes.NewReader(clearTheOne)
+	output, err := runner.Output()
+	if err != nil || string(output) != encodedTheOne {
+		t.Fatalf(`WASI module resulted in '%s': %v`, output, err)
+	}
+}
+
+// ******** Private functions ********
+
+// runWith runs the program with input as standard input and returns standard output, standard error and the exit code.
+func runWith(t *testing.T, input string, args ...string) (string, string, int) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(input), &stdout, &stderr)
+
+	return stdout.String(), stderr.String(), code
+}
+
+// checkDirectory checks if dir contains exactly count files, i.e. no temporary files have been left behind.
+func checkDirectory(t *testing.T, dir string, count int) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf(`Reading directory failed: %v`, err)
+	}
+
+	if len(entries) != count {
+		names := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+
+		t.Fatalf(`Directory contains %d files instead of %d: %v`, len(entries), count, names)
+	}
+}
+
+// checkKeyPair checks if the encoded public key belongs to the encoded secret key.
+func checkKeyPair(t *testing.T, encodedPublic string, encodedSecret string) {
+	t.Helper()
+
+	public, err := keys85.Decode32(encodedPublic)
+	if err != nil {
+		t.Fatalf(`Public key '%s' can not be decoded: %v`, encodedPublic, err)
+	}
+
+	secret, err := keys85.Decode32(encodedSecret)
+	if err != nil {
+		t.Fatalf(`Secret key '%s' can not be decoded: %v`, encodedSecret, err)
+	}
+
+	key, err := ecdh.X25519().NewPrivateKey(secret[:])
+	if err != nil {
+		t.Fatalf(`Secret key is invalid: %v`, err)
+	}
+
+	if !bytes.Equal(key.PublicKey().Bytes(), public[:]) {
+		t.Fatal(`Public key does not belong to the secret key`)
+	}
+}
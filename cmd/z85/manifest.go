@@ -0,0 +1,592 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// manifestHeader is the first line of a manifest.
+const manifestHeader = "# z85 manifest\n"
+
+// manifestLineLength is the length of the lines of the encoded data in a manifest.
+// Lines of this length pass mail and chat systems that break or reject long lines.
+const manifestLineLength = 76
+
+// manifestIndent starts each line of the encoded data in a manifest.
+// It is not part of the Z85 alphabet, so encoded lines can not be mistaken for entries.
+const manifestIndent = ' '
+
+// manifestComment starts a comment line in a manifest.
+const manifestComment = '#'
+
+// Kinds of the entries of a manifest.
+const (
+	entryData   = `data`
+	entrySHA256 = `sha256`
+)
+
+// directoryMode is the permission of the directories that are created when restoring files.
+const directoryMode = 0o755
+
+// ******** Private types ********
+
+// manifestEntry is the header line of a file in a manifest.
+type manifestEntry struct {
+	kind string
+	size int64
+	path string
+	line int
+}
+
+// manifestReader reads the entries of a manifest.
+// It is a reader of the encoded data of the current entry without the indentation and the line feeds.
+type manifestReader struct {
+	br      *bufio.Reader
+	line    int
+	pending []byte
+	inEntry bool
+}
+
+// indentWriter is a writer that indents each line that is written to it.
+type indentWriter struct {
+	w      io.Writer
+	inLine bool
+	buf    []byte
+}
+
+// ******** Private creation functions ********
+
+// newManifestReader creates a new manifestReader that reads from r.
+func newManifestReader(r io.Reader) *manifestReader {
+	return &manifestReader{br: bufio.NewReaderSize(r, bufferSize)}
+}
+
+// ******** Private functions ********
+
+// runManifest executes the manifest command, which writes file trees into manifests and restores them.
+func runManifest(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	subcommands := map[string]command{
+		`create`:  runManifestCreate,
+		`restore`: runManifestRestore,
+		`verify`:  runManifestVerify,
+	}
+
+	if len(args) > 0 {
+		if f, found := subcommands[args[0]]; found {
+			return f(args[1:], stdin, stdout, stderr)
+		}
+	}
+
+	fmt.Fprintf(stderr, "Usage: %s manifest create|restore|verify [arguments]\n\n", programName)
+	fmt.Fprintln(stderr, `Writes the files of a directory tree encoded with Z85 into a text manifest,`)
+	fmt.Fprintln(stderr, `restores them from it and verifies them against it.`)
+
+	if len(args) > 0 && (args[0] == `-h` || args[0] == `-help` || args[0] == `--help`) {
+		return exitOK
+	}
+
+	return exitUsage
+}
+
+// runManifestCreate executes the manifest create command, which writes the manifest of a directory tree.
+func runManifestCreate(args []string, _ io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` manifest create`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s manifest create [-digest] [-o output] directory\n\n", programName)
+		fmt.Fprintln(stderr, `Writes the path, size and Z85P encoding of each file in the directory tree into a manifest.`)
+		fmt.Fprintln(stderr, `With -digest the manifest only contains the SHA-256 digests of the files for verification.`)
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	digest := flags.Bool(`digest`, false, `write the SHA-256 digests instead of the contents of the files`)
+	output := flags.String(`o`, ``, `write the manifest to the `+"`file`"+` instead of standard output`)
+
+	if code, ok := parseWithOneFile(flags, args, stderr); !ok {
+		return code
+	}
+
+	if err := createManifest(*output, stdout, flags.Arg(0), *digest); err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+// runManifestRestore executes the manifest restore command, which restores the files of a manifest.
+func runManifestRestore(args []string, stdin io.Reader, _ io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` manifest restore`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s manifest restore [-dir directory] [manifest]\n\n", programName)
+		fmt.Fprintln(stderr, `Restores the files of the manifest or standard input into the directory.`)
+		fmt.Fprintln(stderr, `Existing files are replaced.`)
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	dir := flags.String(`dir`, `.`, `the `+"`directory`"+` of the restored files`)
+
+	if code, ok := parseManifestArgs(flags, args, stderr); !ok {
+		return code
+	}
+
+	err := readManifest(flags.Arg(0), stdin, func(m *manifestReader, entry *manifestEntry) error {
+		return restoreEntry(m, entry, *dir)
+	})
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+// runManifestVerify executes the manifest verify command, which compares the files of a directory tree with a manifest.
+func runManifestVerify(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` manifest verify`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s manifest verify [-dir directory] [manifest]\n\n", programName)
+		fmt.Fprintln(stderr, `Compares the files in the directory with the manifest or standard input and prints the differences.`)
+		fmt.Fprintln(stderr, `The exit code is 1, if a file is missing or differs.`)
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	dir := flags.String(`dir`, `.`, `the `+"`directory`"+` of the files`)
+
+	if code, ok := parseManifestArgs(flags, args, stderr); !ok {
+		return code
+	}
+
+	count := 0
+	failed := false
+	err := readManifest(flags.Arg(0), stdin, func(m *manifestReader, entry *manifestEntry) error {
+		problem, err := verifyEntry(m, entry, *dir)
+		if problem != `` {
+			fmt.Fprintf(stdout, "%s: %s\n", entry.path, problem)
+			failed = true
+		}
+
+		count++
+		return err
+	})
+	if err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		return exitError
+	}
+
+	if failed {
+		return exitError
+	}
+
+	fmt.Fprintf(stdout, "%d files verified\n", count)
+	return exitOK
+}
+
+// parseManifestArgs parses args with flags and checks that there is at most one manifest file.
+// It returns the exit code and false, if the command must not be executed.
+func parseManifestArgs(flags *flag.FlagSet, args []string, stderr io.Writer) (int, bool) {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK, false
+		}
+
+		return exitUsage, false
+	}
+
+	if flags.NArg() > 1 {
+		fmt.Fprintf(stderr, "%s: unexpected argument '%s'\n", programName, flags.Arg(1))
+		flags.Usage()
+		return exitUsage, false
+	}
+
+	return exitOK, true
+}
+
+// createManifest writes the manifest of the directory tree dir to the file outputName, or to stdout,
+// if outputName is empty or "-".
+// The files are listed before the manifest is written, so a manifest in dir does not contain itself.
+func createManifest(outputName string, stdout io.Writer, dir string, digest bool) error {
+	var paths []string
+	err := filepath.WalkDir(dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return err
+		}
+
+		path, err := filepath.Rel(dir, name)
+		if err != nil {
+			return err
+		}
+
+		path = filepath.ToSlash(path)
+		if path == `.` {
+			return fmt.Errorf(`%s is no directory`, dir)
+		}
+
+		if strings.ContainsAny(path, "\r\n") {
+			return fmt.Errorf(`invalid file name: %q`, path)
+		}
+
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	out, err := createOutput(outputName, stdout)
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.WriteString(out, manifestHeader); err != nil {
+		out.abort()
+		return err
+	}
+
+	for _, path := range paths {
+		if err = writeManifestEntry(out, dir, path, digest); err != nil {
+			out.abort()
+			return err
+		}
+	}
+
+	return out.commit()
+}
+
+// writeManifestEntry writes the entry of the file path in dir to w.
+// The header line contains the kind, the size and the path, and is followed by the indented lines of the encoding.
+func writeManifestEntry(w io.Writer, dir string, path string, digest bool) error {
+	file, err := os.Open(filepath.Join(dir, filepath.FromSlash(path)))
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	kind := entryData
+	if digest {
+		kind = entrySHA256
+	}
+
+	if _, err = fmt.Fprintf(w, "%s %d %s\n", kind, info.Size(), path); err != nil {
+		return err
+	}
+
+	lines := &indentWriter{w: w}
+	formatted := newFormatter(lines, options{wrap: manifestLineLength})
+
+	var size int64
+	if digest {
+		hash := sha256.New()
+		if size, err = io.Copy(hash, file); err != nil {
+			return err
+		}
+
+		encoded, _ := z85.Encode(hash.Sum(nil))
+		_, err = io.WriteString(formatted, encoded)
+	} else {
+		encoder := z85.PaddedEncoding.NewEncoder(formatted)
+		if size, err = io.Copy(encoder, file); err == nil {
+			err = encoder.Close()
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if size != info.Size() {
+		return fmt.Errorf(`%s: file changed while it was read`, path)
+	}
+
+	return lines.end()
+}
+
+// readManifest reads the manifest from the file name, or from stdin, if name is empty or "-",
+// and calls process with each entry.
+func readManifest(name string, stdin io.Reader, process func(*manifestReader, *manifestEntry) error) error {
+	input, err := openInput(name, stdin)
+	if err != nil {
+		return err
+	}
+
+	defer input.Close()
+
+	m := newManifestReader(input)
+	for {
+		entry, err := m.next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err = process(m, entry); err != nil {
+			return err
+		}
+	}
+}
+
+// restoreEntry decodes the data of entry from m and writes it to its file in dir.
+// The file is only replaced, when all data has been decoded and its size matches the entry.
+func restoreEntry(m *manifestReader, entry *manifestEntry, dir string) error {
+	if entry.kind != entryData {
+		return fmt.Errorf(`%s: manifest line %d only contains a digest`, entry.path, entry.line)
+	}
+
+	name := filepath.Join(dir, filepath.FromSlash(entry.path))
+	if name == stdioName {
+		// A file named like standard output is written to the current directory.
+		name = `.` + string(filepath.Separator) + name
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), directoryMode); err != nil {
+		return err
+	}
+
+	out, err := createOutput(name, nil)
+	if err != nil {
+		return err
+	}
+
+	written := &countingWriter{w: out}
+	_, err = io.Copy(written, z85.PaddedEncoding.NewDecoder(m))
+	if err == nil && written.count != entry.size {
+		err = fmt.Errorf(`decoded data has %d bytes instead of %d`, written.count, entry.size)
+	}
+
+	if err != nil {
+		out.abort()
+		return fmt.Errorf(`%s: manifest line %d: %w`, entry.path, entry.line, err)
+	}
+
+	return out.commit()
+}
+
+// verifyEntry compares the file of entry in dir with the data or the digest of entry from m.
+// It returns a description of the problem, if the file is missing or differs.
+func verifyEntry(m *manifestReader, entry *manifestEntry, dir string) (string, error) {
+	expected, err := entryDigest(m, entry)
+	if err != nil {
+		return ``, fmt.Errorf(`%s: manifest line %d: %w`, entry.path, entry.line, err)
+	}
+
+	file, err := os.Open(filepath.Join(dir, filepath.FromSlash(entry.path)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return `missing`, nil
+	}
+
+	if err != nil {
+		return ``, err
+	}
+
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return ``, err
+	}
+
+	switch {
+	case size != entry.size:
+		return fmt.Sprintf(`size is %d instead of %d`, size, entry.size), nil
+	case !bytes.Equal(hash.Sum(nil), expected):
+		return `content differs`, nil
+	default:
+		return ``, nil
+	}
+}
+
+// entryDigest returns the SHA-256 digest of the data of entry from m.
+func entryDigest(m *manifestReader, entry *manifestEntry) ([]byte, error) {
+	if entry.kind == entrySHA256 {
+		text, err := io.ReadAll(m)
+		if err != nil {
+			return nil, err
+		}
+
+		digest, err := z85.Decode(string(text))
+		if err == nil && len(digest) != sha256.Size {
+			err = fmt.Errorf(`digest has %d bytes instead of %d`, len(digest), sha256.Size)
+		}
+
+		return digest, err
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, z85.PaddedEncoding.NewDecoder(m))
+	if err == nil && size != entry.size {
+		err = fmt.Errorf(`decoded data has %d bytes instead of %d`, size, entry.size)
+	}
+
+	return hash.Sum(nil), err
+}
+
+// next skips the rest of the current entry and returns the next entry.
+// Empty lines and comments are skipped. At the end of the manifest io.EOF is returned.
+func (m *manifestReader) next() (*manifestEntry, error) {
+	if _, err := io.Copy(io.Discard, m); err != nil {
+		return nil, err
+	}
+
+	for {
+		line, err := m.br.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == ``) {
+			return nil, err
+		}
+
+		m.line++
+
+		text := strings.TrimRight(line, "\r\n")
+		if text == `` || text[0] == manifestComment {
+			continue
+		}
+
+		entry, ok := parseManifestEntry(text)
+		if !ok {
+			return nil, fmt.Errorf(`invalid manifest line %d`, m.line)
+		}
+
+		entry.line = m.line
+		m.inEntry = true
+		m.pending = nil
+
+		return entry, nil
+	}
+}
+
+// Read reads the encoded data of the current entry.
+// It returns io.EOF at the next line that is not indented.
+func (m *manifestReader) Read(p []byte) (int, error) {
+	for len(m.pending) == 0 {
+		if !m.inEntry {
+			return 0, io.EOF
+		}
+
+		next, err := m.br.Peek(1)
+		if err != nil || next[0] != manifestIndent {
+			m.inEntry = false
+			if err != nil && !errors.Is(err, io.EOF) {
+				return 0, err
+			}
+
+			return 0, io.EOF
+		}
+
+		line, err := m.br.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, err
+		}
+
+		m.line++
+		m.pending = bytes.TrimSpace(line)
+	}
+
+	n := copy(p, m.pending)
+	m.pending = m.pending[n:]
+
+	return n, nil
+}
+
+// parseManifestEntry parses the header line text of an entry.
+// It reports false, if text is no valid header line or the path leaves the directory tree.
+func parseManifestEntry(text string) (*manifestEntry, bool) {
+	kind, rest, _ := strings.Cut(text, ` `)
+	sizeText, path, _ := strings.Cut(rest, ` `)
+
+	size, err := strconv.ParseInt(sizeText, 10, 64)
+	if (kind != entryData && kind != entrySHA256) || err != nil || size < 0 || !fs.ValidPath(path) || path == `.` {
+		return nil, false
+	}
+
+	return &manifestEntry{kind: kind, size: size, path: path}, true
+}
+
+// Write writes p to the underlying writer and indents the lines.
+func (w *indentWriter) Write(p []byte) (int, error) {
+	w.buf = w.buf[:0]
+	for _, b := range p {
+		if !w.inLine {
+			w.buf = append(w.buf, manifestIndent)
+			w.inLine = true
+		}
+
+		w.buf = append(w.buf, b)
+		if b == '\n' {
+			w.inLine = false
+		}
+	}
+
+	if _, err := w.w.Write(w.buf); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// end ends the last line with a line feed.
+func (w *indentWriter) end() error {
+	if !w.inLine {
+		return nil
+	}
+
+	w.inLine = false
+	_, err := io.WriteString(w.w, "\n")
+
+	return err
+}
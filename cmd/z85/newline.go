@@ -0,0 +1,97 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"io"
+)
+
+// ******** Private types ********
+
+// lineEndWriter controls the line feed at the end of the decoded data.
+// It either keeps back a final line feed and drops it, or adds one, if the data does not end with one.
+type lineEndWriter struct {
+	w       io.Writer
+	add     bool
+	pending bool
+	last    byte
+}
+
+// ******** Private creation functions ********
+
+// newLineEndWriter creates a new lineEndWriter that writes to w.
+// With add a missing final line feed is added, otherwise a final line feed is removed.
+func newLineEndWriter(w io.Writer, add bool) *lineEndWriter {
+	return &lineEndWriter{w: w, add: add}
+}
+
+// ******** Private functions ********
+
+// Write writes p to the underlying writer. A line feed at the end of p is kept back, if it is to be removed.
+func (l *lineEndWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	n := len(p)
+	l.last = p[n-1]
+
+	// A kept back line feed is not the last byte, as it is followed by p.
+	if l.pending {
+		if _, err := l.w.Write([]byte{'\n'}); err != nil {
+			return 0, err
+		}
+
+		l.pending = false
+	}
+
+	if !l.add && l.last == '\n' {
+		p = p[:n-1]
+		l.pending = true
+	}
+
+	if len(p) > 0 {
+		if _, err := l.w.Write(p); err != nil {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+// finish adds the final line feed, if it is missing and to be added.
+// A kept back line feed is dropped.
+func (l *lineEndWriter) finish() error {
+	if !l.add || l.last == '\n' {
+		return nil
+	}
+
+	_, err := l.w.Write([]byte{'\n'})
+	return err
+}
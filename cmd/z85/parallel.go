@@ -0,0 +1,253 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// parallelBlockSize is the size of the data blocks that are encoded and decoded by one worker.
+const parallelBlockSize = 1024 * 1024
+
+// parallelEncodedBlockSize is the size of the encoded blocks.
+const parallelEncodedBlockSize = parallelBlockSize / 4 * groupSize
+
+// ******** Private types ********
+
+// blockTransform transforms the block with the supplied index.
+// The last block is transformed with last set to true and may be shorter or empty.
+type blockTransform func(index int64, block []byte, last bool) ([]byte, error)
+
+// blockResult is the result of the transformation of a block.
+type blockResult struct {
+	data []byte
+	err  error
+}
+
+// parallelWriter splits the data written to it into blocks, transforms them in parallel
+// and writes the results to w in the order of the blocks.
+// A block is only transformed when more than holdBack bytes follow it,
+// so the last block, which may be up to holdBack bytes larger, is transformed when it is closed.
+type parallelWriter struct {
+	w         io.Writer
+	transform blockTransform
+	blockSize int
+	holdBack  int
+	workers   int
+	block     []byte
+	index     int64
+	pending   []chan blockResult
+	err       error
+}
+
+// movedError is the cause of an invalid character error whose position has been moved from a block to the whole data.
+// It has the message of the original cause with the moved position and wraps the original cause.
+type movedError struct {
+	message string
+	err     error
+}
+
+// ******** Private creation functions ********
+
+// newParallelWriter creates a new parallelWriter that writes to w.
+// If workers is 0, one worker per CPU is used.
+func newParallelWriter(w io.Writer, blockSize int, holdBack int, workers int, transform blockTransform) *parallelWriter {
+	if workers == 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	return &parallelWriter{
+		w:         w,
+		transform: transform,
+		blockSize: blockSize,
+		holdBack:  holdBack,
+		workers:   workers,
+		block:     make([]byte, 0, blockSize+holdBack),
+	}
+}
+
+// newParallelEncoder creates a parallelWriter that encodes the data written to it as opts select.
+// All blocks but the last one have a size that is a multiple of 4, so they are encoded with StdEncoding
+// and only the last one needs the encoding that opts select.
+func newParallelEncoder(w io.Writer, opts options) *parallelWriter {
+	lastEncoding := encoding(opts)
+	return newParallelWriter(w, parallelBlockSize, 0, opts.jobs, func(index int64, block []byte, last bool) ([]byte, error) {
+		e := z85.StdEncoding
+		if last {
+			e = lastEncoding
+		}
+
+		result, err := e.AppendEncode(nil, block)
+		return result, moveError(err, index*parallelBlockSize, index*parallelEncodedBlockSize)
+	})
+}
+
+// newParallelDecoder creates a parallelWriter that decodes the text written to it as opts select.
+// The last chunk is held back, so the pad count of a padded encoding is never alone in the last block.
+func newParallelDecoder(w io.Writer, opts options) *parallelWriter {
+	lastEncoding := encoding(opts)
+	return newParallelWriter(w, parallelEncodedBlockSize, groupSize, opts.jobs, func(index int64, block []byte, last bool) ([]byte, error) {
+		e := z85.StdEncoding
+		if last {
+			e = lastEncoding
+		}
+
+		result, err := e.AppendDecode(nil, string(block))
+		return result, moveError(err, index*parallelBlockSize, index*parallelEncodedBlockSize)
+	})
+}
+
+// ******** Private functions ********
+
+// Write splits p into blocks and starts their transformation.
+// A full block is only transformed when more data follows, as the last block is transformed differently.
+func (p *parallelWriter) Write(data []byte) (int, error) {
+	if p.err != nil {
+		return 0, p.err
+	}
+
+	n := len(data)
+	for len(data) > 0 {
+		if len(p.block) == cap(p.block) {
+			if err := p.dispatch(false); err != nil {
+				return 0, err
+			}
+		}
+
+		copied := copy(p.block[len(p.block):cap(p.block)], data)
+		p.block = p.block[:len(p.block)+copied]
+		data = data[copied:]
+	}
+
+	return n, nil
+}
+
+// Close transforms the last block and writes all results.
+func (p *parallelWriter) Close() error {
+	if p.err != nil {
+		return p.err
+	}
+
+	if err := p.dispatch(true); err != nil {
+		return err
+	}
+
+	for len(p.pending) > 0 {
+		if err := p.writeOldest(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dispatch starts the transformation of the current block.
+// The bytes that are held back are moved to the next block.
+// If all workers are busy, it waits for the oldest block and writes its result.
+func (p *parallelWriter) dispatch(last bool) error {
+	block := p.block
+	if !last {
+		block = p.block[:p.blockSize]
+		p.block = append(make([]byte, 0, cap(p.block)), p.block[p.blockSize:]...)
+	}
+
+	result := make(chan blockResult, 1)
+	go func(index int64) {
+		data, err := p.transform(index, block, last)
+		result <- blockResult{data: data, err: err}
+	}(p.index)
+
+	p.pending = append(p.pending, result)
+	p.index++
+
+	if len(p.pending) >= p.workers {
+		return p.writeOldest()
+	}
+
+	return nil
+}
+
+// writeOldest waits for the result of the oldest block and writes it.
+func (p *parallelWriter) writeOldest() error {
+	result := <-p.pending[0]
+	p.pending = p.pending[1:]
+
+	err := result.err
+	if err == nil {
+		_, err = p.w.Write(result.data)
+	}
+
+	if err != nil {
+		p.err = err
+		p.pending = nil
+	}
+
+	return err
+}
+
+// moveError adds the offsets of a block to the offsets of a CodecError, so they are relative to the whole data.
+// The positions in the messages of the causes are moved, too.
+func moveError(err error, rawOffset int64, encodedOffset int64) error {
+	var codecErr *z85.CodecError
+	if encodedOffset == 0 || !errors.As(err, &codecErr) {
+		return err
+	}
+
+	codecErr.RawOffset += rawOffset
+	codecErr.EncodedOffset += encodedOffset
+
+	switch codecErr.Kind {
+	case z85.KindOverflow:
+		codecErr.Err = z85.ErrOverflow(codecErr.EncodedOffset)
+	case z85.KindInvalidByte, z85.KindControlCharacter:
+		codecErr.Err = &movedError{
+			message: fmt.Sprintf(`%s at position %d: %q`, codecErr.Kind, codecErr.EncodedOffset, codecErr.Byte),
+			err:     codecErr.Err,
+		}
+	}
+
+	return err
+}
+
+// Error returns the message of the moved error.
+func (m *movedError) Error() string {
+	return m.message
+}
+
+// Unwrap returns the original cause.
+func (m *movedError) Unwrap() error {
+	return m.err
+}
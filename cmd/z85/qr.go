@@ -0,0 +1,146 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xformerfhs/z85/internal/qr"
+)
+
+// ******** Private constants ********
+
+// qrLevel is the error correction level of the QR codes.
+// Medium still scans from a slightly damaged printout or a blurred screen.
+const qrLevel = qr.Medium
+
+// qrQuietZone is the width of the light border around a QR code in modules, as the standard requires it.
+const qrQuietZone = 4
+
+// qrModuleSize is the width and height of a module in the PNG image in pixels.
+const qrModuleSize = 8
+
+// qrImageExtension is the extension of output files that get a PNG image instead of text.
+const qrImageExtension = `.png`
+
+// Characters that draw the upper and lower halves of two rows of modules.
+const (
+	qrBoth  = `█`
+	qrUpper = `▀`
+	qrLower = `▄`
+	qrNone  = ` `
+)
+
+// ******** Private functions ********
+
+// writeQR encodes the data from r as opts select and writes the encoded text as a QR code to w.
+// The code is a PNG image, if the output file has the extension ".png", and text otherwise.
+func writeQR(w io.Writer, r io.Reader, opts options) error {
+	maxLen := qr.MaxLen(qrLevel)
+	errTooLarge := fmt.Errorf(`encoded text is too large for a QR code of at most %d characters`, maxLen)
+
+	// The encoded text is longer than the data, so more data than maxLen never fits.
+	data, err := io.ReadAll(io.LimitReader(r, int64(maxLen)+1))
+	if err != nil {
+		return err
+	}
+
+	if len(data) > maxLen {
+		return errTooLarge
+	}
+
+	var text bytes.Buffer
+	if err = encode(&text, bytes.NewReader(data), opts); err != nil {
+		return err
+	}
+
+	code, err := qr.Encode(bytes.TrimSuffix(text.Bytes(), []byte("\n")), qrLevel)
+	if err != nil {
+		return errTooLarge
+	}
+
+	if strings.EqualFold(filepath.Ext(opts.output), qrImageExtension) {
+		return png.Encode(w, qrImage(code))
+	}
+
+	_, err = io.WriteString(w, qrText(code))
+	return err
+}
+
+// qrText renders code as text with two rows of modules in each line.
+// The light modules are drawn as blocks, so the code can be scanned from terminals with light text on a dark background.
+func qrText(code *qr.Code) string {
+	light := func(x int, y int) bool {
+		// Below the quiet zone is the background of the terminal.
+		return y < code.Size+qrQuietZone && !code.Dark(x, y)
+	}
+
+	var result strings.Builder
+	for y := -qrQuietZone; y < code.Size+qrQuietZone; y += 2 {
+		for x := -qrQuietZone; x < code.Size+qrQuietZone; x++ {
+			upper, lower := light(x, y), light(x, y+1)
+			switch {
+			case upper && lower:
+				result.WriteString(qrBoth)
+			case upper:
+				result.WriteString(qrUpper)
+			case lower:
+				result.WriteString(qrLower)
+			default:
+				result.WriteString(qrNone)
+			}
+		}
+
+		result.WriteByte('\n')
+	}
+
+	return result.String()
+}
+
+// qrImage renders code as a black and white image with a quiet zone.
+func qrImage(code *qr.Code) image.Image {
+	width := (code.Size + 2*qrQuietZone) * qrModuleSize
+	img := image.NewPaletted(image.Rect(0, 0, width, width), color.Palette{color.White, color.Black})
+	for y := 0; y < width; y++ {
+		for x := 0; x < width; x++ {
+			if code.Dark(x/qrModuleSize-qrQuietZone, y/qrModuleSize-qrQuietZone) {
+				img.SetColorIndex(x, y, 1)
+			}
+		}
+	}
+
+	return img
+}
@@ -0,0 +1,410 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// keyLength is the length of an encoded CURVE key.
+const keyLength = 40
+
+// defaultMinBlobLength is the default minimum length of other encoded data that is reported.
+const defaultMinBlobLength = 50
+
+// maxRequiredDistinct is the maximum number of distinct characters that a candidate must contain.
+const maxRequiredDistinct = 40
+
+// minCharacterClasses is the number of character classes that a candidate must contain.
+const minCharacterClasses = 3
+
+// Classes of the characters of a candidate.
+const (
+	classDigit = iota
+	classLower
+	classUpper
+	classOther
+	classCount
+)
+
+// maxCasePercent is the maximum share of letters of one case in a candidate in percent.
+const maxCasePercent = 60
+
+// maxSameCasePercent is the maximum share of letters that follow a letter of the same case in percent.
+const maxSameCasePercent = 45
+
+// minDistinctPercent is the minimum share of distinct characters in a candidate in percent.
+const minDistinctPercent = 60
+
+// maxSequentialPercent is the maximum share of characters that follow their predecessor in percent.
+const maxSequentialPercent = 25
+
+// Kinds of the candidates that are found by the scan command.
+const (
+	kindKey       = `key`
+	kindSecretKey = `secret-key`
+	kindData      = `data`
+)
+
+// redactedLength is the number of characters that are shown at the start and at the end of a redacted candidate.
+const redactedLength = 4
+
+// ******** Private variables ********
+
+// keySeparators contains the characters after which a candidate may start in a sequence of encoded characters,
+// as in "SECRET=..." or "key:...".
+var keySeparators = []byte(`=:`)
+
+// secretMarker is the word that marks a key as a secret key, if it is on the same line.
+var secretMarker = []byte(`secret`)
+
+// ******** Private types ********
+
+// scanOptions contains the values of the command line flags of the scan command.
+type scanOptions struct {
+	keysOnly bool
+	minBlob  int
+	redact   bool
+}
+
+// scanCandidate is a string that is found by the scan command.
+type scanCandidate struct {
+	line   int
+	column int
+	offset int64
+	kind   string
+	text   string
+}
+
+// ******** Private functions ********
+
+// runScan executes the scan command, which searches files for Z85 keys and other encoded data.
+func runScan(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` scan`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s scan [-keys] [-min n] [-redact] [path...]\n\n", programName)
+		fmt.Fprintln(stderr, `Searches files, directory trees or standard input for CURVE keys and other data encoded with Z85`)
+		fmt.Fprintln(stderr, `and prints the file, line, column and byte offset of each candidate.`)
+		fmt.Fprintln(stderr, `The exit code is 1, if a candidate has been found.`)
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	var opts scanOptions
+	flags.BoolVar(&opts.keysOnly, `keys`, false, `only search for keys of 40 characters`)
+	flags.IntVar(&opts.minBlob, `min`, defaultMinBlobLength, `the minimum `+"`length`"+` of other encoded data`)
+	flags.BoolVar(&opts.redact, `redact`, false, `only print the start and the end of the candidates`)
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	if opts.minBlob < 0 {
+		fmt.Fprintf(stderr, "%s: minimum length %d is negative\n", programName, opts.minBlob)
+		return exitUsage
+	}
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{stdioName}
+	}
+
+	found := false
+	failed := false
+	report := func(name string, candidate scanCandidate) {
+		found = true
+		text := candidate.text
+		if opts.redact {
+			text = redact(text)
+		}
+
+		fmt.Fprintf(stdout, "%s:%d:%d: offset %d: %s: %s\n",
+			name, candidate.line, candidate.column, candidate.offset, candidate.kind, text)
+	}
+
+	fail := func(err error) {
+		fmt.Fprintf(stderr, "%s: %v\n", programName, err)
+		failed = true
+	}
+
+	for _, path := range paths {
+		scanPath(path, stdin, opts, report, fail)
+	}
+
+	if found || failed {
+		return exitError
+	}
+
+	return exitOK
+}
+
+// scanPath scans the file path, all files in the directory tree path or stdin, if path is "-".
+// Directories of version control systems are skipped.
+// Errors are passed to fail and the scan continues with the next file.
+func scanPath(path string, stdin io.Reader, opts scanOptions, report func(string, scanCandidate), fail func(error)) {
+	if path == stdioName {
+		if err := scanReader(stdin, opts, func(candidate scanCandidate) { report(path, candidate) }); err != nil {
+			fail(err)
+		}
+
+		return
+	}
+
+	// The walk function never returns an error, so neither does WalkDir.
+	_ = filepath.WalkDir(path, scanEntry(path, opts, report, fail))
+}
+
+// scanEntry returns the function that scans the entries of the directory tree root.
+// An error is passed to fail and the entry is skipped, so one unreadable file or directory
+// does not abort the scan of the rest of the tree.
+func scanEntry(root string, opts scanOptions, report func(string, scanCandidate), fail func(error)) fs.WalkDirFunc {
+	return func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			fail(err)
+
+			// A directory that can not be read is skipped.
+			if entry != nil && entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if entry.IsDir() {
+			if name != root && (entry.Name() == `.git` || entry.Name() == `.hg` || entry.Name() == `.svn`) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		if err = scanFile(name, opts, report); err != nil {
+			fail(err)
+		}
+
+		return nil
+	}
+}
+
+// scanFile scans the file name.
+func scanFile(name string, opts scanOptions, report func(string, scanCandidate)) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	return scanReader(file, opts, func(candidate scanCandidate) { report(name, candidate) })
+}
+
+// scanReader scans the lines of r for candidates and reports them.
+func scanReader(r io.Reader, opts scanOptions, report func(scanCandidate)) error {
+	br := bufio.NewReaderSize(r, bufferSize)
+
+	var offset int64
+	for lineNumber := 1; ; lineNumber++ {
+		line, err := br.ReadBytes('\n')
+		for _, candidate := range scanLine(line, opts) {
+			candidate.line = lineNumber
+			candidate.offset += offset
+			report(candidate)
+		}
+
+		offset += int64(len(line))
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// scanLine returns the candidates in line with their columns and their offsets in the line.
+// Candidates are sequences of encoded characters, or their ends after a key separator.
+func scanLine(line []byte, opts scanOptions) []scanCandidate {
+	var result []scanCandidate
+
+	for start := 0; start < len(line); {
+		if !isAlphabet[line[start]] {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(line) && isAlphabet[line[end]] {
+			end++
+		}
+
+		if candidateStart, kind := findCandidate(line[start:end], opts); kind != `` {
+			if kind == kindKey && bytes.Contains(bytes.ToLower(line), secretMarker) {
+				kind = kindSecretKey
+			}
+
+			position := start + candidateStart
+			result = append(result, scanCandidate{
+				column: position + 1,
+				offset: int64(position),
+				kind:   kind,
+				text:   string(line[position:end]),
+			})
+		}
+
+		start = end
+	}
+
+	return result
+}
+
+// findCandidate returns the start and the kind of the longest plausible encoding at the end of run.
+// The kind is empty, if run does not end with a plausible encoding.
+func findCandidate(run []byte, opts scanOptions) (int, string) {
+	if kind := candidateKind(run, opts); kind != `` {
+		return 0, kind
+	}
+
+	for i, b := range run {
+		if bytes.IndexByte(keySeparators, b) >= 0 {
+			if kind := candidateKind(run[i+1:], opts); kind != `` {
+				return i + 1, kind
+			}
+		}
+	}
+
+	return 0, ``
+}
+
+// candidateKind returns the kind of the candidate s, or an empty string, if s is not a plausible encoding.
+func candidateKind(s []byte, opts scanOptions) string {
+	kind := kindData
+	switch {
+	case len(s) == keyLength:
+		kind = kindKey
+	case opts.keysOnly || len(s) < max(opts.minBlob, 1):
+		return ``
+	}
+
+	if !z85.IsValid(string(s)) || !looksRandom(s) {
+		return ``
+	}
+
+	return kind
+}
+
+// looksRandom reports whether s looks like random data, so words, code and sequences are not mistaken for encoded data.
+// In a random encoding about 30% of the characters are lower case letters and 30% are upper case letters
+// that seldom follow a letter of the same case, and the characters are mostly distinct and seldom in sequence.
+// The thresholds reject about 0.1% of random keys.
+func looksRandom(s []byte) bool {
+	var seen [256]bool
+	var classes [classCount]int
+	distinct := 0
+	sameCase := 0
+	sequential := 0
+	previousClass := -1
+	for i, b := range s {
+		if !seen[b] {
+			seen[b] = true
+			distinct++
+		}
+
+		if i > 0 && b == s[i-1]+1 {
+			sequential++
+		}
+
+		class := characterClass(b)
+		classes[class]++
+		if class == previousClass && (class == classLower || class == classUpper) {
+			sameCase++
+		}
+
+		previousClass = class
+	}
+
+	usedClasses := 0
+	for _, count := range classes {
+		if count > 0 {
+			usedClasses++
+		}
+	}
+
+	return usedClasses >= minCharacterClasses &&
+		100*classes[classLower] <= maxCasePercent*len(s) &&
+		100*classes[classUpper] <= maxCasePercent*len(s) &&
+		100*sameCase <= maxSameCasePercent*(len(s)-1) &&
+		100*distinct >= min(minDistinctPercent*len(s), 100*maxRequiredDistinct) &&
+		100*sequential <= maxSequentialPercent*len(s)
+}
+
+// characterClass returns the class of the character b.
+func characterClass(b byte) int {
+	switch {
+	case b >= '0' && b <= '9':
+		return classDigit
+	case b >= 'a' && b <= 'z':
+		return classLower
+	case b >= 'A' && b <= 'Z':
+		return classUpper
+	default:
+		return classOther
+	}
+}
+
+// redact returns the start and the end of s.
+func redact(s string) string {
+	if len(s) <= 2*redactedLength {
+		return s
+	}
+
+	return s[:redactedLength] + `...` + s[len(s)-redactedLength:]
+}
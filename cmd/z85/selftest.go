@@ -0,0 +1,453 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// maxRoundTripSize is the maximum size of the data of the round trip tests.
+// It is large enough to use all code paths, including the accelerated ones.
+const maxRoundTripSize = 1024
+
+// randomSeed is the seed of the data of the round trip tests, so the tests are reproducible.
+const randomSeed = 85
+
+// ******** Private types ********
+
+// selfTest is one test of the selftest command.
+type selfTest struct {
+	name string
+	run  func() error
+}
+
+// specVector is a test vector of the Z85 specification.
+type specVector struct {
+	data    []byte
+	encoded string
+}
+
+// ******** Private variables ********
+
+// specVectors contains the test vectors of RFC 32 and its reference implementation.
+var specVectors = []specVector{
+	{
+		data:    []byte{0x86, 0x4f, 0xd2, 0x6f, 0xb5, 0x59, 0xf7, 0x5b},
+		encoded: `HelloWorld`,
+	},
+	{
+		data: []byte{
+			0x8e, 0x0b, 0xdd, 0x69, 0x76, 0x28, 0xb9, 0x1d, 0x8f, 0x24, 0x55, 0x87, 0xee, 0x95, 0xc5, 0xb0,
+			0x4d, 0x48, 0x96, 0x3f, 0x79, 0x25, 0x98, 0x77, 0xb4, 0x9c, 0xd9, 0x06, 0x3a, 0xea, 0xd3, 0xb7,
+		},
+		encoded: `JTKVSB%%)wK0E.X)V>+}o?pNmC{O&4W4b!Ni{Lh6`,
+	},
+}
+
+// selfTests contains the tests of the selftest command.
+var selfTests = []selfTest{
+	{`specification vectors`, testSpecVectors},
+	{`chunk boundary values`, testChunkBoundaries},
+	{`round trip Z85`, testRoundTrip},
+	{`round trip Z85P`, testPaddedRoundTrip},
+	{`streaming round trip`, testStreamRoundTrip},
+	{`formatted round trip`, testFormattedRoundTrip},
+	{`checksum`, testChecksum},
+	{`invalid characters`, testInvalidCharacters},
+	{`invalid lengths`, testInvalidLengths},
+	{`overflow`, testOverflow},
+	{`invalid padding`, testInvalidPadding},
+}
+
+// ******** Private functions ********
+
+// runSelftest executes the selftest command, which tests the encoding of this build.
+func runSelftest(args []string, _ io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet(programName+` selftest`, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s selftest\n\n", programName)
+		fmt.Fprintln(stderr, `Runs the test vectors of the specification and round trip and negative tests against this build.`)
+		fmt.Fprintln(stderr, `The exit code is 1, if a test fails.`)
+	}
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "%s: unexpected argument '%s'\n", programName, flags.Arg(0))
+		flags.Usage()
+		return exitUsage
+	}
+
+	capabilities := z85.Capabilities()
+	fmt.Fprintf(stdout, "%s %s, acceleration %s\n", programName, capabilities.Version, capabilities.Acceleration)
+
+	failCount := 0
+	for _, test := range selfTests {
+		if err := test.run(); err != nil {
+			fmt.Fprintf(stdout, "FAIL  %s: %v\n", test.name, err)
+			failCount++
+		} else {
+			fmt.Fprintf(stdout, "ok    %s\n", test.name)
+		}
+	}
+
+	if failCount > 0 {
+		fmt.Fprintf(stdout, "%d of %d tests failed\n", failCount, len(selfTests))
+		return exitError
+	}
+
+	fmt.Fprintf(stdout, "all %d tests passed\n", len(selfTests))
+	return exitOK
+}
+
+// testSpecVectors tests if the test vectors of the specification are encoded and decoded.
+func testSpecVectors() error {
+	for _, vector := range specVectors {
+		encoded, err := z85.StdEncoding.EncodeToString(vector.data)
+		if err != nil {
+			return err
+		}
+
+		if encoded != vector.encoded {
+			return fmt.Errorf(`encoding resulted in '%s' instead of '%s'`, encoded, vector.encoded)
+		}
+
+		if err = checkDecode(z85.StdEncoding, encoded, vector.data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// testChunkBoundaries tests if the values at the boundaries of the digits of a chunk are encoded and decoded.
+func testChunkBoundaries() error {
+	for value := uint64(1); value <= 1<<32; value *= 85 {
+		for _, v := range []uint64{value - 1, value, value + 1, 1<<32 - value} {
+			if v >= 1<<32 {
+				continue
+			}
+
+			encoded := z85.EncodeChunkString(uint32(v))
+			if expected := referenceEncode([]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}); encoded != expected {
+				return fmt.Errorf(`value %08x was encoded as '%s' instead of '%s'`, v, encoded, expected)
+			}
+
+			if decoded := z85.MustDecodeChunk(encoded); decoded != uint32(v) {
+				return fmt.Errorf(`'%s' was decoded as %08x instead of %08x`, encoded, decoded, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// testRoundTrip tests if data of all sizes is encoded like the reference encoder does it and decoded back.
+func testRoundTrip() error {
+	data := randomData(maxRoundTripSize)
+	for size := 0; size <= len(data); size += 4 {
+		encoded, err := z85.StdEncoding.EncodeToString(data[:size])
+		if err != nil {
+			return err
+		}
+
+		if expected := referenceEncode(data[:size]); encoded != expected {
+			return fmt.Errorf(`encoding of %d bytes differs from the reference encoding`, size)
+		}
+
+		if err = checkDecode(z85.StdEncoding, encoded, data[:size]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// testPaddedRoundTrip tests if data of all sizes is encoded and decoded with the padded encoding.
+func testPaddedRoundTrip() error {
+	data := randomData(maxRoundTripSize / 4)
+	for size := 0; size <= len(data); size++ {
+		encoded, err := z85.PaddedEncoding.EncodeToString(data[:size])
+		if err != nil {
+			return err
+		}
+
+		if err = checkDecode(z85.PaddedEncoding, encoded, data[:size]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// testStreamRoundTrip tests if data that is written and read in parts of all sizes is encoded and decoded.
+func testStreamRoundTrip() error {
+	data := randomData(maxRoundTripSize / 4)
+	expected := referenceEncode(data)
+
+	for partSize := 1; partSize <= 64; partSize++ {
+		var buffer bytes.Buffer
+		encoder := z85.NewEncoder(&buffer)
+		for start := 0; start < len(data); start += partSize {
+			if _, err := encoder.Write(data[start:min(start+partSize, len(data))]); err != nil {
+				return err
+			}
+		}
+
+		if err := encoder.Close(); err != nil {
+			return err
+		}
+
+		if buffer.String() != expected {
+			return fmt.Errorf(`streaming encoding with parts of %d bytes differs from the reference encoding`, partSize)
+		}
+
+		decoded, err := readInParts(z85.NewDecoder(&buffer), partSize)
+		if err != nil {
+			return err
+		}
+
+		if !bytes.Equal(decoded, data) {
+			return fmt.Errorf(`streaming decoding with parts of %d bytes did not result in the data`, partSize)
+		}
+	}
+
+	return nil
+}
+
+// testFormattedRoundTrip tests if the output formats of this program are decoded.
+func testFormattedRoundTrip() error {
+	data := randomData(maxRoundTripSize / 4)
+	for _, opts := range []options{
+		{separator: ` `, jobs: 1},
+		{separator: ` `, jobs: 1, raw: true},
+		{separator: ` `, jobs: 1, wrap: 7},
+		{separator: `_`, jobs: 1, group: 3, armor: true},
+		{separator: `,`, jobs: 1, wrap: 12, group: 5, padded: true},
+		{separator: ` `, jobs: 1, check: true},
+		{separator: ` `, jobs: 4, wrap: 9, padded: true},
+	} {
+		for size := 0; size <= len(data); size += 4 {
+			var encoded, decoded bytes.Buffer
+			if err := encode(&encoded, bytes.NewReader(data[:size]), opts); err != nil {
+				return err
+			}
+
+			opts.decode = true
+			opts.verify = opts.check
+			if err := decode(&decoded, &encoded, opts); err != nil {
+				return err
+			}
+
+			opts.decode = false
+			opts.verify = false
+			if !bytes.Equal(decoded.Bytes(), data[:size]) {
+				return fmt.Errorf(`decoding of %d bytes with the format %+v did not result in the data`, size, opts)
+			}
+		}
+	}
+
+	return nil
+}
+
+// testChecksum tests if checksums are appended and corrupted data is detected.
+func testChecksum() error {
+	data := randomData(64)
+	encoded, err := z85.EncodeCheck(data)
+	if err != nil {
+		return err
+	}
+
+	decoded, err := z85.DecodeCheck(encoded)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(decoded, data) {
+		return errors.New(`decoding with checksum did not result in the data`)
+	}
+
+	for position := 0; position < len(encoded); position++ {
+		corrupted := []byte(encoded)
+		corrupted[position] = z85.Alphabet[(strings.IndexByte(z85.Alphabet, corrupted[position])+1)%len(z85.Alphabet)]
+
+		if _, err = z85.DecodeCheck(string(corrupted)); err == nil {
+			return fmt.Errorf(`corruption at position %d was not detected`, position)
+		}
+	}
+
+	return nil
+}
+
+// testInvalidCharacters tests if every character outside the alphabet is rejected at every position of a chunk.
+// Control characters are reported with their own kind.
+func testInvalidCharacters() error {
+	for value := 0; value < 256; value++ {
+		b := byte(value)
+		if strings.IndexByte(z85.Alphabet, b) >= 0 {
+			continue
+		}
+
+		for position := 0; position < groupSize; position++ {
+			source := []byte(`00000`)
+			source[position] = b
+
+			_, err := z85.StdEncoding.DecodeString(string(source))
+
+			var codecErr *z85.CodecError
+			if !errors.As(err, &codecErr) ||
+				(codecErr.Kind != z85.KindInvalidByte && codecErr.Kind != z85.KindControlCharacter) ||
+				codecErr.EncodedOffset != int64(position) || codecErr.Byte != b {
+				return fmt.Errorf(`character %q at position %d resulted in the error '%v'`, b, position, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// testInvalidLengths tests if data and text with invalid lengths are rejected.
+func testInvalidLengths() error {
+	for size := 1; size < 4; size++ {
+		if _, err := z85.StdEncoding.EncodeToString(make([]byte, size)); !z85.IsErrInvalidLength(err) {
+			return fmt.Errorf(`encoding of %d bytes resulted in the error '%v'`, size, err)
+		}
+	}
+
+	for size := 1; size < groupSize; size++ {
+		if _, err := z85.StdEncoding.DecodeString(strings.Repeat(`0`, groupSize+size)); !z85.IsErrInvalidLength(err) {
+			return fmt.Errorf(`decoding of %d characters resulted in the error '%v'`, groupSize+size, err)
+		}
+	}
+
+	return nil
+}
+
+// testOverflow tests if chunks with values that do not fit into 32 bits are rejected.
+func testOverflow() error {
+	maxChunk := z85.EncodeChunkString(1<<32 - 1)
+	if _, err := z85.StdEncoding.DecodeString(maxChunk); err != nil {
+		return fmt.Errorf(`decoding of the maximum value '%s' failed: %w`, maxChunk, err)
+	}
+
+	for _, source := range []string{maxChunk[:4] + `1`, `%%%%%`, `#####`} {
+		if _, err := z85.StdEncoding.DecodeString(source); !z85.IsErrOverflow(err) {
+			return fmt.Errorf(`decoding of '%s' resulted in the error '%v'`, source, err)
+		}
+	}
+
+	return nil
+}
+
+// testInvalidPadding tests if padded text with an invalid padding count is rejected.
+func testInvalidPadding() error {
+	for _, source := range []string{`k%^{C4`, `k%^{Ca`, `k%^{C#`, `0`} {
+		if _, err := z85.PaddedEncoding.DecodeString(source); err == nil {
+			return fmt.Errorf(`padded text '%s' was decoded`, source)
+		}
+	}
+
+	return nil
+}
+
+// checkDecode checks if the encoding e decodes encoded into expected.
+func checkDecode(e *z85.Encoding, encoded string, expected []byte) error {
+	decoded, err := e.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(decoded, expected) {
+		return fmt.Errorf(`decoding of '%s' resulted in '% 02x' instead of '% 02x'`, encoded, decoded, expected)
+	}
+
+	return nil
+}
+
+// readInParts reads all data from r with reads of at most partSize bytes.
+func readInParts(r io.Reader, partSize int) ([]byte, error) {
+	var result []byte
+	part := make([]byte, partSize)
+	for {
+		n, err := r.Read(part)
+		result = append(result, part[:n]...)
+
+		if errors.Is(err, io.EOF) {
+			return result, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// referenceEncode encodes data, whose length is a multiple of 4, in the simplest possible way.
+// It is independent of the optimized code of the package.
+func referenceEncode(data []byte) string {
+	result := make([]byte, 0, len(data)/4*groupSize)
+	for i := 0; i+4 <= len(data); i += 4 {
+		value := uint32(data[i])<<24 | uint32(data[i+1])<<16 | uint32(data[i+2])<<8 | uint32(data[i+3])
+
+		var group [groupSize]byte
+		for j := groupSize - 1; j >= 0; j-- {
+			group[j] = z85.Alphabet[value%85]
+			value /= 85
+		}
+
+		result = append(result, group[:]...)
+	}
+
+	return string(result)
+}
+
+// randomData returns size bytes of reproducible pseudo-random data.
+func randomData(size int) []byte {
+	result := make([]byte, size)
+	_, _ = rand.New(rand.NewSource(randomSeed)).Read(result)
+
+	return result
+}
@@ -0,0 +1,244 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private variables ********
+
+// isAlphabet contains true for the characters of the Z85 alphabet.
+var isAlphabet = alphabetTable(z85.Alphabet)
+
+// ******** Private types ********
+
+// textReader reads encoded text and removes a leading byte order mark, armor lines,
+// white space and group separators.
+// None of them is part of the Z85 alphabet, so they can be removed anywhere.
+// It records how many bytes it removed before each position, so offsets in the text can be mapped to offsets in the input.
+type textReader struct {
+	r           *bufio.Reader
+	pending     []byte
+	atLineStart bool
+	skip        func(byte) bool
+	err         error
+	kept        int64
+	dropped     int64
+	runs        []offsetRun
+}
+
+// offsetRun describes count positions in the text, before which the number of removed bytes changed.
+// The positions are start, start+step, ... and the numbers of removed bytes are dropped, dropped+dropStep, ...
+// Wrapped or grouped text has regular gaps, so a few runs describe the whole text.
+type offsetRun struct {
+	start    int64
+	step     int64
+	dropped  int64
+	dropStep int64
+	count    int64
+}
+
+// ******** Private creation functions ********
+
+// newTextReader creates a new textReader that reads from r.
+func newTextReader(r io.Reader) *textReader {
+	return newFilteringTextReader(r, isNonData)
+}
+
+// newGarbageTextReader creates a new textReader that reads from r
+// and removes all characters that are not part of the Z85 alphabet.
+func newGarbageTextReader(r io.Reader) *textReader {
+	return newFilteringTextReader(r, isGarbage)
+}
+
+// newFilteringTextReader creates a new textReader that reads from r and removes the characters for which skip is true.
+func newFilteringTextReader(r io.Reader, skip func(byte) bool) *textReader {
+	br := bufio.NewReaderSize(r, bufferSize)
+	prefix, _ := br.Peek(utf8.UTFMax)
+	bom, _ := br.Discard(bomLen(prefix))
+
+	return &textReader{r: br, atLineStart: true, skip: skip, dropped: int64(bom)}
+}
+
+// ******** Private functions ********
+
+// bomLen returns the length of the byte order mark at the start of text, or 0, if it does not start with one.
+// The mark is the one that z85.TrimBOM removes, so only one definition of it exists.
+func bomLen(text []byte) int {
+	prefix := string(text[:min(len(text), utf8.UTFMax)])
+
+	return len(prefix) - len(z85.TrimBOM(prefix))
+}
+
+// Read reads the encoded characters of the text into p.
+func (t *textReader) Read(p []byte) (int, error) {
+	for len(t.pending) == 0 {
+		if t.err != nil {
+			return 0, t.err
+		}
+
+		// A line that is longer than the buffer is returned in parts.
+		// Only the first part is checked for an armor line, as armor lines are short.
+		line, err := t.r.ReadSlice('\n')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			err = nil
+		}
+
+		lineStart := t.atLineStart
+		t.atLineStart = len(line) > 0 && line[len(line)-1] == '\n'
+		t.err = err
+
+		if lineStart && isArmorLine(line) {
+			t.dropped += int64(len(line))
+			continue
+		}
+
+		// The line is only valid until the next read, so it can be changed in place.
+		t.pending = line[:t.removeSkipped(line)]
+	}
+
+	n := copy(p, t.pending)
+	t.pending = t.pending[n:]
+
+	return n, nil
+}
+
+// removeSkipped removes the characters for which skip is true from buffer and returns the remaining length.
+// The number of removed bytes is recorded at each position where it changed.
+func (t *textReader) removeSkipped(buffer []byte) int {
+	n := 0
+	recorded := t.recordedDropped()
+	for _, b := range buffer {
+		if t.skip(b) {
+			t.dropped++
+			continue
+		}
+
+		if t.dropped != recorded {
+			t.record(t.kept+int64(n), t.dropped)
+			recorded = t.dropped
+		}
+
+		buffer[n] = b
+		n++
+	}
+
+	t.kept += int64(n)
+
+	return n
+}
+
+// recordedDropped returns the number of removed bytes at the last recorded position.
+func (t *textReader) recordedDropped() int64 {
+	if len(t.runs) == 0 {
+		return 0
+	}
+
+	last := &t.runs[len(t.runs)-1]
+
+	return last.dropped + (last.count-1)*last.dropStep
+}
+
+// record records that dropped bytes have been removed before the position in the text.
+// The position is appended to the last run, if it continues its steps.
+func (t *textReader) record(position int64, dropped int64) {
+	if len(t.runs) > 0 {
+		last := &t.runs[len(t.runs)-1]
+		if last.count == 1 {
+			last.step = position - last.start
+			last.dropStep = dropped - last.dropped
+			last.count = 2
+			return
+		}
+
+		if position-last.start == last.count*last.step && dropped-last.dropped == last.count*last.dropStep {
+			last.count++
+			return
+		}
+	}
+
+	t.runs = append(t.runs, offsetRun{start: position, dropped: dropped, count: 1})
+}
+
+// inputOffset returns the offset in the input of the given offset in the text.
+func (t *textReader) inputOffset(offset int64) int64 {
+	i := sort.Search(len(t.runs), func(i int) bool { return t.runs[i].start > offset }) - 1
+	if i < 0 {
+		return offset
+	}
+
+	run := t.runs[i]
+	index := int64(0)
+	if run.count > 1 {
+		index = min((offset-run.start)/run.step, run.count-1)
+	}
+
+	return offset + run.dropped + index*run.dropStep
+}
+
+// moveError returns a copy of a CodecError with the encoded offset moved from the text to the input.
+// The error itself is not changed, as a decoder returns the same error again, when it is read again.
+func (t *textReader) moveError(err error) error {
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		return err
+	}
+
+	moved := *codecErr
+
+	return moveError(&moved, 0, t.inputOffset(codecErr.EncodedOffset)-codecErr.EncodedOffset)
+}
+
+// isNonData reports whether b is a white space character or a separator.
+func isNonData(b byte) bool {
+	return b == '\t' || b == '\n' || b == '\r' || b == '\v' || b == '\f' || strings.IndexByte(separators, b) >= 0
+}
+
+// isGarbage reports whether b is not a character of the Z85 alphabet.
+func isGarbage(b byte) bool {
+	return !isAlphabet[b]
+}
+
+// alphabetTable returns a table that contains true for the characters of alphabet.
+func alphabetTable(alphabet string) *[256]bool {
+	result := new([256]bool)
+	for i := 0; i < len(alphabet); i++ {
+		result[alphabet[i]] = true
+	}
+
+	return result
+}
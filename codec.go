@@ -0,0 +1,141 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"slices"
+	"sync"
+)
+
+// ******** Public types ********
+
+// Codec encodes and decodes with buffers that are reused between calls.
+// The results of its methods are only valid until the next call, so they must be copied, if they are kept.
+// This avoids an allocation per call for services that process many small messages.
+// A Codec must not be used concurrently. Use a CodecPool to share codecs between goroutines.
+type Codec struct {
+	encoding  *Encoding
+	encodeBuf []byte
+	decodeBuf []byte
+}
+
+// CodecPool is a pool of Codecs for the same encoding that is backed by a sync.Pool.
+// It is safe for concurrent use.
+type CodecPool struct {
+	pool sync.Pool
+}
+
+// ******** Public creation functions ********
+
+// NewCodec creates a new Codec for the encoding e.
+// If e is nil, StdEncoding is used.
+func NewCodec(e *Encoding) *Codec {
+	if e == nil {
+		e = StdEncoding
+	}
+
+	return &Codec{encoding: e}
+}
+
+// NewCodecPool creates a new pool of Codecs for the encoding e.
+// If e is nil, StdEncoding is used.
+func NewCodecPool(e *Encoding) *CodecPool {
+	result := &CodecPool{}
+	result.pool.New = func() any {
+		return NewCodec(e)
+	}
+
+	return result
+}
+
+// ******** Public functions ********
+
+// Encode encodes src and returns the encoding in the buffer of the Codec.
+// The result is only valid until the next call of a method of the Codec.
+func (c *Codec) Encode(src []byte) ([]byte, error) {
+	encodedLen, err := c.encoding.encodedLength(len(src))
+	if err != nil {
+		return nil, err
+	}
+
+	c.encodeBuf = slices.Grow(c.encodeBuf[:0], encodedLen)[:encodedLen]
+	c.encoding.encode(c.encodeBuf, src)
+
+	return c.encodeBuf, nil
+}
+
+// EncodeToString encodes src and returns the encoding as a string.
+// Only the string is allocated.
+func (c *Codec) EncodeToString(src []byte) (string, error) {
+	result, err := c.Encode(src)
+	if err != nil {
+		return ``, err
+	}
+
+	return string(result), nil
+}
+
+// Decode decodes the string s and returns the data in the buffer of the Codec.
+// The result is only valid until the next call of a method of the Codec.
+func (c *Codec) Decode(s string) ([]byte, error) {
+	return codecDecode(c, s)
+}
+
+// DecodeBytes decodes src and returns the data in the buffer of the Codec.
+// The result is only valid until the next call of a method of the Codec.
+func (c *Codec) DecodeBytes(src []byte) ([]byte, error) {
+	return codecDecode(c, src)
+}
+
+// Get returns a Codec from the pool or creates a new one.
+func (p *CodecPool) Get() *Codec {
+	return p.pool.Get().(*Codec)
+}
+
+// Put returns a Codec to the pool.
+// The results of the Codec must not be used after this call.
+func (p *CodecPool) Put(c *Codec) {
+	p.pool.Put(c)
+}
+
+// ******** Private functions ********
+
+// codecDecode decodes source into the decode buffer of the Codec.
+func codecDecode[T string | []byte](c *Codec, source T) ([]byte, error) {
+	// This is large enough for padded and wrapped encodings, as well.
+	maxLen := len(source) / encodedChunkSize * byteChunkSize
+	c.decodeBuf = slices.Grow(c.decodeBuf[:0], maxLen)[:maxLen]
+
+	n, err := decodeInto(c.encoding, c.decodeBuf, source)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.decodeBuf[:n], nil
+}
@@ -0,0 +1,149 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"sync"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestCodec tests if a Codec encodes and decodes like the encoding it uses.
+func TestCodec(t *testing.T) {
+	encodings := map[string]*z85.Encoding{
+		`Std`:     nil,
+		`Padded`:  z85.PaddedEncoding,
+		`Wrapped`: z85.StdEncoding.WithWrap(16),
+	}
+
+	for name, encoding := range encodings {
+		codec := z85.NewCodec(encoding)
+		if encoding == nil {
+			encoding = z85.StdEncoding
+		}
+
+		for size := 0; size <= maxSliceSize; size += 3 {
+			if size%4 != 0 && encoding != z85.PaddedEncoding {
+				continue
+			}
+
+			data := make([]byte, size)
+			_, _ = crand.Read(data)
+
+			expected, _ := encoding.EncodeToString(data)
+			encoded, err := codec.Encode(data)
+			if err != nil {
+				t.Fatalf(`%s: Encoding of size %d failed: %v`, name, size, err)
+			}
+
+			if string(encoded) != expected {
+				t.Fatalf(`%s: Encoding of size %d is '%s', but should be '%s'`, name, size, encoded, expected)
+			}
+
+			decoded, err := codec.Decode(expected)
+			if err != nil {
+				t.Fatalf(`%s: Decoding of size %d failed: %v`, name, size, err)
+			}
+
+			if !bytes.Equal(decoded, data) {
+				t.Fatalf(`%s: Decoding of size %d is '% 02x', but should be '% 02x'`, name, size, decoded, data)
+			}
+
+			decoded, err = codec.DecodeBytes([]byte(expected))
+			if err != nil || !bytes.Equal(decoded, data) {
+				t.Fatalf(`%s: Decoding bytes of size %d failed: %v`, name, size, err)
+			}
+		}
+	}
+}
+
+// TestCodecErrors tests if a Codec returns the errors of the encoding.
+func TestCodecErrors(t *testing.T) {
+	codec := z85.NewCodec(nil)
+
+	if _, err := codec.Encode(make([]byte, 3)); !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	if _, err := codec.EncodeToString(make([]byte, 3)); !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	if _, err := codec.Decode(`Hello World`); !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	if _, err := codec.Decode(`Hello~orld`); !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Expected invalid byte error, but got: %v`, err)
+	}
+}
+
+// TestCodecNoAllocations tests if a Codec does not allocate once its buffers are large enough.
+func TestCodecNoAllocations(t *testing.T) {
+	codec := z85.NewCodec(nil)
+
+	allocs := testing.AllocsPerRun(iterationCount, func() {
+		_, _ = codec.Encode(clearTheOne)
+		_, _ = codec.Decode(encodedTheOne)
+	})
+	if allocs != 0 {
+		t.Fatalf(`Codec allocates %.1f times per run`, allocs)
+	}
+}
+
+// TestCodecPool tests if codecs from a pool can be used concurrently.
+func TestCodecPool(t *testing.T) {
+	pool := z85.NewCodecPool(nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < iterationCount; j++ {
+				codec := pool.Get()
+				encoded, _ := codec.EncodeToString(clearTheOne)
+				decoded, err := codec.Decode(encoded)
+				if err != nil || !bytes.Equal(decoded, clearTheOne) {
+					t.Errorf(`Round trip failed: %v`, err)
+				}
+
+				pool.Put(codec)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
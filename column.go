@@ -0,0 +1,99 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Public functions ********
+
+// EncodeColumn encodes a column of fixed-width binary values into one packed Z85 string.
+// The values are stored one after the other in values, each stride bytes long.
+// Each value is padded with zero bytes to a multiple of 4 and encoded separately.
+// The returned offsets contain the start of each encoded value in the string, followed by the length of the string.
+func EncodeColumn(values []byte, stride int) (string, []int, error) {
+	if stride <= 0 {
+		return ``, nil, newInvalidStrideError(stride, 0, 0)
+	}
+
+	count := len(values) / stride
+	paddedStride := (stride + byteChunkMask) &^ byteChunkMask
+	width := (paddedStride >> byteChunkShift) * encodedChunkSize
+
+	// The incomplete last value is the first one that does not match.
+	if len(values)%stride != 0 {
+		return ``, nil, newInvalidStrideError(stride, count*stride, count*width)
+	}
+
+	result := make([]byte, count*width)
+	offsets := make([]int, count+1)
+	padded := make([]byte, paddedStride)
+	destination := result
+	for i := 0; i < count; i++ {
+		copy(padded, values[i*stride:(i+1)*stride])
+		StdEncoding.encodeChunks(destination, padded)
+
+		offsets[i] = i * width
+		destination = destination[width:]
+	}
+	offsets[count] = len(result)
+
+	return string(result), offsets, nil
+}
+
+// DecodeColumn decodes a packed Z85 string that was created by EncodeColumn back into a column of values,
+// each stride bytes long.
+func DecodeColumn(encoded string, offsets []int, stride int) ([]byte, error) {
+	if stride <= 0 || len(offsets) == 0 {
+		return nil, newInvalidStrideError(stride, 0, 0)
+	}
+
+	count := len(offsets) - 1
+	paddedStride := (stride + byteChunkMask) &^ byteChunkMask
+	width := (paddedStride >> byteChunkShift) * encodedChunkSize
+
+	if len(encoded) != count*width {
+		return nil, newInvalidStrideError(stride, 0, 0)
+	}
+
+	result := make([]byte, count*stride)
+	padded := make([]byte, paddedStride)
+	for i := 0; i < count; i++ {
+		start := offsets[i]
+		if start != i*width || offsets[i+1] != start+width {
+			return nil, newInvalidStrideError(stride, i*stride, i*width)
+		}
+
+		err := decodeChunks(StdEncoding, padded, encoded[start:start+width], uint(start))
+		if err != nil {
+			return nil, err
+		}
+
+		copy(result[i*stride:], padded[:stride])
+	}
+
+	return result, nil
+}
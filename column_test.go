@@ -0,0 +1,113 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"errors"
+	"github.com/xformerfhs/z85"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestColumnRoundTrip tests if a column is restored after encoding and decoding.
+func TestColumnRoundTrip(t *testing.T) {
+	for _, stride := range []int{1, 3, 4, 6, 16} {
+		values := make([]byte, stride*7)
+		_, _ = crand.Read(values)
+
+		encoded, offsets, err := z85.EncodeColumn(values, stride)
+		if err != nil {
+			t.Fatalf(`Encoding with stride %d failed: %v`, stride, err)
+		}
+
+		if len(offsets) != 8 || offsets[7] != len(encoded) {
+			t.Fatalf(`Wrong offsets for stride %d: %v`, stride, offsets)
+		}
+
+		var decoded []byte
+		decoded, err = z85.DecodeColumn(encoded, offsets, stride)
+		if err != nil {
+			t.Fatalf(`Decoding with stride %d failed: %v`, stride, err)
+		}
+
+		if !bytes.Equal(decoded, values) {
+			t.Fatalf(`Decoded column with stride %d does not match`, stride)
+		}
+	}
+}
+
+// TestColumnValues tests if each value can be decoded on its own with the offsets.
+func TestColumnValues(t *testing.T) {
+	values := append(append([]byte{}, clearTheOne...), clearTheOne...)
+
+	encoded, offsets, err := z85.EncodeColumn(values, len(clearTheOne))
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if encoded[offsets[1]:offsets[2]] != encodedTheOne {
+		t.Fatalf(`Second value is not encoded as '%s': '%s'`, encodedTheOne, encoded)
+	}
+}
+
+// TestEncodeColumnInvalidStride tests if an error occurs when the values do not match the stride.
+func TestEncodeColumnInvalidStride(t *testing.T) {
+	for _, stride := range []int{0, -1, 3} {
+		_, _, err := z85.EncodeColumn(clearTheOne, stride)
+		if !z85.IsErrInvalidStride(err) {
+			t.Fatalf(`Wrong error for stride %d: '%v'`, stride, err)
+		}
+	}
+
+	// The incomplete third value starts at byte 6 and would be encoded at character 10.
+	_, _, err := z85.EncodeColumn(clearTheOne, 3)
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindInvalidStride, 6, 10, 0)
+}
+
+// TestDecodeColumnInvalidOffsets tests if an error occurs when the offsets do not match the stride.
+func TestDecodeColumnInvalidOffsets(t *testing.T) {
+	_, err := z85.DecodeColumn(encodedTheOne, []int{0, 4, 10}, 4)
+	if !z85.IsErrInvalidStride(err) {
+		t.Fatalf(`Wrong error: '%v'`, err)
+	}
+
+	_, err = z85.DecodeColumn(encodedTheOne, []int{0, 10}, 4)
+	if !z85.IsErrInvalidStride(err) {
+		t.Fatalf(`Wrong error: '%v'`, err)
+	}
+}
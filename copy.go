@@ -0,0 +1,159 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"io"
+)
+
+// ******** Private constants ********
+
+// copyUnit is the least common multiple of the raw and the encoded chunk size.
+// A buffer whose size is a multiple of it holds complete raw and complete encoded chunks.
+const copyUnit = byteChunkSize * encodedChunkSize
+
+// defaultCopySize is the buffer size that io.Copy uses.
+const defaultCopySize = 32 * 1024
+
+// ******** Public functions ********
+
+// OptimalBufferSize rounds rawChunk up to the next multiple of 20.
+// A buffer of this size holds complete raw chunks of 4 bytes as well as complete encoded chunks of 5 characters,
+// so data can be encoded and decoded buffer by buffer without carrying incomplete chunks.
+// If rawChunk is 0 or less, the size of the buffer of io.Copy is used.
+func OptimalBufferSize(rawChunk int) int {
+	if rawChunk <= 0 {
+		rawChunk = defaultCopySize
+	}
+
+	// Rounding up must not overflow.
+	if rawChunk > MaxEncodeInputLen-copyUnit {
+		return MaxEncodeInputLen / copyUnit * copyUnit
+	}
+
+	return (rawChunk + copyUnit - 1) / copyUnit * copyUnit
+}
+
+// CopyEncode reads src until EOF, writes its Z85 encoding to dst and returns the number of bytes written.
+// It returns an error, if the length of the data is not a multiple of 4.
+func CopyEncode(dst io.Writer, src io.Reader) (int64, error) {
+	bufferSize := OptimalBufferSize(0)
+	in := make([]byte, bufferSize)
+	out := make([]byte, bufferSize/byteChunkSize*encodedChunkSize)
+
+	count := uint(0)
+	written := int64(0)
+	for {
+		n, err := fillBuffer(src, in)
+		fullLen := n &^ byteChunkMask
+		if fullLen > 0 {
+			encodedLen := fullLen + fullLen>>byteChunkShift
+			StdEncoding.encodeChunks(out, in[:fullLen])
+			count += uint(fullLen)
+
+			m, writeErr := dst.Write(out[:encodedLen])
+			written += int64(m)
+			if writeErr != nil {
+				return written, writeErr
+			}
+		}
+
+		switch {
+		case err == io.EOF:
+			if fullLen < n {
+				return written, newInvalidLengthError(byteChunkSize, count)
+			}
+
+			return written, nil
+
+		case err != nil:
+			return written, err
+		}
+	}
+}
+
+// CopyDecode reads Z85 encoded data from src until EOF, writes its decoding to dst
+// and returns the number of bytes written.
+// Errors are CodecErrors with the offsets in the stream.
+func CopyDecode(dst io.Writer, src io.Reader) (int64, error) {
+	bufferSize := OptimalBufferSize(0)
+	in := make([]byte, bufferSize)
+	out := make([]byte, bufferSize/encodedChunkSize*byteChunkSize)
+
+	position := uint(0)
+	written := int64(0)
+	for {
+		n, err := fillBuffer(src, in)
+		fullLen := n - n%encodedChunkSize
+		if fullLen > 0 {
+			decodeErr := decodeChunks(StdEncoding, out, in[:fullLen], position)
+			if decodeErr != nil {
+				return written, decodeErr
+			}
+
+			position += uint(fullLen)
+
+			m, writeErr := dst.Write(out[:fullLen-fullLen/encodedChunkSize])
+			written += int64(m)
+			if writeErr != nil {
+				return written, writeErr
+			}
+		}
+
+		switch {
+		case err == io.EOF:
+			if fullLen < n {
+				return written, newInvalidLengthError(encodedChunkSize, position)
+			}
+
+			return written, nil
+
+		case err != nil:
+			return written, err
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// fillBuffer reads from src until buffer is full or src returns an error.
+// Unlike io.ReadFull, it returns the error of src unchanged,
+// so io.EOF is the end of the data and io.ErrUnexpectedEOF of src stays an error.
+func fillBuffer(src io.Reader, buffer []byte) (int, error) {
+	n := 0
+	for n < len(buffer) {
+		m, err := src.Read(buffer[n:])
+		n += m
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
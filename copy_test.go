@@ -0,0 +1,152 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestOptimalBufferSize tests if the buffer sizes are multiples of 4 and 5.
+func TestOptimalBufferSize(t *testing.T) {
+	tests := []struct {
+		rawChunk int
+		expected int
+	}{
+		{1, 20},
+		{20, 20},
+		{21, 40},
+		{4096, 4100},
+		{0, 32780},
+		{-1, 32780},
+	}
+
+	for _, test := range tests {
+		size := z85.OptimalBufferSize(test.rawChunk)
+		if size != test.expected {
+			t.Fatalf(`Buffer size for %d is %d, but should be %d`, test.rawChunk, size, test.expected)
+		}
+	}
+}
+
+// TestCopyRoundTrip tests if data survives CopyEncode and CopyDecode with readers that return few bytes.
+func TestCopyRoundTrip(t *testing.T) {
+	for _, size := range []int{0, 4, 32780, 100_000} {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		var encoded bytes.Buffer
+		written, err := z85.CopyEncode(&encoded, iotest.HalfReader(bytes.NewReader(data)))
+		if err != nil {
+			t.Fatalf(`Encoding of size %d failed: %v`, size, err)
+		}
+
+		expected, _ := z85.Encode(data)
+		if encoded.String() != expected || written != int64(len(expected)) {
+			t.Fatalf(`Encoding of size %d is wrong or has wrong length %d`, size, written)
+		}
+
+		var decoded bytes.Buffer
+		written, err = z85.CopyDecode(&decoded, iotest.OneByteReader(&encoded))
+		if err != nil {
+			t.Fatalf(`Decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded.Bytes(), data) || written != int64(size) {
+			t.Fatalf(`Decoding of size %d is wrong or has wrong length %d`, size, written)
+		}
+	}
+}
+
+// TestCopyInvalidLength tests if data with an invalid length is rejected after the complete chunks are written.
+func TestCopyInvalidLength(t *testing.T) {
+	var encoded bytes.Buffer
+	written, err := z85.CopyEncode(&encoded, bytes.NewReader(clearTheOne[:7]))
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Invalid length not detected when encoding: %v`, err)
+	}
+
+	if written != 5 {
+		t.Fatalf(`Encoding wrote %d bytes, but should have written 5`, written)
+	}
+
+	var decoded bytes.Buffer
+	_, err = z85.CopyDecode(&decoded, strings.NewReader(encodedTheOne+`12`))
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindInvalidLength, 8, 10, 0)
+}
+
+// TestCopyDecodeOffsets tests if decoding errors have the offsets in the stream.
+func TestCopyDecodeOffsets(t *testing.T) {
+	source := strings.Repeat(encodedTheOne, 4000) + `Hell~`
+
+	_, err := z85.CopyDecode(&bytes.Buffer{}, strings.NewReader(source))
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindInvalidByte, 32000, 40004, '~')
+}
+
+// TestCopySourceErrors tests if errors of the source are returned, even if they look like the end of the data.
+func TestCopySourceErrors(t *testing.T) {
+	for _, sourceErr := range []error{io.ErrUnexpectedEOF, fmt.Errorf(`truncated: %w`, io.EOF)} {
+		_, err := z85.CopyEncode(&bytes.Buffer{}, io.MultiReader(bytes.NewReader(clearTheOne), iotest.ErrReader(sourceErr)))
+		if err != sourceErr {
+			t.Fatalf(`Encoding returned '%v' instead of the source error '%v'`, err, sourceErr)
+		}
+
+		var decoded bytes.Buffer
+		written, err := z85.CopyDecode(&decoded, io.MultiReader(strings.NewReader(encodedTheOne), iotest.ErrReader(sourceErr)))
+		if err != sourceErr {
+			t.Fatalf(`Decoding returned '%v' instead of the source error '%v'`, err, sourceErr)
+		}
+
+		if written != int64(len(clearTheOne)) {
+			t.Fatalf(`Decoding wrote %d bytes before the error instead of %d`, written, len(clearTheOne))
+		}
+	}
+}
@@ -0,0 +1,569 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"slices"
+	"unsafe"
+)
+
+// ******** Public types ********
+
+// Encoding is a Z85 style encoding that is defined by an alphabet of 85 characters.
+// Its methods mirror the ones of encoding/base64.Encoding.
+// An Encoding is safe for concurrent use.
+type Encoding struct {
+	encodeTable string
+	decodeTable *[decodeTableSize]byte
+	pairTable   *pairTable
+	padded      bool
+	wrap        int
+	maxLine     int
+	transparent []Range
+}
+
+// ******** Public variables ********
+
+// StdEncoding is the Z85 encoding as specified in https://rfc.zeromq.org/spec/32.
+// The package level functions use this encoding.
+var StdEncoding = &Encoding{
+	encodeTable: encodeTable,
+	decodeTable: &decodeTable,
+	pairTable:   newPairTable(encodeTable),
+}
+
+// ******** Public creation functions ********
+
+// NewEncoding creates a new Encoding with the given alphabet.
+// The alphabet must consist of 85 unique printable ASCII characters other than the space character.
+// The character at index i encodes the value i.
+func NewEncoding(alphabet string) (*Encoding, error) {
+	if len(alphabet) != codeSize {
+		return nil, newInvalidAlphabetError(fmt.Sprintf(`length is %d instead of %d`, len(alphabet), codeSize))
+	}
+
+	table := new([decodeTableSize]byte)
+	for i := range table {
+		table[i] = ivEc
+	}
+
+	for i := 0; i < codeSize; i++ {
+		c := alphabet[i]
+		if c <= ' ' || c >= asciiDel {
+			return nil, newInvalidAlphabetError(fmt.Sprintf(`character %q at position %d is not printable ASCII`, c, i))
+		}
+
+		if table[c] != ivEc {
+			return nil, newInvalidAlphabetError(fmt.Sprintf(`character %q at position %d is a duplicate`, alphabet[i], i))
+		}
+
+		table[c] = byte(i)
+	}
+
+	return &Encoding{
+		encodeTable: alphabet,
+		decodeTable: table,
+		pairTable:   newPairTable(alphabet),
+	}, nil
+}
+
+// ******** Public functions ********
+
+// Alphabet returns the alphabet of the encoding.
+func (e *Encoding) Alphabet() string {
+	return e.encodeTable
+}
+
+// EncodedLen returns the length of the encoding of n bytes.
+// It returns -1, if n is negative, not a multiple of 4 or larger than MaxEncodeInputLen.
+// For a padded encoding n does not need to be a multiple of 4.
+func (e *Encoding) EncodedLen(n int) int {
+	if n < 0 {
+		return -1
+	}
+
+	result, err := e.encodedLength(n)
+	if err != nil {
+		return -1
+	}
+
+	return result
+}
+
+// DecodedLen returns the length of the decoding of an encoded string with n characters.
+// It returns an error, if n is negative or not a multiple of 5.
+// For a padded encoding n must be a multiple of 5 plus 1 and the result is the maximum length,
+// as the number of padding bytes is only known from the encoded data.
+// For a wrapping encoding n is the number of characters without line breaks.
+func (e *Encoding) DecodedLen(n int) (int, error) {
+	if n < 0 {
+		return 0, newInvalidLengthError(encodedChunkSize, 0)
+	}
+
+	return e.decodedLength(n)
+}
+
+// Encode encodes src into dst and returns the number of bytes written.
+// The length of src must be a multiple of 4 and dst must have room for EncodedLen(len(src)) bytes.
+func (e *Encoding) Encode(dst []byte, src []byte) (int, error) {
+	encodedLen, err := e.encodedLength(len(src))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(dst) < encodedLen {
+		return 0, newShortBufferError()
+	}
+
+	e.encode(dst, src)
+
+	return encodedLen, nil
+}
+
+// EncodeToString returns the encoding of src as a string.
+// The length of src must be a multiple of 4.
+func (e *Encoding) EncodeToString(src []byte) (string, error) {
+	encodedLen, err := e.encodedLength(len(src))
+	if err != nil {
+		return ``, err
+	}
+
+	if encodedLen <= smallBufferSize {
+		return e.encodeSmallToString(src, encodedLen), nil
+	}
+
+	result := make([]byte, encodedLen)
+	e.encodeOwned(result, src)
+
+	// The buffer is not referenced anywhere else, so it can become the string without a copy.
+	return unsafe.String(unsafe.SliceData(result), len(result)), nil
+}
+
+// AppendEncode appends the encoding of src to dst and returns the extended slice.
+// The length of src must be a multiple of 4.
+// If an error occurs, dst is returned unchanged.
+func (e *Encoding) AppendEncode(dst []byte, src []byte) ([]byte, error) {
+	encodedLen, err := e.encodedLength(len(src))
+	if err != nil {
+		return dst, err
+	}
+
+	dstLen := len(dst)
+	if encodedLen > math.MaxInt-dstLen {
+		return dst, newInputTooLargeError(((math.MaxInt - dstLen) / encodedChunkSize) * byteChunkSize)
+	}
+
+	dst = slices.Grow(dst, encodedLen)[:dstLen+encodedLen]
+	e.encode(dst[dstLen:], src)
+
+	return dst, nil
+}
+
+// Decode decodes src into dst and returns the number of bytes written.
+// The length of src must be a multiple of 5 and dst must have room for the decoded bytes.
+func (e *Encoding) Decode(dst []byte, src []byte) (int, error) {
+	return decodeInto(e, dst, src)
+}
+
+// DecodeString returns the bytes represented by the encoded string s.
+// The length of s must be a multiple of 5.
+func (e *Encoding) DecodeString(s string) ([]byte, error) {
+	return decodeToSlice(e, s)
+}
+
+// AppendDecode appends the decoding of src to dst and returns the extended slice.
+// The length of src must be a multiple of 5.
+// If an error occurs, dst is returned unchanged.
+func (e *Encoding) AppendDecode(dst []byte, src string) ([]byte, error) {
+	if e.transparent != nil && containsTransparent(e, src) {
+		result, err := e.AppendDecode(dst, string(withoutTransparent(e, nil, src)))
+		return result, remapTransparentError(e, err, src)
+	}
+
+	if e.wrap > 0 && containsLineBreak(src) {
+		result, err := e.AppendDecode(dst, string(removeLineBreaks(src)))
+		return result, remapWrappedError(err, src)
+	}
+
+	decodedLen, err := e.decodedLength(len(src))
+	if err != nil {
+		return dst, err
+	}
+
+	dstLen := len(dst)
+	result := slices.Grow(dst, decodedLen)[:dstLen+decodedLen]
+	decodedLen, err = decodeAll(e, result[dstLen:], src)
+	if err != nil {
+		return dst, err
+	}
+
+	return result[:dstLen+decodedLen], nil
+}
+
+// ******** Private functions ********
+
+// encodedLength checks the length of data to encode and returns the length of its encoding.
+func (e *Encoding) encodedLength(n int) (int, error) {
+	var result int
+	var err error
+	if e.padded {
+		result, err = paddedEncodedLength(n)
+	} else {
+		result, err = encodedLength(n)
+	}
+
+	if err != nil || e.wrap == 0 {
+		return result, err
+	}
+
+	return e.wrappedLength(result)
+}
+
+// decodedLength checks the length of an encoded string and returns the length of its decoding.
+// For a padded encoding this is the length including the padding bytes.
+func (e *Encoding) decodedLength(n int) (int, error) {
+	if e.padded {
+		return paddedDecodedLength(n)
+	}
+
+	return decodedLength(n)
+}
+
+// encode encodes source into destination.
+// The length of destination must be the length returned by encodedLength.
+func (e *Encoding) encode(destination []byte, source []byte) {
+	// A wrapping encoding encodes into the end of destination and then inserts the line feeds.
+	unwrapped := destination
+	if e.wrap > 0 {
+		unwrapped = destination[len(destination)/(e.wrap+1):]
+	}
+
+	if e.padded {
+		e.encodePadded(unwrapped, source)
+	} else {
+		e.encodeChunks(unwrapped, source)
+	}
+
+	if e.wrap > 0 {
+		e.wrapLines(destination)
+	}
+}
+
+// encodeChunks encodes source into destination.
+// The length of source must be a multiple of 4 and destination must be large enough.
+func (e *Encoding) encodeChunks(destination []byte, source []byte) {
+	// Encode as much as possible with the accelerated code path, if there is one.
+	// Otherwise, the common sizes of UUIDs, keys and digests have an unrolled code path.
+	if done := encodeBlocks(destination, source, e.encodeTable); done != 0 {
+		destination = destination[(done>>byteChunkShift)*encodedChunkSize:]
+		source = source[done:]
+	} else if e.encodeFixedSize(destination, source) {
+		return
+	}
+
+	e.encodeScalarChunks(destination, source)
+}
+
+// encodeScalarChunks encodes the chunks of source into destination with the portable implementation.
+func (e *Encoding) encodeScalarChunks(destination []byte, source []byte) {
+	// Encode 2 chunks per iteration to halve the loop overhead.
+	for len(source) >= doubleChunkSize {
+		e.encodeDoubleChunk(destination, binary.BigEndian.Uint64(source[:doubleChunkSize]))
+
+		destination = destination[doubleEncodedChunkSize:]
+		source = source[doubleChunkSize:]
+	}
+
+	if len(source) >= byteChunkSize {
+		e.encodeChunk(destination, binary.BigEndian.Uint32(source[:byteChunkSize]))
+	}
+}
+
+// encodeDoubleChunk encodes a 64 bit value, i.e. 2 chunks, into the first 10 bytes of destination.
+func (e *Encoding) encodeDoubleChunk(destination []byte, value uint64) {
+	_ = destination[doubleEncodedChunkSize-1] // Eliminate bounds checks below.
+
+	pairs := e.pairTable
+
+	// Both halves are computed side by side, so the processor can overlap the multiplications.
+	upper := uint32(value >> 32)
+	lower := uint32(value)
+
+	upperHigh := divPair(upper)
+	lowerHigh := divPair(lower)
+	upperLow := 2 * ((upper - upperHigh*pairDivisor) & pairIndexMask)
+	lowerLow := 2 * ((lower - lowerHigh*pairDivisor) & pairIndexMask)
+
+	upperFirst := divPair(upperHigh)
+	lowerFirst := divPair(lowerHigh)
+	upperMiddle := 2 * ((upperHigh - upperFirst*pairDivisor) & pairIndexMask)
+	lowerMiddle := 2 * ((lowerHigh - lowerFirst*pairDivisor) & pairIndexMask)
+
+	destination[0] = e.encodeTable[upperFirst]
+	destination[1] = pairs[upperMiddle]
+	destination[2] = pairs[upperMiddle+1]
+	destination[3] = pairs[upperLow]
+	destination[4] = pairs[upperLow+1]
+	destination[5] = e.encodeTable[lowerFirst]
+	destination[6] = pairs[lowerMiddle]
+	destination[7] = pairs[lowerMiddle+1]
+	destination[8] = pairs[lowerLow]
+	destination[9] = pairs[lowerLow+1]
+}
+
+// encodeChunk encodes a 32 bit value into the first 5 bytes of destination.
+// The last 4 characters are taken from the pair table, so only 2 divisions are needed.
+// They are replaced by multiplications with the reciprocal of the divisor.
+func (e *Encoding) encodeChunk(destination []byte, value uint32) {
+	_ = destination[encodedChunkSize-1] // Eliminate bounds checks below.
+
+	pairs := e.pairTable
+
+	high := divPair(value)
+	low := 2 * ((value - high*pairDivisor) & pairIndexMask)
+	first := divPair(high)
+	middle := 2 * ((high - first*pairDivisor) & pairIndexMask)
+
+	destination[0] = e.encodeTable[first]
+	destination[1] = pairs[middle]
+	destination[2] = pairs[middle+1]
+	destination[3] = pairs[low]
+	destination[4] = pairs[low+1]
+}
+
+// decodeChunkValue decodes exactly one chunk without any loops.
+// The position is the position of the chunk in the encoded string.
+func (e *Encoding) decodeChunkValue(chunk string, position uint) (uint32, error) {
+	_ = chunk[encodedChunkSize-1] // Eliminate bounds checks below.
+
+	d0 := e.decodeValue(chunk[0])
+	d1 := e.decodeValue(chunk[1])
+	d2 := e.decodeValue(chunk[2])
+	d3 := e.decodeValue(chunk[3])
+	d4 := e.decodeValue(chunk[4])
+
+	if (d0|d1|d2|d3|d4)&invalidMarker != 0 {
+		return 0, e.invalidByteInChunk(chunk, position)
+	}
+
+	value := (((uint64(d0)*codeSize+uint64(d1))*codeSize+uint64(d2))*codeSize+uint64(d3))*codeSize + uint64(d4)
+	if value > math.MaxUint32 {
+		return 0, newOverflowError(position)
+	}
+
+	return uint32(value), nil
+}
+
+// decodeValue returns the decoded value of a character, or ivEc, if the character is invalid.
+func (e *Encoding) decodeValue(b byte) byte {
+	return e.decodeTable[b]
+}
+
+// invalidByteInChunk returns the error for the first invalid character in a chunk.
+func (e *Encoding) invalidByteInChunk(chunk string, position uint) error {
+	for i := uint(0); i < encodedChunkSize; i++ {
+		if e.decodeValue(chunk[i]) == ivEc {
+			return newInvalidByteError(position+i, chunk[i])
+		}
+	}
+
+	return nil
+}
+
+// decodeToSlice decodes source, which is either a string or a byte slice, into a new byte slice.
+func decodeToSlice[T string | []byte](e *Encoding, source T) ([]byte, error) {
+	if e.transparent != nil && containsTransparent(e, source) {
+		// Small data is copied into a buffer on the stack.
+		var buffer [smallBufferSize]byte
+		result, err := decodeToSlice(e, withoutTransparent(e, buffer[:], source))
+		return result, remapTransparentError(e, err, source)
+	}
+
+	if e.wrap > 0 && containsLineBreak(source) {
+		// Small data is copied into a buffer on the stack.
+		var buffer [smallBufferSize]byte
+		result, err := decodeToSlice(e, withoutLineBreaks(buffer[:], source))
+		return result, remapWrappedError(err, source)
+	}
+
+	decodedLen, err := e.decodedLength(len(source))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, decodedLen)
+	decodedLen, err = decodeAll(e, result, source)
+	if err != nil {
+		return nil, err
+	}
+
+	return result[:decodedLen], nil
+}
+
+// decodeInto decodes source, which is either a string or a byte slice, into destination
+// and returns the number of bytes written.
+func decodeInto[T string | []byte](e *Encoding, destination []byte, source T) (int, error) {
+	if e.transparent != nil && containsTransparent(e, source) {
+		// Small data is copied into a buffer on the stack.
+		var buffer [smallBufferSize]byte
+		n, err := decodeInto(e, destination, withoutTransparent(e, buffer[:], source))
+		return n, remapTransparentError(e, err, source)
+	}
+
+	if e.wrap > 0 && containsLineBreak(source) {
+		// Small data is copied into a buffer on the stack.
+		var buffer [smallBufferSize]byte
+		n, err := decodeInto(e, destination, withoutLineBreaks(buffer[:], source))
+		return n, remapWrappedError(err, source)
+	}
+
+	decodedLen, err := e.decodedLength(len(source))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(destination) < decodedLen {
+		return 0, newShortBufferError()
+	}
+
+	return decodeAll(e, destination, source)
+}
+
+// decodeAll decodes source, which is either a string or a byte slice, into destination
+// and returns the number of bytes written.
+// The length of source must have been checked by decodedLength and destination must be large enough.
+func decodeAll[T string | []byte](e *Encoding, destination []byte, source T) (int, error) {
+	if e.padded {
+		return decodePadded(e, destination, source, 0)
+	}
+
+	err := decodeChunks(e, destination, source, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(source) - len(source)/encodedChunkSize, nil
+}
+
+// decodeChunks decodes source, which is either a string or a byte slice, into destination.
+// The length of source must be a multiple of 5 and destination must be large enough.
+// The position is the position of source in the encoded input and is used for error reporting.
+func decodeChunks[T string | []byte](e *Encoding, destination []byte, source T, position uint) error {
+	// Keys, UUIDs and hash values are decoded with unrolled code.
+	if decodeFixedSize(e, destination, source) {
+		return nil
+	}
+
+	// Decode as much as possible with the accelerated code path, if there is one.
+	// It stops in front of an invalid chunk, so the loop below reports the error.
+	if done := decodeBlocks(destination, source, e.encodeTable); done != 0 {
+		destination = destination[done/encodedChunkSize*byteChunkSize:]
+		source = source[done:]
+		position += uint(done)
+	}
+
+	// Decode 2 chunks per iteration as long as the data is valid.
+	if done := decodeDoubleChunks(e, destination, source); done != 0 {
+		destination = destination[done/encodedChunkSize*byteChunkSize:]
+		source = source[done:]
+		position += uint(done)
+	}
+
+	// Decode the last chunk and find the exact position of an error.
+	table := e.decodeTable
+	chunkCount := uint(len(source)) / encodedChunkSize
+	for chunkIndex := uint(0); chunkIndex < chunkCount; chunkIndex++ {
+		value := uint64(0)
+		for i := uint(0); i < encodedChunkSize; i++ {
+			charByte := source[i]
+			encodedValue := table[charByte]
+			if encodedValue == ivEc {
+				return newInvalidByteError(position+chunkIndex*encodedChunkSize+i, charByte)
+			}
+
+			value = value*codeSize + uint64(encodedValue)
+		}
+
+		// 5 characters can encode values up to 85^5-1, which is larger than the largest 32 bit value.
+		if value > math.MaxUint32 {
+			return newOverflowError(position + chunkIndex*encodedChunkSize)
+		}
+
+		binary.BigEndian.PutUint32(destination, uint32(value))
+
+		destination = destination[byteChunkSize:]
+		source = source[encodedChunkSize:]
+	}
+
+	return nil
+}
+
+// decodeDoubleChunks decodes source, which is either a string or a byte slice, into destination 2 chunks at a time.
+// It stops in front of the first pair of chunks that contains an invalid character or an overflow,
+// so that the caller can report the error.
+// It returns the number of characters that have been decoded.
+func decodeDoubleChunks[T string | []byte](e *Encoding, destination []byte, source T) int {
+	table := e.decodeTable
+	done := 0
+	for len(source)-done >= doubleEncodedChunkSize && len(destination) >= doubleChunkSize {
+		chunks := source[done : done+doubleEncodedChunkSize]
+
+		d0 := table[chunks[0]]
+		d1 := table[chunks[1]]
+		d2 := table[chunks[2]]
+		d3 := table[chunks[3]]
+		d4 := table[chunks[4]]
+		d5 := table[chunks[5]]
+		d6 := table[chunks[6]]
+		d7 := table[chunks[7]]
+		d8 := table[chunks[8]]
+		d9 := table[chunks[9]]
+
+		if (d0|d1|d2|d3|d4|d5|d6|d7|d8|d9)&invalidMarker != 0 {
+			break
+		}
+
+		upper := (((uint64(d0)*codeSize+uint64(d1))*codeSize+uint64(d2))*codeSize+uint64(d3))*codeSize + uint64(d4)
+		lower := (((uint64(d5)*codeSize+uint64(d6))*codeSize+uint64(d7))*codeSize+uint64(d8))*codeSize + uint64(d9)
+		if (upper | lower) > math.MaxUint32 {
+			break
+		}
+
+		// Both chunks are written with one store.
+		binary.BigEndian.PutUint64(destination, upper<<32|lower)
+
+		destination = destination[doubleChunkSize:]
+		done += doubleEncodedChunkSize
+	}
+
+	return done
+}
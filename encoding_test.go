@@ -0,0 +1,285 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"errors"
+	"github.com/xformerfhs/z85"
+	"io"
+	"strings"
+	"testing"
+)
+
+// ******** Private constants ********
+
+// reversedAlphabet is the Z85 alphabet in reversed order.
+const reversedAlphabet = `#$%@}{][)(><&?*/!^=+:-.ZYXWVUTSRQPONMLKJIHGFEDCBAzyxwvutsrqponmlkjihgfedcba9876543210`
+
+// ******** Test functions ********
+
+// TestStdEncodingEncode tests the encoding methods of StdEncoding.
+func TestStdEncodingEncode(t *testing.T) {
+	encoded, err := z85.StdEncoding.EncodeToString(clearTheOne)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if encoded != encodedTheOne {
+		t.Fatalf(`Encoding did not result in '%s', but '%s'`, encodedTheOne, encoded)
+	}
+
+	buffer := make([]byte, z85.StdEncoding.EncodedLen(len(clearTheOne)))
+	var n int
+	n, err = z85.StdEncoding.Encode(buffer, clearTheOne)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if string(buffer[:n]) != encodedTheOne {
+		t.Fatalf(`Encoding did not result in '%s', but '%s'`, encodedTheOne, buffer[:n])
+	}
+
+	_, err = z85.StdEncoding.Encode(buffer[:1], clearTheOne)
+	if !errors.Is(err, io.ErrShortBuffer) {
+		t.Fatalf(`Wrong error when encoding into a short buffer: '%v'`, err)
+	}
+
+	var appended []byte
+	appended, err = z85.StdEncoding.AppendEncode([]byte(`>`), clearTheOne)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if string(appended) != `>`+encodedTheOne {
+		t.Fatalf(`Appending did not result in '>%s', but '%s'`, encodedTheOne, appended)
+	}
+}
+
+// TestStdEncodingDecode tests the decoding methods of StdEncoding.
+func TestStdEncodingDecode(t *testing.T) {
+	decoded, err := z85.StdEncoding.DecodeString(encodedTheOne)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, clearTheOne) {
+		t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, decoded)
+	}
+
+	var decodedLen int
+	decodedLen, err = z85.StdEncoding.DecodedLen(len(encodedTheOne))
+	if err != nil {
+		t.Fatalf(`DecodedLen failed: %v`, err)
+	}
+
+	buffer := make([]byte, decodedLen)
+	var n int
+	n, err = z85.StdEncoding.Decode(buffer, []byte(encodedTheOne))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(buffer[:n], clearTheOne) {
+		t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, buffer[:n])
+	}
+
+	var appended []byte
+	appended, err = z85.StdEncoding.AppendDecode([]byte{0xff}, encodedTheOne)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(appended, append([]byte{0xff}, clearTheOne...)) {
+		t.Fatalf(`Appending did not result in expected bytes, but '% 02x'`, appended)
+	}
+
+	_, err = z85.StdEncoding.DecodeString(`123~5`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Wrong error when decoding invalid character: '%v'`, err)
+	}
+}
+
+// TestNewEncodingStd tests if an encoding with the Z85 alphabet behaves exactly like StdEncoding.
+func TestNewEncodingStd(t *testing.T) {
+	encoding, err := z85.NewEncoding(z85.StdEncoding.Alphabet())
+	if err != nil {
+		t.Fatalf(`Creating encoding failed: %v`, err)
+	}
+
+	// Check all characters, including invalid ones.
+	for c := 0; c < 256; c++ {
+		source := strings.Repeat(string([]byte{byte(c)}), 5)
+		expected, expectedErr := z85.StdEncoding.DecodeString(source)
+		decoded, decodedErr := encoding.DecodeString(source)
+		if (expectedErr == nil) != (decodedErr == nil) || !bytes.Equal(expected, decoded) {
+			t.Fatalf(`Decoding of character %q differs`, c)
+		}
+	}
+}
+
+// TestNewEncodingCustom tests if an encoding with a custom alphabet round-trips.
+func TestNewEncodingCustom(t *testing.T) {
+	encoding, err := z85.NewEncoding(reversedAlphabet)
+	if err != nil {
+		t.Fatalf(`Creating encoding failed: %v`, err)
+	}
+
+	encoded, _ := encoding.EncodeToString([]byte{0, 0, 0, 0})
+	if encoded != `#####` {
+		t.Fatalf(`Encoding of zero is not '#####', but '%s'`, encoded)
+	}
+
+	buffer := make([]byte, 64)
+	_, _ = crand.Read(buffer)
+	encoded, _ = encoding.EncodeToString(buffer)
+
+	var decoded []byte
+	decoded, err = encoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, buffer) {
+		t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, decoded)
+	}
+}
+
+// TestNewEncodingInvalid tests if invalid alphabets are rejected.
+func TestNewEncodingInvalid(t *testing.T) {
+	for _, alphabet := range []string{
+		reversedAlphabet[1:],
+		` ` + reversedAlphabet[1:],
+		"\x80" + reversedAlphabet[1:],
+		`$` + reversedAlphabet[1:],
+	} {
+		_, err := z85.NewEncoding(alphabet)
+		if !z85.IsErrInvalidAlphabet(err) {
+			t.Fatalf(`Wrong error for alphabet '%s': '%v'`, alphabet, err)
+		}
+	}
+}
+
+// TestDecodeAllByteValues tests if every byte value is either decoded or reported as an invalid character.
+func TestDecodeAllByteValues(t *testing.T) {
+	customEncoding, _ := z85.NewEncoding(reversedAlphabet)
+	for _, test := range []struct {
+		encoding *z85.Encoding
+		alphabet string
+	}{
+		{z85.StdEncoding, z85.Alphabet},
+		{customEncoding, reversedAlphabet},
+	} {
+		for value := 0; value < 256; value++ {
+			b := byte(value)
+			source := strings.Repeat(test.alphabet[:1], 4) + string([]byte{b})
+
+			decoded, err := test.encoding.DecodeString(source)
+
+			index := strings.IndexByte(test.alphabet, b)
+			if index >= 0 {
+				if err != nil {
+					t.Fatalf(`Decoding of valid character %q failed: %v`, b, err)
+				}
+
+				if decoded[3] != byte(index) {
+					t.Fatalf(`Character %q was decoded as %d instead of %d`, b, decoded[3], index)
+				}
+
+				continue
+			}
+
+			var codecErr *z85.CodecError
+			if !errors.As(err, &codecErr) {
+				t.Fatalf(`Invalid character %q did not result in a CodecError: %v`, b, err)
+			}
+
+			if codecErr.EncodedOffset != 4 || codecErr.Byte != b {
+				t.Fatalf(`Invalid character %q was reported at offset %d with byte %q`, b, codecErr.EncodedOffset, codecErr.Byte)
+			}
+		}
+	}
+}
+
+// TestEncodeOddChunkCounts tests if data with an odd and an even number of chunks is encoded correctly.
+func TestEncodeOddChunkCounts(t *testing.T) {
+	customEncoding, _ := z85.NewEncoding(reversedAlphabet)
+	data := make([]byte, maxSliceSize)
+	_, _ = crand.Read(data)
+
+	for length := 0; length <= maxSliceSize; length += 4 {
+		source := data[:length]
+
+		encoded, _ := z85.StdEncoding.EncodeToString(source)
+		if expected := referenceEncode(z85.Alphabet, source); encoded != expected {
+			t.Fatalf(`Encoding of %d bytes resulted in '%s' instead of '%s'`, length, encoded, expected)
+		}
+
+		encoded, _ = customEncoding.EncodeToString(source)
+		if expected := referenceEncode(reversedAlphabet, source); encoded != expected {
+			t.Fatalf(`Custom encoding of %d bytes resulted in '%s' instead of '%s'`, length, encoded, expected)
+		}
+	}
+}
+
+// TestDecodeErrorPositions tests if errors are reported at the right position in data of any number of chunks.
+func TestDecodeErrorPositions(t *testing.T) {
+	for chunkCount := 1; chunkCount <= 13; chunkCount++ {
+		valid := strings.Repeat(`0`, chunkCount*5)
+
+		for position := 0; position < len(valid); position++ {
+			invalid := valid[:position] + `~` + valid[position+1:]
+
+			_, err := z85.Decode(invalid)
+
+			var codecErr *z85.CodecError
+			if !errors.As(err, &codecErr) {
+				t.Fatalf(`Invalid character at position %d of %d chunks did not result in a CodecError: %v`, position, chunkCount, err)
+			}
+
+			checkCodecError(t, codecErr, z85.KindInvalidByte, int64(position/5*4), int64(position), '~')
+		}
+
+		for chunk := 0; chunk < chunkCount; chunk++ {
+			overflow := valid[:chunk*5] + `#####` + valid[(chunk+1)*5:]
+
+			_, err := z85.Decode(overflow)
+
+			var codecErr *z85.CodecError
+			if !errors.As(err, &codecErr) || codecErr.Kind != z85.KindOverflow {
+				t.Fatalf(`Overflow in chunk %d of %d was not detected: %v`, chunk, chunkCount, err)
+			}
+
+			if codecErr.EncodedOffset != int64(chunk*5) {
+				t.Fatalf(`Overflow in chunk %d of %d was reported at offset %d`, chunk, chunkCount, codecErr.EncodedOffset)
+			}
+		}
+	}
+}
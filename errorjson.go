@@ -0,0 +1,134 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ******** Private constants ********
+
+// unknownCodeMessage contains the format for the error message of an unknown error code.
+const unknownCodeMessage = `unknown error code %q`
+
+// ******** Private variables ********
+
+// kindCodes contains the codes of the error kinds in the JSON representation.
+// The codes must never be changed, as they are part of the serialization format.
+var kindCodes = map[ErrorKind]string{
+	KindInvalidLength:           `invalid_length`,
+	KindUnexpectedLength:        `unexpected_length`,
+	KindInvalidByte:             `invalid_byte`,
+	KindInvalidStride:           `invalid_stride`,
+	KindControlCharacter:        `control_character`,
+	KindShortBuffer:             `short_buffer`,
+	KindInputTooLarge:           `input_too_large`,
+	KindInvalidAlphabet:         `invalid_alphabet`,
+	KindInvalidFrame:            `invalid_frame`,
+	KindAccelerationUnavailable: `acceleration_unavailable`,
+	KindChecksumMismatch:        `checksum_mismatch`,
+	KindOverflow:                `overflow`,
+	KindLineTooLong:             `line_too_long`,
+	KindMixedVariants:           `mixed_variants`,
+}
+
+// ******** Private types ********
+
+// codecErrorJSON is the JSON representation of a CodecError.
+type codecErrorJSON struct {
+	Code          string `json:"code"`
+	RawOffset     int64  `json:"rawOffset"`
+	EncodedOffset int64  `json:"encodedOffset"`
+	Byte          byte   `json:"byte"`
+	Message       string `json:"message"`
+}
+
+// ******** Public functions ********
+
+// MarshalJSON returns the JSON representation of the error.
+// It is an object with the fields "code", "rawOffset", "encodedOffset", "byte" and "message".
+// The code identifies the kind of the error and is stable across versions.
+func (e *CodecError) MarshalJSON() ([]byte, error) {
+	code, found := kindCodes[e.Kind]
+	if !found {
+		return nil, fmt.Errorf(unknownCodeMessage, e.Kind.String())
+	}
+
+	message := ``
+	if e.Err != nil {
+		message = e.Err.Error()
+	}
+
+	return json.Marshal(codecErrorJSON{
+		Code:          code,
+		RawOffset:     e.RawOffset,
+		EncodedOffset: e.EncodedOffset,
+		Byte:          e.Byte,
+		Message:       message,
+	})
+}
+
+// UnmarshalJSON sets the error from its JSON representation.
+// The wrapped cause only contains the message, so the IsErr functions do not recognize it.
+// The kind has to be checked instead.
+func (e *CodecError) UnmarshalJSON(data []byte) error {
+	var representation codecErrorJSON
+	err := json.Unmarshal(data, &representation)
+	if err != nil {
+		return err
+	}
+
+	kind, found := kindOfCode(representation.Code)
+	if !found {
+		return fmt.Errorf(unknownCodeMessage, representation.Code)
+	}
+
+	e.Kind = kind
+	e.RawOffset = representation.RawOffset
+	e.EncodedOffset = representation.EncodedOffset
+	e.Byte = representation.Byte
+	e.Err = errors.New(representation.Message)
+
+	return nil
+}
+
+// ******** Private functions ********
+
+// kindOfCode returns the error kind that has the supplied code.
+func kindOfCode(code string) (ErrorKind, bool) {
+	for kind, kindCode := range kindCodes {
+		if kindCode == code {
+			return kind, true
+		}
+	}
+
+	return 0, false
+}
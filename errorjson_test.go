@@ -0,0 +1,115 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestCodecErrorMarshalJSON tests the JSON representation of a CodecError.
+func TestCodecErrorMarshalJSON(t *testing.T) {
+	_, err := z85.Decode(`123455432112,45`)
+
+	data, err := json.Marshal(err)
+	if err != nil {
+		t.Fatalf(`Marshalling failed: %v`, err)
+	}
+
+	expected := `{"code":"invalid_byte","rawOffset":8,"encodedOffset":12,"byte":44,"message":"invalid byte at position 12: ','"}`
+	if string(data) != expected {
+		t.Fatalf(`JSON is '%s', but should be '%s'`, data, expected)
+	}
+}
+
+// TestCodecErrorJSONRoundTrip tests if a CodecError survives a JSON round trip.
+func TestCodecErrorJSONRoundTrip(t *testing.T) {
+	_, err := z85.Decode(`HelloWorld%nSc1`)
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	data, err := json.Marshal(codecErr)
+	if err != nil {
+		t.Fatalf(`Marshalling failed: %v`, err)
+	}
+
+	var unmarshalled z85.CodecError
+	err = json.Unmarshal(data, &unmarshalled)
+	if err != nil {
+		t.Fatalf(`Unmarshalling failed: %v`, err)
+	}
+
+	checkCodecError(t, &unmarshalled, z85.KindOverflow, 8, 10, 0)
+
+	if unmarshalled.Error() != codecErr.Error() {
+		t.Fatalf(`Message is '%s', but should be '%s'`, unmarshalled.Error(), codecErr.Error())
+	}
+}
+
+// TestCodecErrorJSONAllKinds tests if all error kinds have a code.
+func TestCodecErrorJSONAllKinds(t *testing.T) {
+	for kind := z85.KindInvalidLength; kind <= z85.KindMixedVariants; kind++ {
+		data, err := json.Marshal(&z85.CodecError{Kind: kind, Err: errors.New(`test`)})
+		if err != nil {
+			t.Fatalf(`Marshalling of kind '%s' failed: %v`, kind, err)
+		}
+
+		var unmarshalled z85.CodecError
+		err = json.Unmarshal(data, &unmarshalled)
+		if err != nil {
+			t.Fatalf(`Unmarshalling of kind '%s' failed: %v`, kind, err)
+		}
+
+		if unmarshalled.Kind != kind {
+			t.Fatalf(`Kind '%s' was unmarshalled as '%s'`, kind, unmarshalled.Kind)
+		}
+	}
+}
+
+// TestCodecErrorJSONUnknownCode tests if an unknown code is rejected.
+func TestCodecErrorJSONUnknownCode(t *testing.T) {
+	var unmarshalled z85.CodecError
+	err := json.Unmarshal([]byte(`{"code":"no_such_code"}`), &unmarshalled)
+	if err == nil {
+		t.Fatal(`Unknown code was accepted`)
+	}
+
+	_, err = json.Marshal(&z85.CodecError{Kind: 0})
+	if err == nil {
+		t.Fatal(`Unknown kind was marshalled`)
+	}
+}
@@ -20,10 +20,22 @@
 //
 // Author: Frank Schwab
 //
-// Version: 1.0.0
+// Version: 1.11.1
 //
 // Change history:
 //    2025-02-15: V1.0.0: Created.
+//    2026-10-17: V1.1.0: Add ErrUnexpectedLength.
+//    2026-10-17: V1.2.0: Add CodecError.
+//    2026-10-17: V1.3.0: Add ErrInvalidStride.
+//    2026-10-17: V1.4.0: Add ErrControlCharacter.
+//    2026-10-17: V1.5.0: Add KindShortBuffer.
+//    2026-10-17: V1.6.0: Add ErrInputTooLarge.
+//    2026-10-17: V1.7.0: Add ErrInvalidAlphabet.
+//    2026-10-17: V1.8.0: Add ErrInvalidFrame.
+//    2026-10-17: V1.9.0: Add ErrAccelerationUnavailable.
+//    2026-10-17: V1.10.0: Add ErrChecksumMismatch.
+//    2026-10-17: V1.11.0: Add ErrOverflow.
+//    2026-10-17: V1.11.1: ErrControlCharacter is also an ErrInvalidByte.
 //
 
 package z85
@@ -31,19 +43,147 @@ package z85
 import (
 	"errors"
 	"fmt"
+	"io"
 )
 
 // ******** Private constants ********
 
+// asciiDel is the ASCII DEL control character.
+const asciiDel = 0x7f
+
 // invalidLengthMessage contains the format for the error message when the input
 // has a length that is not valid for the operation.
 const invalidLengthMessage = `input length is not a multiple of %d`
 
+// unexpectedLengthMessage contains the format for the error message when the input
+// does not have the exact length required by the operation.
+const unexpectedLengthMessage = `input length is not %d`
+
+// invalidStrideMessage contains the format for the error message when a column does not match its stride.
+const invalidStrideMessage = `column does not match stride %d`
+
 // invalidByteMessage contains the format for the error message of an invalid byte.
 const invalidByteMessage = `invalid byte at position %d: %q`
 
+// inputTooLargeMessage contains the format for the error message of an input that is too large.
+const inputTooLargeMessage = `input length exceeds maximum of %d`
+
+// invalidAlphabetMessage contains the format for the error message of an invalid alphabet.
+const invalidAlphabetMessage = `invalid alphabet: %s`
+
+// invalidFrameMessage contains the format for the error message of a frame whose length does not match its data.
+const invalidFrameMessage = `frame length %d does not match the length of the data`
+
+// accelerationUnavailableMessage contains the format for the error message when no acceleration is available.
+const accelerationUnavailableMessage = `acceleration is not available, implementation is %s`
+
+// checksumMismatchMessage contains the format for the error message of a checksum that does not match the data.
+const checksumMismatchMessage = `checksum %08x does not match the data`
+
+// overflowMessage contains the format for the error message of a chunk whose value exceeds 32 bits.
+const overflowMessage = `chunk at position %d exceeds 32 bits`
+
+// lineTooLongMessage contains the format for the error message of a line that exceeds the maximum line length.
+const lineTooLongMessage = `line is longer than %d characters`
+
+// mixedVariantsMessage contains the format for the error message of a block in another format than the first one.
+const mixedVariantsMessage = `block has the format %s, which differs from the format of the first block`
+
+// controlCharacterMessage contains the format for the error message of a control character.
+const controlCharacterMessage = `control character at position %d: %q`
+
 // ******** Public types and functions ********
 
+// ErrorKind is the kind of error that is reported by a CodecError.
+type ErrorKind byte
+
+// These are the possible error kinds.
+const (
+	// KindInvalidLength is the kind of ErrInvalidLength.
+	KindInvalidLength ErrorKind = iota + 1
+	// KindUnexpectedLength is the kind of ErrUnexpectedLength.
+	KindUnexpectedLength
+	// KindInvalidByte is the kind of ErrInvalidByte.
+	KindInvalidByte
+	// KindInvalidStride is the kind of ErrInvalidStride.
+	KindInvalidStride
+	// KindControlCharacter is the kind of ErrControlCharacter.
+	KindControlCharacter
+	// KindShortBuffer is the kind of io.ErrShortBuffer.
+	KindShortBuffer
+	// KindInputTooLarge is the kind of ErrInputTooLarge.
+	KindInputTooLarge
+	// KindInvalidAlphabet is the kind of ErrInvalidAlphabet.
+	KindInvalidAlphabet
+	// KindInvalidFrame is the kind of ErrInvalidFrame.
+	KindInvalidFrame
+	// KindAccelerationUnavailable is the kind of ErrAccelerationUnavailable.
+	KindAccelerationUnavailable
+	// KindChecksumMismatch is the kind of ErrChecksumMismatch.
+	KindChecksumMismatch
+	// KindOverflow is the kind of ErrOverflow.
+	KindOverflow
+	// KindLineTooLong is the kind of ErrLineTooLong.
+	KindLineTooLong
+	// KindMixedVariants is the kind of ErrMixedVariants.
+	KindMixedVariants
+)
+
+// kindNames contains the names of the error kinds.
+var kindNames = map[ErrorKind]string{
+	KindInvalidLength:           `invalid length`,
+	KindUnexpectedLength:        `unexpected length`,
+	KindInvalidByte:             `invalid byte`,
+	KindInvalidStride:           `invalid stride`,
+	KindControlCharacter:        `control character`,
+	KindShortBuffer:             `short buffer`,
+	KindInputTooLarge:           `input too large`,
+	KindInvalidAlphabet:         `invalid alphabet`,
+	KindInvalidFrame:            `invalid frame`,
+	KindAccelerationUnavailable: `acceleration unavailable`,
+	KindChecksumMismatch:        `checksum mismatch`,
+	KindOverflow:                `overflow`,
+	KindLineTooLong:             `line too long`,
+	KindMixedVariants:           `mixed variants`,
+}
+
+// String returns the name of the error kind.
+func (k ErrorKind) String() string {
+	name, found := kindNames[k]
+	if !found {
+		return fmt.Sprintf(`unknown kind %d`, byte(k))
+	}
+
+	return name
+}
+
+// CodecError is the error that is returned by all encoding and decoding functions.
+// It wraps the specific error that describes the cause.
+type CodecError struct {
+	// Kind is the kind of the error.
+	Kind ErrorKind
+	// RawOffset is the offset in the raw data where the error occurred.
+	// For encoded data this is the start of the raw chunk that corresponds to the erroneous encoded chunk.
+	RawOffset int64
+	// EncodedOffset is the offset in the encoded data where the error occurred.
+	// For raw data this is the start of the encoded chunk that corresponds to the erroneous raw chunk.
+	EncodedOffset int64
+	// Byte is the offending byte, if Kind is KindInvalidByte.
+	Byte byte
+	// Err is the wrapped cause.
+	Err error
+}
+
+// Error returns the error message of the wrapped cause.
+func (e *CodecError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the wrapped cause.
+func (e *CodecError) Unwrap() error {
+	return e.Err
+}
+
 // ErrInvalidLength is returned when the input has a length that is not valid for the operation.
 type ErrInvalidLength byte
 
@@ -58,6 +198,124 @@ func IsErrInvalidLength(err error) bool {
 	return errors.As(err, &expectedErr)
 }
 
+// ErrUnexpectedLength is returned when the input does not have the exact length required by the operation.
+type ErrUnexpectedLength uint
+
+// Error returns the error message for an unexpected length error.
+func (e ErrUnexpectedLength) Error() string {
+	return fmt.Sprintf(unexpectedLengthMessage, e)
+}
+
+// IsErrUnexpectedLength reports whether the supplied error is the ErrUnexpectedLength error.
+func IsErrUnexpectedLength(err error) bool {
+	var expectedErr ErrUnexpectedLength
+	return errors.As(err, &expectedErr)
+}
+
+// ErrInvalidStride is returned when a column does not match its stride.
+type ErrInvalidStride int
+
+// Error returns the error message for an invalid stride error.
+func (e ErrInvalidStride) Error() string {
+	return fmt.Sprintf(invalidStrideMessage, e)
+}
+
+// IsErrInvalidStride reports whether the supplied error is the ErrInvalidStride error.
+func IsErrInvalidStride(err error) bool {
+	var expectedErr ErrInvalidStride
+	return errors.As(err, &expectedErr)
+}
+
+// ErrInputTooLarge is returned when the length of the result of an operation would overflow an int.
+type ErrInputTooLarge int
+
+// Error returns the error message for an input too large error.
+func (e ErrInputTooLarge) Error() string {
+	return fmt.Sprintf(inputTooLargeMessage, e)
+}
+
+// IsErrInputTooLarge reports whether the supplied error is the ErrInputTooLarge error.
+func IsErrInputTooLarge(err error) bool {
+	var expectedErr ErrInputTooLarge
+	return errors.As(err, &expectedErr)
+}
+
+// ErrInvalidAlphabet is returned when an alphabet for a new encoding is not valid.
+// Its value is the reason why the alphabet is not valid.
+type ErrInvalidAlphabet string
+
+// Error returns the error message for an invalid alphabet error.
+func (e ErrInvalidAlphabet) Error() string {
+	return fmt.Sprintf(invalidAlphabetMessage, string(e))
+}
+
+// IsErrInvalidAlphabet reports whether the supplied error is the ErrInvalidAlphabet error.
+func IsErrInvalidAlphabet(err error) bool {
+	var expectedErr ErrInvalidAlphabet
+	return errors.As(err, &expectedErr)
+}
+
+// ErrInvalidFrame is returned when the length in the header of a frame does not match the length of its data.
+// Its value is the length in the header.
+type ErrInvalidFrame uint32
+
+// Error returns the error message for an invalid frame error.
+func (e ErrInvalidFrame) Error() string {
+	return fmt.Sprintf(invalidFrameMessage, e)
+}
+
+// IsErrInvalidFrame reports whether the supplied error is the ErrInvalidFrame error.
+func IsErrInvalidFrame(err error) bool {
+	var expectedErr ErrInvalidFrame
+	return errors.As(err, &expectedErr)
+}
+
+// ErrAccelerationUnavailable is returned when an accelerated implementation is required, but not available.
+// Its value is the name of the implementation that is used instead.
+type ErrAccelerationUnavailable string
+
+// Error returns the error message for an acceleration unavailable error.
+func (e ErrAccelerationUnavailable) Error() string {
+	return fmt.Sprintf(accelerationUnavailableMessage, string(e))
+}
+
+// IsErrAccelerationUnavailable reports whether the supplied error is the ErrAccelerationUnavailable error.
+func IsErrAccelerationUnavailable(err error) bool {
+	var expectedErr ErrAccelerationUnavailable
+	return errors.As(err, &expectedErr)
+}
+
+// ErrChecksumMismatch is returned when the checksum of checked encoded data does not match the data.
+// Its value is the checksum in the encoded data.
+type ErrChecksumMismatch uint32
+
+// Error returns the error message for a checksum mismatch error.
+func (e ErrChecksumMismatch) Error() string {
+	return fmt.Sprintf(checksumMismatchMessage, uint32(e))
+}
+
+// IsErrChecksumMismatch reports whether the supplied error is the ErrChecksumMismatch error.
+func IsErrChecksumMismatch(err error) bool {
+	var expectedErr ErrChecksumMismatch
+	return errors.As(err, &expectedErr)
+}
+
+// ErrOverflow is returned when an encoded chunk has a value that does not fit into 32 bits.
+// Such a chunk can never be the result of an encoding.
+// Its value is the position of the chunk in the encoded string.
+type ErrOverflow uint
+
+// Error returns the error message for an overflow error.
+func (e ErrOverflow) Error() string {
+	return fmt.Sprintf(overflowMessage, uint(e))
+}
+
+// IsErrOverflow reports whether the supplied error is the ErrOverflow error.
+func IsErrOverflow(err error) bool {
+	var expectedErr ErrOverflow
+	return errors.As(err, &expectedErr)
+}
+
 // ErrInvalidByte is returned when there is an invalid byte in the encoded string.
 type ErrInvalidByte struct {
 	position uint
@@ -74,3 +332,208 @@ func IsErrInvalidByte(err error) bool {
 	var errInvalidByte *ErrInvalidByte
 	return errors.As(err, &errInvalidByte)
 }
+
+// ErrControlCharacter is returned when there is a control character in the encoded string.
+// Control characters are often the result of line breaks or other formatting.
+// A control character is also an invalid byte, so IsErrInvalidByte reports true for this error, too.
+type ErrControlCharacter struct {
+	position uint
+	value    byte
+}
+
+// Error returns the error message for a control character error.
+func (e *ErrControlCharacter) Error() string {
+	return fmt.Sprintf(controlCharacterMessage, e.position, e.value)
+}
+
+// Unwrap returns the ErrInvalidByte error of the control character.
+func (e *ErrControlCharacter) Unwrap() error {
+	return &ErrInvalidByte{position: e.position, value: e.value}
+}
+
+// IsErrControlCharacter reports whether the supplied error is the ErrControlCharacter error.
+func IsErrControlCharacter(err error) bool {
+	var errControlCharacter *ErrControlCharacter
+	return errors.As(err, &errControlCharacter)
+}
+
+// ******** Private functions ********
+
+// ErrLineTooLong is returned by a stream decoder when a line is longer than the maximum line length.
+// Its value is the maximum line length.
+type ErrLineTooLong int
+
+// Error returns the error message for a line too long error.
+func (e ErrLineTooLong) Error() string {
+	return fmt.Sprintf(lineTooLongMessage, int(e))
+}
+
+// IsErrLineTooLong reports whether the supplied error is the ErrLineTooLong error.
+func IsErrLineTooLong(err error) bool {
+	var expectedErr ErrLineTooLong
+	return errors.As(err, &expectedErr)
+}
+
+// ErrMixedVariants is returned by a strict AutoDecoder when a block has another format than the first block.
+// Its value is the name of the format of the block.
+type ErrMixedVariants string
+
+// Error returns the error message for a mixed variants error.
+func (e ErrMixedVariants) Error() string {
+	return fmt.Sprintf(mixedVariantsMessage, string(e))
+}
+
+// IsErrMixedVariants reports whether the supplied error is the ErrMixedVariants error.
+func IsErrMixedVariants(err error) bool {
+	var expectedErr ErrMixedVariants
+	return errors.As(err, &expectedErr)
+}
+
+// newInvalidLengthError creates the error for an input that has an invalid length.
+// The offset is the start of the incomplete chunk in the input.
+func newInvalidLengthError(chunkSize byte, offset uint) error {
+	rawOffset, encodedOffset := chunkOffsets(chunkSize, offset)
+	return &CodecError{
+		Kind:          KindInvalidLength,
+		RawOffset:     rawOffset,
+		EncodedOffset: encodedOffset,
+		Err:           ErrInvalidLength(chunkSize),
+	}
+}
+
+// newUnexpectedLengthError creates the error for an encoded input that does not have the expected length.
+func newUnexpectedLengthError(expected uint, actual uint) error {
+	offset := min(actual, expected)
+	return &CodecError{
+		Kind:          KindUnexpectedLength,
+		RawOffset:     int64(offset/encodedChunkSize) * byteChunkSize,
+		EncodedOffset: int64(offset),
+		Err:           ErrUnexpectedLength(expected),
+	}
+}
+
+// newInvalidStrideError creates the error for a column that does not match its stride.
+// The offsets are the offsets of the first value that does not match.
+func newInvalidStrideError(stride int, rawOffset int, encodedOffset int) error {
+	return &CodecError{
+		Kind:          KindInvalidStride,
+		RawOffset:     int64(rawOffset),
+		EncodedOffset: int64(encodedOffset),
+		Err:           ErrInvalidStride(stride),
+	}
+}
+
+// newShortBufferError creates the error for a destination buffer that is too small.
+func newShortBufferError() error {
+	return &CodecError{
+		Kind: KindShortBuffer,
+		Err:  io.ErrShortBuffer,
+	}
+}
+
+// newInputTooLargeError creates the error for an input that is longer than maxLen.
+func newInputTooLargeError(maxLen int) error {
+	return &CodecError{
+		Kind:          KindInputTooLarge,
+		RawOffset:     int64(maxLen),
+		EncodedOffset: int64(maxLen/byteChunkSize) * encodedChunkSize,
+		Err:           ErrInputTooLarge(maxLen),
+	}
+}
+
+// newInvalidAlphabetError creates the error for an invalid alphabet.
+func newInvalidAlphabetError(reason string) error {
+	return &CodecError{
+		Kind: KindInvalidAlphabet,
+		Err:  ErrInvalidAlphabet(reason),
+	}
+}
+
+// newInvalidFrameError creates the error for a frame whose header length does not match its data.
+func newInvalidFrameError(length uint32) error {
+	return &CodecError{
+		Kind: KindInvalidFrame,
+		Err:  ErrInvalidFrame(length),
+	}
+}
+
+// newAccelerationUnavailableError creates the error for a missing acceleration.
+func newAccelerationUnavailableError(implementation string) error {
+	return &CodecError{
+		Kind: KindAccelerationUnavailable,
+		Err:  ErrAccelerationUnavailable(implementation),
+	}
+}
+
+// newChecksumMismatchError creates the error for a checksum that does not match the data of length dataLen.
+func newChecksumMismatchError(checksum uint32, dataLen int) error {
+	return &CodecError{
+		Kind:          KindChecksumMismatch,
+		RawOffset:     int64(dataLen),
+		EncodedOffset: int64(dataLen/byteChunkSize) * encodedChunkSize,
+		Err:           ErrChecksumMismatch(checksum),
+	}
+}
+
+// newOverflowError creates the error for an overflowing chunk at a position in the encoded input.
+func newOverflowError(position uint) error {
+	return &CodecError{
+		Kind:          KindOverflow,
+		RawOffset:     int64(position/encodedChunkSize) * byteChunkSize,
+		EncodedOffset: int64(position),
+		Err:           ErrOverflow(position),
+	}
+}
+
+// newLineTooLongError creates the error for a line that is longer than maxLen
+// with its first character beyond the limit at a position in the encoded input.
+func newLineTooLongError(maxLen int, position uint) error {
+	return &CodecError{
+		Kind:          KindLineTooLong,
+		RawOffset:     int64(position/encodedChunkSize) * byteChunkSize,
+		EncodedOffset: int64(position),
+		Err:           ErrLineTooLong(maxLen),
+	}
+}
+
+// newMixedVariantsError creates the error for a block in the format variant that starts at the offsets of the stream.
+func newMixedVariantsError(variant string, rawOffset int64, encodedOffset int64) error {
+	return &CodecError{
+		Kind:          KindMixedVariants,
+		RawOffset:     rawOffset,
+		EncodedOffset: encodedOffset,
+		Err:           ErrMixedVariants(variant),
+	}
+}
+
+// newInvalidByteError creates the error for an invalid byte at a position in the encoded input.
+// Control characters get a dedicated error.
+func newInvalidByteError(position uint, value byte) error {
+	if value < ' ' || value == asciiDel {
+		return &CodecError{
+			Kind:          KindControlCharacter,
+			RawOffset:     int64(position/encodedChunkSize) * byteChunkSize,
+			EncodedOffset: int64(position),
+			Byte:          value,
+			Err:           &ErrControlCharacter{position: position, value: value},
+		}
+	}
+
+	return &CodecError{
+		Kind:          KindInvalidByte,
+		RawOffset:     int64(position/encodedChunkSize) * byteChunkSize,
+		EncodedOffset: int64(position),
+		Byte:          value,
+		Err:           &ErrInvalidByte{position: position, value: value},
+	}
+}
+
+// chunkOffsets converts an offset at a chunk boundary into the raw and the encoded offset.
+// The chunk size specifies whether the offset is a raw or an encoded offset.
+func chunkOffsets(chunkSize byte, offset uint) (int64, int64) {
+	if chunkSize == byteChunkSize {
+		return int64(offset), int64(offset>>byteChunkShift) * encodedChunkSize
+	}
+
+	return int64(offset/encodedChunkSize) * byteChunkSize, int64(offset)
+}
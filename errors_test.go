@@ -0,0 +1,197 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"errors"
+	"github.com/xformerfhs/z85"
+	"io"
+	"strings"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestCodecErrorInvalidByte tests the fields of a CodecError for an invalid byte.
+func TestCodecErrorInvalidByte(t *testing.T) {
+	_, err := z85.Decode(`123455432112,45`)
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindInvalidByte, 8, 12, ',')
+
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`CodecError does not wrap ErrInvalidByte: '%v'`, err)
+	}
+}
+
+// TestCodecErrorControlCharacter tests if a control character is reported as a control character and an invalid byte.
+func TestCodecErrorControlCharacter(t *testing.T) {
+	_, err := z85.Decode("1234554321\x002345")
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindControlCharacter, 8, 10, 0)
+
+	if !z85.IsErrControlCharacter(err) || !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`CodecError does not wrap ErrControlCharacter and ErrInvalidByte: '%v'`, err)
+	}
+}
+
+// TestCodecErrorEncodeInvalidLength tests the fields of a CodecError for an invalid encode length.
+func TestCodecErrorEncodeInvalidLength(t *testing.T) {
+	_, err := z85.Encode(make([]byte, 10))
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindInvalidLength, 8, 10, 0)
+
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`CodecError does not wrap ErrInvalidLength: '%v'`, err)
+	}
+}
+
+// TestCodecErrorDecodeInvalidLength tests the fields of a CodecError for an invalid decode length.
+func TestCodecErrorDecodeInvalidLength(t *testing.T) {
+	_, err := z85.Decode(`HelloWorld12`)
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindInvalidLength, 8, 10, 0)
+}
+
+// TestCodecErrorUnexpectedLength tests the fields of a CodecError for an unexpected length.
+func TestCodecErrorUnexpectedLength(t *testing.T) {
+	_, err := z85.Decode20(encodedTheOne)
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindUnexpectedLength, 8, 10, 0)
+}
+
+// TestCodecErrorOverflow tests the fields of a CodecError for an overflowing chunk in all decoders.
+func TestCodecErrorOverflow(t *testing.T) {
+	const overflowing = `HelloWorld%nSc1`
+
+	decoders := map[string]func() error{
+		`Decode`: func() error {
+			_, err := z85.Decode(overflowing)
+			return err
+		},
+		`DecodeBytes`: func() error {
+			_, err := z85.DecodeBytes([]byte(overflowing))
+			return err
+		},
+		`Decode20`: func() error {
+			_, err := z85.Decode20(overflowing + `00000`)
+			return err
+		},
+		`PaddedEncoding`: func() error {
+			_, err := z85.PaddedEncoding.DecodeString(overflowing + `0`)
+			return err
+		},
+		`NewDecoder`: func() error {
+			_, err := io.ReadAll(z85.NewDecoder(strings.NewReader(overflowing)))
+			return err
+		},
+	}
+
+	for name, decode := range decoders {
+		err := decode()
+
+		var codecErr *z85.CodecError
+		if !errors.As(err, &codecErr) {
+			t.Fatalf(`%s: Error is not a CodecError: '%v'`, name, err)
+		}
+
+		checkCodecError(t, codecErr, z85.KindOverflow, 8, 10, 0)
+
+		if !z85.IsErrOverflow(err) {
+			t.Fatalf(`%s: CodecError does not wrap ErrOverflow: '%v'`, name, err)
+		}
+	}
+}
+
+// TestErrorKindString tests the names of the error kinds.
+func TestErrorKindString(t *testing.T) {
+	if z85.KindInvalidByte.String() != `invalid byte` {
+		t.Fatalf(`Wrong name for KindInvalidByte: '%s'`, z85.KindInvalidByte)
+	}
+
+	if z85.ErrorKind(0).String() != `unknown kind 0` {
+		t.Fatalf(`Wrong name for unknown kind: '%s'`, z85.ErrorKind(0))
+	}
+}
+
+// ******** Private functions ********
+
+// checkCodecError checks the fields of a CodecError.
+func checkCodecError(t *testing.T,
+	codecErr *z85.CodecError,
+	kind z85.ErrorKind,
+	rawOffset int64,
+	encodedOffset int64,
+	b byte) {
+	t.Helper()
+
+	if codecErr.Kind != kind {
+		t.Fatalf(`Wrong kind: %s`, codecErr.Kind)
+	}
+
+	if codecErr.RawOffset != rawOffset {
+		t.Fatalf(`Wrong raw offset: %d`, codecErr.RawOffset)
+	}
+
+	if codecErr.EncodedOffset != encodedOffset {
+		t.Fatalf(`Wrong encoded offset: %d`, codecErr.EncodedOffset)
+	}
+
+	if codecErr.Byte != b {
+		t.Fatalf(`Wrong byte: %q`, codecErr.Byte)
+	}
+
+	if errors.Unwrap(codecErr) == nil {
+		t.Fatal(`CodecError does not wrap a cause`)
+	}
+}
@@ -0,0 +1,299 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.1.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//    2026-10-17: V1.1.0: Add fast paths for common sizes.
+//
+
+package z85
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// ******** Private constants ********
+
+// key40Size is the length of an encoded 32 byte key.
+const key40Size = 40
+
+// uuid20Size is the length of an encoded 16 byte UUID.
+const uuid20Size = 20
+
+// key80Size is the length of an encoded 64 byte key.
+const key80Size = 80
+
+// quadChunkSize is the size of 4 byte chunks, i.e. of a UUID.
+const quadChunkSize = 4 * byteChunkSize
+
+// quadEncodedChunkSize is the size of 4 encoded chunks.
+const quadEncodedChunkSize = 4 * encodedChunkSize
+
+// ******** Public functions ********
+
+// Decode40 decodes a Z85 string of exactly 40 characters into a 32 byte array.
+// This is the size of an encoded CURVE key.
+func Decode40(source string) ([32]byte, error) {
+	var result [32]byte
+
+	if len(source) != key40Size {
+		return result, newUnexpectedLengthError(key40Size, uint(len(source)))
+	}
+
+	// The decoding is unrolled on purpose.
+	source = source[:key40Size]
+	if err := decodeFixedChunk(result[0:4], source[0:5], 0); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[4:8], source[5:10], 5); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[8:12], source[10:15], 10); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[12:16], source[15:20], 15); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[16:20], source[20:25], 20); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[20:24], source[25:30], 25); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[24:28], source[30:35], 30); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[28:32], source[35:40], 35); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Decode20 decodes a Z85 string of exactly 20 characters into a 16 byte array.
+// This is the size of an encoded UUID.
+func Decode20(source string) ([16]byte, error) {
+	var result [16]byte
+
+	if len(source) != uuid20Size {
+		return result, newUnexpectedLengthError(uuid20Size, uint(len(source)))
+	}
+
+	// The decoding is unrolled on purpose.
+	source = source[:uuid20Size]
+	if err := decodeFixedChunk(result[0:4], source[0:5], 0); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[4:8], source[5:10], 5); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[8:12], source[10:15], 10); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[12:16], source[15:20], 15); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Decode80 decodes a Z85 string of exactly 80 characters into a 64 byte array.
+// This is the size of an encoded 64 byte key, e.g. an Ed25519 private key.
+// It does not allocate memory, if it succeeds.
+func Decode80(source string) ([64]byte, error) {
+	var result [64]byte
+
+	if len(source) != key80Size {
+		return result, newUnexpectedLengthError(key80Size, uint(len(source)))
+	}
+
+	// The decoding is unrolled on purpose.
+	source = source[:key80Size]
+	if err := decodeFixedChunk(result[0:4], source[0:5], 0); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[4:8], source[5:10], 5); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[8:12], source[10:15], 10); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[12:16], source[15:20], 15); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[16:20], source[20:25], 20); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[20:24], source[25:30], 25); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[24:28], source[30:35], 30); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[28:32], source[35:40], 35); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[32:36], source[40:45], 40); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[36:40], source[45:50], 45); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[40:44], source[50:55], 50); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[44:48], source[55:60], 55); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[48:52], source[60:65], 60); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[52:56], source[65:70], 65); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[56:60], source[70:75], 70); err != nil {
+		return result, err
+	}
+	if err := decodeFixedChunk(result[60:64], source[75:80], 75); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// ******** Private functions ********
+
+// decodeFixedChunk decodes exactly one chunk without any loops.
+// The position is the position of the chunk in the encoded string.
+func decodeFixedChunk(destination []byte, chunk string, position uint) error {
+	value, err := StdEncoding.decodeChunkValue(chunk, position)
+	if err != nil {
+		return err
+	}
+
+	binary.BigEndian.PutUint32(destination, value)
+
+	return nil
+}
+
+// encodeFixedSize encodes source with an unrolled fast path, if its length is 4, 16, 32 or 64 bytes.
+// It returns false, if there is no fast path for the length of source.
+func (e *Encoding) encodeFixedSize(destination []byte, source []byte) bool {
+	switch len(source) {
+	case byteChunkSize:
+		e.encodeChunk(destination, binary.BigEndian.Uint32(source))
+
+	case quadChunkSize:
+		e.encodeQuadChunk(destination, source)
+
+	case 2 * quadChunkSize:
+		e.encodeQuadChunk(destination, source)
+		e.encodeQuadChunk(destination[quadEncodedChunkSize:], source[quadChunkSize:])
+
+	case 4 * quadChunkSize:
+		e.encodeQuadChunk(destination, source)
+		e.encodeQuadChunk(destination[quadEncodedChunkSize:], source[quadChunkSize:])
+		e.encodeQuadChunk(destination[2*quadEncodedChunkSize:], source[2*quadChunkSize:])
+		e.encodeQuadChunk(destination[3*quadEncodedChunkSize:], source[3*quadChunkSize:])
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// encodeQuadChunk encodes the first 16 bytes of source into the first 20 bytes of destination.
+func (e *Encoding) encodeQuadChunk(destination []byte, source []byte) {
+	_ = source[quadChunkSize-1]             // Eliminate bounds checks below.
+	_ = destination[quadEncodedChunkSize-1] // Eliminate bounds checks below.
+
+	e.encodeDoubleChunk(destination[0:10], binary.BigEndian.Uint64(source[0:8]))
+	e.encodeDoubleChunk(destination[10:20], binary.BigEndian.Uint64(source[8:16]))
+}
+
+// decodeFixedSize decodes source, which is either a string or a byte slice, with an unrolled fast path,
+// if its length is 5, 20, 40 or 80 characters.
+// It returns false, if there is no fast path for the length of source or if source is not valid.
+// Then the caller has to decode source with the general loop, which reports the exact position of the error.
+func decodeFixedSize[T string | []byte](e *Encoding, destination []byte, source T) bool {
+	switch len(source) {
+	case encodedChunkSize:
+		return decodeSingleChunk(e, destination, source)
+
+	case quadEncodedChunkSize:
+		return decodeQuadChunk(e, destination, source)
+
+	case 2 * quadEncodedChunkSize:
+		return decodeQuadChunk(e, destination, source) &&
+			decodeQuadChunk(e, destination[quadChunkSize:], source[quadEncodedChunkSize:])
+
+	case 4 * quadEncodedChunkSize:
+		return decodeQuadChunk(e, destination, source) &&
+			decodeQuadChunk(e, destination[quadChunkSize:], source[quadEncodedChunkSize:]) &&
+			decodeQuadChunk(e, destination[2*quadChunkSize:], source[2*quadEncodedChunkSize:]) &&
+			decodeQuadChunk(e, destination[3*quadChunkSize:], source[3*quadEncodedChunkSize:])
+	}
+
+	return false
+}
+
+// decodeQuadChunk decodes the first 20 characters of source into the first 16 bytes of destination.
+// It returns false, if the characters are not valid.
+func decodeQuadChunk[T string | []byte](e *Encoding, destination []byte, source T) bool {
+	_ = source[quadEncodedChunkSize-1] // Eliminate bounds checks below.
+	_ = destination[quadChunkSize-1]   // Eliminate bounds checks below.
+
+	return decodeSingleChunk(e, destination[0:4], source[0:5]) &&
+		decodeSingleChunk(e, destination[4:8], source[5:10]) &&
+		decodeSingleChunk(e, destination[8:12], source[10:15]) &&
+		decodeSingleChunk(e, destination[12:16], source[15:20])
+}
+
+// decodeSingleChunk decodes the first 5 characters of source into the first 4 bytes of destination.
+// It returns false, if the characters are not valid.
+func decodeSingleChunk[T string | []byte](e *Encoding, destination []byte, source T) bool {
+	_ = source[encodedChunkSize-1] // Eliminate bounds checks below.
+
+	table := e.decodeTable
+	d0 := table[source[0]]
+	d1 := table[source[1]]
+	d2 := table[source[2]]
+	d3 := table[source[3]]
+	d4 := table[source[4]]
+
+	if (d0|d1|d2|d3|d4)&invalidMarker != 0 {
+		return false
+	}
+
+	value := (((uint64(d0)*codeSize+uint64(d1))*codeSize+uint64(d2))*codeSize+uint64(d3))*codeSize + uint64(d4)
+	if value > math.MaxUint32 {
+		return false
+	}
+
+	binary.BigEndian.PutUint32(destination, uint32(value))
+
+	return true
+}
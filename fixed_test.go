@@ -0,0 +1,203 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"errors"
+	"github.com/xformerfhs/z85"
+	"strings"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestDecode40 tests if Decode40 has the same result as Decode.
+func TestDecode40(t *testing.T) {
+	key := make([]byte, 32)
+	for i := 0; i < iterationCount; i++ {
+		_, _ = crand.Read(key)
+		encoded, _ := z85.Encode(key)
+
+		decoded, err := z85.Decode40(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding failed: %v`, err)
+		}
+
+		if !bytes.Equal(decoded[:], key) {
+			t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, decoded)
+		}
+	}
+}
+
+// TestDecode20 tests if Decode20 has the same result as Decode.
+func TestDecode20(t *testing.T) {
+	uuid := make([]byte, 16)
+	for i := 0; i < iterationCount; i++ {
+		_, _ = crand.Read(uuid)
+		encoded, _ := z85.Encode(uuid)
+
+		decoded, err := z85.Decode20(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding failed: %v`, err)
+		}
+
+		if !bytes.Equal(decoded[:], uuid) {
+			t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, decoded)
+		}
+	}
+}
+
+// TestDecode80 tests if Decode80 has the same result as Decode.
+func TestDecode80(t *testing.T) {
+	key := make([]byte, 64)
+	for i := 0; i < iterationCount; i++ {
+		_, _ = crand.Read(key)
+		encoded, _ := z85.Encode(key)
+
+		decoded, err := z85.Decode80(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding failed: %v`, err)
+		}
+
+		if !bytes.Equal(decoded[:], key) {
+			t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, decoded)
+		}
+	}
+
+	_, err := z85.Decode80(encodedTheOne)
+	if !z85.IsErrUnexpectedLength(err) {
+		t.Fatalf(`Expected unexpected length error, but got: %v`, err)
+	}
+}
+
+// TestDecode40WrongLength tests if an error occurs when decoding a string that does not have 40 characters.
+func TestDecode40WrongLength(t *testing.T) {
+	_, err := z85.Decode40(encodedTheOne)
+	if err == nil {
+		t.Fatal(`Wrong length did not result in an error`)
+	} else {
+		if !z85.IsErrUnexpectedLength(err) {
+			t.Fatalf(`Wrong error when decoding wrong length string: '%v'`, err)
+		}
+		if !strings.HasSuffix(err.Error(), ` 40`) {
+			t.Fatalf(`Wrong length did not result in an error message ending with %d: '%s'`, 40, err)
+		}
+	}
+}
+
+// TestDecode20InvalidChar tests if an error occurs with decoding an invalid character.
+func TestDecode20InvalidChar(t *testing.T) {
+	_, err := z85.Decode20(`HelloWorldHelloWo"ld`)
+	if err == nil {
+		t.Fatal(`Invalid character did not result in an error`)
+	} else {
+		if !z85.IsErrInvalidByte(err) {
+			t.Fatalf(`Wrong error when decoding invalid character: '%v'`, err)
+		}
+		if !strings.HasSuffix(err.Error(), ` 17: '"'`) {
+			t.Fatalf(`Correct error with wrong text: '%v'`, err)
+		}
+	}
+}
+
+// TestDecodeFixedSizes tests if data of the sizes with a fast path is decoded correctly.
+func TestDecodeFixedSizes(t *testing.T) {
+	customEncoding, _ := z85.NewEncoding(reversedAlphabet)
+	for _, size := range []int{4, 16, 32, 64} {
+		data := make([]byte, size)
+		for i := 0; i < iterationCount; i++ {
+			_, _ = crand.Read(data)
+
+			for _, encoding := range []*z85.Encoding{z85.StdEncoding, customEncoding} {
+				encoded, _ := encoding.EncodeToString(data)
+				decoded, err := encoding.DecodeString(encoded)
+				if err != nil {
+					t.Fatalf(`Decoding of %d bytes failed: %v`, size, err)
+				}
+
+				if !bytes.Equal(decoded, data) {
+					t.Fatalf(`Decoding of %d bytes resulted in '% 02x' instead of '% 02x'`, size, decoded, data)
+				}
+			}
+		}
+	}
+}
+
+// TestEncodeFixedSizes tests if data of the sizes with a fast path is encoded like longer data.
+func TestEncodeFixedSizes(t *testing.T) {
+	customEncoding, _ := z85.NewEncoding(reversedAlphabet)
+	for _, size := range []int{4, 16, 32, 64} {
+		data := make([]byte, size+4)
+		for i := 0; i < iterationCount; i++ {
+			_, _ = crand.Read(data)
+
+			for _, encoding := range []*z85.Encoding{z85.StdEncoding, customEncoding} {
+				encoded, _ := encoding.EncodeToString(data[:size])
+				longer, _ := encoding.EncodeToString(data)
+
+				if encoded != longer[:len(encoded)] {
+					t.Fatalf(`Encoding of %d bytes resulted in '%s' instead of '%s'`, size, encoded, longer[:len(encoded)])
+				}
+			}
+		}
+	}
+}
+
+// TestDecodeFixedSizesErrors tests if errors in data of the sizes with a fast path are reported at the right position.
+func TestDecodeFixedSizesErrors(t *testing.T) {
+	for _, size := range []int{5, 20, 40, 80} {
+		valid := strings.Repeat(`0`, size)
+		for position := 0; position < size; position++ {
+			invalid := valid[:position] + `~` + valid[position+1:]
+
+			_, err := z85.Decode(invalid)
+			if !z85.IsErrInvalidByte(err) {
+				t.Fatalf(`Invalid character at position %d of %d did not result in an invalid byte error: %v`, position, size, err)
+			}
+
+			var codecErr *z85.CodecError
+			_ = errors.As(err, &codecErr)
+			checkCodecError(t, codecErr, z85.KindInvalidByte, int64(position/5*4), int64(position), '~')
+		}
+
+		overflow := valid[:size-5] + `#####`
+		_, err := z85.Decode(overflow)
+
+		var codecErr *z85.CodecError
+		if !errors.As(err, &codecErr) || codecErr.Kind != z85.KindOverflow {
+			t.Fatalf(`Overflow in the last chunk of %d characters was not detected: %v`, size, err)
+		}
+
+		if codecErr.EncodedOffset != int64(size-5) {
+			t.Fatalf(`Overflow of %d characters was reported at offset %d instead of %d`, size, codecErr.EncodedOffset, size-5)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// ******** Private constants ********
+
+// maxFrameDataLen is the maximum length of the data in a frame.
+const maxFrameDataLen = math.MaxUint32
+
+// ******** Public functions ********
+
+// EncodeFramed encodes a byte slice of any length into a self-describing Z85 frame.
+//
+// The frame starts with a header chunk that contains the length of the data as a 32 bit big-endian number.
+// It is followed by the data padded with zero bytes to a multiple of 4.
+// So the data round-trips exactly without the length being transferred separately.
+// The length of the data must not exceed 4294967295 bytes.
+func EncodeFramed(source []byte) (string, error) {
+	// This limit can only be exceeded on 64-bit platforms.
+	maxLen := uint64(maxFrameDataLen)
+	sourceLen := len(source)
+	if uint64(sourceLen) > maxLen {
+		return ``, newInputTooLargeError(int(maxLen))
+	}
+
+	chunkCount := sourceLen >> byteChunkShift
+	if (sourceLen & byteChunkMask) != 0 {
+		chunkCount++
+	}
+
+	// The header is one additional chunk.
+	if chunkCount >= MaxEncodeInputLen/byteChunkSize {
+		return ``, newInputTooLargeError(MaxEncodeInputLen - byteChunkSize)
+	}
+
+	result := make([]byte, (chunkCount+1)*encodedChunkSize)
+	StdEncoding.encodeChunk(result, uint32(sourceLen))
+
+	fullLen := sourceLen &^ byteChunkMask
+	destination := result[encodedChunkSize:]
+	StdEncoding.encodeChunks(destination, source[:fullLen])
+
+	if fullLen < sourceLen {
+		var lastChunk [byteChunkSize]byte
+		copy(lastChunk[:], source[fullLen:])
+		StdEncoding.encodeChunk(destination[fullLen+(fullLen>>byteChunkShift):], binary.BigEndian.Uint32(lastChunk[:]))
+	}
+
+	return string(result), nil
+}
+
+// DecodeFramed decodes a Z85 frame that was created by EncodeFramed.
+// It returns an error, if the length in the header does not match the length of the data.
+func DecodeFramed(source string) ([]byte, error) {
+	if len(source) < encodedChunkSize {
+		return nil, newInvalidLengthError(encodedChunkSize, 0)
+	}
+
+	decodedLen, err := decodedLength(len(source))
+	if err != nil {
+		return nil, err
+	}
+
+	length, err := StdEncoding.decodeChunkValue(source[:encodedChunkSize], 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// The data must be the length in the header padded to a multiple of 4.
+	dataLen := uint64(decodedLen - byteChunkSize)
+	if uint64(length) > dataLen || dataLen-uint64(length) >= byteChunkSize {
+		return nil, newInvalidFrameError(length)
+	}
+
+	result := make([]byte, dataLen)
+	err = decodeChunks(StdEncoding, result, source[encodedChunkSize:], encodedChunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return result[:length], nil
+}
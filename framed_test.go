@@ -0,0 +1,108 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"github.com/xformerfhs/z85"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestFramedRoundTrip tests if data of all lengths survives framing.
+func TestFramedRoundTrip(t *testing.T) {
+	for size := 0; size <= maxSliceSize; size++ {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		encoded, err := z85.EncodeFramed(data)
+		if err != nil {
+			t.Fatalf(`Encoding of size %d failed: %v`, size, err)
+		}
+
+		decoded, err := z85.DecodeFramed(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoded data of size %d is '% 02x', but should be '% 02x'`, size, decoded, data)
+		}
+	}
+}
+
+// TestFramedHeader tests if the frame starts with the encoded length.
+func TestFramedHeader(t *testing.T) {
+	encoded, _ := z85.EncodeFramed(clearTheOne)
+
+	header, _ := z85.Encode([]byte{0, 0, 0, byte(len(clearTheOne))})
+	if encoded != header+encodedTheOne {
+		t.Fatalf(`Frame is '%s', but should be '%s'`, encoded, header+encodedTheOne)
+	}
+}
+
+// TestFramedInvalidLength tests if frames with a length that does not match the data are rejected.
+func TestFramedInvalidLength(t *testing.T) {
+	for _, length := range []byte{0, 4, 9, 255} {
+		header, _ := z85.Encode([]byte{0, 0, 0, length})
+
+		_, err := z85.DecodeFramed(header + encodedTheOne)
+		if !z85.IsErrInvalidFrame(err) {
+			t.Fatalf(`Expected invalid frame error for length %d, but got: %v`, length, err)
+		}
+	}
+}
+
+// TestFramedInvalid tests if malformed frames are rejected.
+func TestFramedInvalid(t *testing.T) {
+	_, err := z85.DecodeFramed(``)
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error for empty frame, but got: %v`, err)
+	}
+
+	_, err = z85.DecodeFramed(encodedTheOne[:7])
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	_, err = z85.DecodeFramed(`0000`)
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error for short frame, but got: %v`, err)
+	}
+
+	header, _ := z85.Encode([]byte{0, 0, 0, 8})
+	_, err = z85.DecodeFramed(header + `Hell~World`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Expected invalid byte error, but got: %v`, err)
+	}
+
+	checkStreamOffsets(t, err, 4, 9)
+}
@@ -0,0 +1,196 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ******** Public constants ********
+
+// ManifestFileName is the name of the manifest file that EncodeFS writes and DecodeFS reads.
+const ManifestFileName = `z85-manifest.txt`
+
+// EncodedFileSuffix is the suffix that is appended to the names of encoded files.
+const EncodedFileSuffix = `.z85`
+
+// ******** Private constants ********
+
+// dirPerm is the permission of created directories.
+const dirPerm = 0o755
+
+// filePerm is the permission of created files.
+const filePerm = 0o644
+
+// invalidManifestMessage contains the format for the error message of an invalid manifest line.
+const invalidManifestMessage = `invalid manifest line %d`
+
+// invalidPathMessage contains the format for the error message of an invalid path in a manifest.
+const invalidPathMessage = `invalid path in manifest line %d: %q`
+
+// invalidFileNameMessage contains the format for the error message of a file name that cannot be processed.
+const invalidFileNameMessage = `invalid file name: %q`
+
+// invalidSizeMessage contains the format for the error message of a file that does not match its manifest size.
+const invalidSizeMessage = `decoded file %q does not match manifest size %d`
+
+// ******** Public functions ********
+
+// EncodeFS encodes every regular file in fsys and writes it to the directory dst, preserving the directory structure.
+// Each encoded file gets the suffix EncodedFileSuffix.
+// As Z85 can only encode data with a length that is a multiple of 4, files are padded with zero bytes.
+// The original sizes are written to the manifest file ManifestFileName in dst.
+// Empty directories and files that are not regular files are not encoded.
+func EncodeFS(fsys fs.FS, dst string) error {
+	var manifest bytes.Buffer
+
+	err := fs.WalkDir(fsys, `.`, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		if strings.ContainsAny(filePath, "\r\n") {
+			return fmt.Errorf(invalidFileNameMessage, filePath)
+		}
+
+		var data []byte
+		data, err = fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return err
+		}
+
+		size := len(data)
+		padded := make([]byte, (size+byteChunkMask)&^byteChunkMask)
+		copy(padded, data)
+
+		var encoded string
+		encoded, err = Encode(padded)
+		if err != nil {
+			return err
+		}
+
+		err = writeFile(dst, filePath+EncodedFileSuffix, []byte(encoded))
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(&manifest, "%d %s\n", size, filePath)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeFile(dst, ManifestFileName, manifest.Bytes())
+}
+
+// DecodeFS decodes every file listed in the manifest file of fsys and writes it to the directory dst,
+// preserving the directory structure.
+// It reverses EncodeFS.
+func DecodeFS(fsys fs.FS, dst string) error {
+	manifest, err := fs.ReadFile(fsys, ManifestFileName)
+	if err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(manifest))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		sizeText, filePath, found := strings.Cut(scanner.Text(), ` `)
+		if !found {
+			return fmt.Errorf(invalidManifestMessage, lineNumber)
+		}
+
+		var size int
+		size, err = strconv.Atoi(sizeText)
+		if err != nil || size < 0 {
+			return fmt.Errorf(invalidManifestMessage, lineNumber)
+		}
+
+		if !fs.ValidPath(filePath) || filePath == `.` {
+			return fmt.Errorf(invalidPathMessage, lineNumber, filePath)
+		}
+
+		var encoded []byte
+		encoded, err = fs.ReadFile(fsys, filePath+EncodedFileSuffix)
+		if err != nil {
+			return err
+		}
+
+		var decoded []byte
+		decoded, err = Decode(string(encoded))
+		if err != nil {
+			return fmt.Errorf(`%s: %w`, filePath+EncodedFileSuffix, err)
+		}
+
+		if size > len(decoded) || len(decoded)-size > byteChunkMask {
+			return fmt.Errorf(invalidSizeMessage, filePath, size)
+		}
+
+		err = writeFile(dst, filePath, decoded[:size])
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// ******** Private functions ********
+
+// writeFile writes data to a file with a slash-separated path relative to the directory dst.
+// Missing directories are created.
+func writeFile(dst string, filePath string, data []byte) error {
+	if !fs.ValidPath(filePath) {
+		return fmt.Errorf(invalidFileNameMessage, filePath)
+	}
+
+	fullPath := filepath.Join(dst, filepath.FromSlash(filePath))
+	err := os.MkdirAll(filepath.Join(dst, filepath.FromSlash(path.Dir(filePath))), dirPerm)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(fullPath, data, filePerm)
+}
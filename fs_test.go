@@ -0,0 +1,122 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	"github.com/xformerfhs/z85"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+// ******** Test functions ********
+
+// TestEncodeDecodeFS tests if a file tree is restored exactly after encoding and decoding.
+func TestEncodeDecodeFS(t *testing.T) {
+	source := fstest.MapFS{
+		`empty.bin`:             {Data: []byte{}},
+		`one.bin`:               {Data: []byte{0x01}},
+		`dir/aligned.bin`:       {Data: clearTheOne},
+		`dir/sub/unaligned.bin`: {Data: []byte{0x86, 0x4f, 0xd2, 0x6f, 0xb5, 0x59}},
+		`dir/with space.bin`:    {Data: []byte(`with space`)},
+	}
+
+	encodedDir := t.TempDir()
+	err := z85.EncodeFS(source, encodedDir)
+	if err != nil {
+		t.Fatalf(`EncodeFS failed: %v`, err)
+	}
+
+	var encoded []byte
+	encoded, err = os.ReadFile(filepath.Join(encodedDir, `dir`, `aligned.bin`+z85.EncodedFileSuffix))
+	if err != nil {
+		t.Fatalf(`Encoded file could not be read: %v`, err)
+	}
+
+	if string(encoded) != encodedTheOne {
+		t.Fatalf(`Encoded file has wrong content: '%s'`, encoded)
+	}
+
+	decodedDir := t.TempDir()
+	err = z85.DecodeFS(os.DirFS(encodedDir), decodedDir)
+	if err != nil {
+		t.Fatalf(`DecodeFS failed: %v`, err)
+	}
+
+	for name, file := range source {
+		var decoded []byte
+		decoded, err = os.ReadFile(filepath.Join(decodedDir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatalf(`Decoded file could not be read: %v`, err)
+		}
+
+		if !bytes.Equal(decoded, file.Data) {
+			t.Fatalf(`Decoded file '%s' has wrong content: '% 02x'`, name, decoded)
+		}
+	}
+}
+
+// TestDecodeFSInvalidPath tests if DecodeFS rejects paths that leave the destination directory.
+func TestDecodeFSInvalidPath(t *testing.T) {
+	source := fstest.MapFS{
+		z85.ManifestFileName: {Data: []byte("8 ../evil.bin\n")},
+	}
+
+	err := z85.DecodeFS(source, t.TempDir())
+	if err == nil {
+		t.Fatal(`Invalid path did not result in an error`)
+	}
+}
+
+// TestDecodeFSInvalidManifest tests if DecodeFS rejects an invalid manifest.
+func TestDecodeFSInvalidManifest(t *testing.T) {
+	source := fstest.MapFS{
+		z85.ManifestFileName: {Data: []byte("abc\n")},
+	}
+
+	err := z85.DecodeFS(source, t.TempDir())
+	if err == nil {
+		t.Fatal(`Invalid manifest did not result in an error`)
+	}
+}
+
+// TestDecodeFSWrongSize tests if DecodeFS detects a size that does not match the encoded file.
+func TestDecodeFSWrongSize(t *testing.T) {
+	source := fstest.MapFS{
+		z85.ManifestFileName:              {Data: []byte("3 one.bin\n")},
+		`one.bin` + z85.EncodedFileSuffix: {Data: []byte(encodedTheOne)},
+	}
+
+	err := z85.DecodeFS(source, t.TempDir())
+	if err == nil {
+		t.Fatal(`Wrong size did not result in an error`)
+	}
+}
@@ -0,0 +1,133 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"errors"
+	"github.com/xformerfhs/z85"
+	"testing"
+)
+
+// ******** Private variables ********
+
+// decodeRegressions contains inputs that caused problems in the decoder and the expected error kind.
+// A kind of 0 means that the decoding must succeed.
+// The inputs are also the seeds of FuzzDecode.
+var decodeRegressions = []struct {
+	input string
+	kind  z85.ErrorKind
+}{
+	{``, 0},
+	{encodedTheOne, 0},
+	// The largest encoded chunk.
+	{`%nSc0`, 0},
+	// Overflowing chunks.
+	{`%nSc1`, z85.KindOverflow},
+	{`#####`, z85.KindOverflow},
+	{`HelloWorld#####`, z85.KindOverflow},
+	// Whitespace and line breaks at the boundaries.
+	{` HelloWorld`, z85.KindInvalidLength},
+	{`HelloWorl `, z85.KindInvalidByte},
+	{"HelloWorld\n", z85.KindInvalidLength},
+	{"Hello\nWorl", z85.KindControlCharacter},
+	{"\r\nHelloWor", z85.KindControlCharacter},
+	// A byte order mark.
+	{"\xef\xbb\xbfHelloWo", z85.KindInvalidByte},
+	// Characters just outside the alphabet.
+	{`Hell~World`, z85.KindInvalidByte},
+	{"Hell\x7fWorld", z85.KindControlCharacter},
+}
+
+// ******** Test functions ********
+
+// TestDecodeRegressions tests if the regression inputs result in the expected errors.
+func TestDecodeRegressions(t *testing.T) {
+	for _, regression := range decodeRegressions {
+		_, err := z85.Decode(regression.input)
+
+		if regression.kind == 0 {
+			if err != nil {
+				t.Fatalf(`Decoding of %q failed: %v`, regression.input, err)
+			}
+
+			continue
+		}
+
+		var codecErr *z85.CodecError
+		if !errors.As(err, &codecErr) {
+			t.Fatalf(`Decoding of %q did not return a CodecError, but: %v`, regression.input, err)
+		}
+
+		if codecErr.Kind != regression.kind {
+			t.Fatalf(`Decoding of %q returned kind '%s', but should return '%s'`, regression.input, codecErr.Kind, regression.kind)
+		}
+	}
+}
+
+// FuzzDecode tests if decoding arbitrary strings never panics, returns consistent lengths and errors
+// and if every successfully decoded string is the encoding of its decoding.
+func FuzzDecode(f *testing.F) {
+	for _, regression := range decodeRegressions {
+		f.Add(regression.input)
+	}
+
+	f.Fuzz(func(t *testing.T, input string) {
+		decoded, err := z85.Decode(input)
+		if z85.IsValid(input) != (err == nil) {
+			t.Fatalf(`IsValid of %q does not match the decoding error: %v`, input, err)
+		}
+
+		if err != nil {
+			var codecErr *z85.CodecError
+			if !errors.As(err, &codecErr) {
+				t.Fatalf(`Decoding of %q did not return a CodecError, but: %v`, input, err)
+			}
+
+			if codecErr.EncodedOffset < 0 || codecErr.EncodedOffset > int64(len(input)) {
+				t.Fatalf(`Decoding of %q returned an offset outside of the input: %d`, input, codecErr.EncodedOffset)
+			}
+
+			return
+		}
+
+		if len(decoded) != len(input)/5*4 {
+			t.Fatalf(`Decoding of %q has length %d`, input, len(decoded))
+		}
+
+		encoded, err := z85.Encode(decoded)
+		if err != nil {
+			t.Fatalf(`Encoding of the decoding of %q failed: %v`, input, err)
+		}
+
+		// Overflowing chunks are rejected, so the encoding is unique.
+		if encoded != input {
+			t.Fatalf(`Encoding of the decoding of %q is %q`, input, encoded)
+		}
+	})
+}
@@ -0,0 +1,56 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package gitbase85
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ******** Private constants ********
+
+// corruptLineMessage contains the format for the error message of an invalid line.
+const corruptLineMessage = `invalid git base85 data in line %d`
+
+// ******** Public types and functions ********
+
+// ErrCorruptLine is returned when a line of the encoded data is not valid.
+// Its value is the number of the line, starting with 1.
+type ErrCorruptLine int
+
+// Error returns the error message for a corrupt line error.
+func (e ErrCorruptLine) Error() string {
+	return fmt.Sprintf(corruptLineMessage, int(e))
+}
+
+// IsErrCorruptLine reports whether the supplied error is the ErrCorruptLine error.
+func IsErrCorruptLine(err error) bool {
+	var expectedErr ErrCorruptLine
+	return errors.As(err, &expectedErr)
+}
@@ -0,0 +1,170 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Package gitbase85 implements the base85 encoding that Git uses in binary patches.
+//
+// The data is split into lines of at most 52 bytes.
+// Each line starts with a character that contains the number of bytes in the line:
+// 'A' to 'Z' for 1 to 26 bytes and 'a' to 'z' for 27 to 52 bytes.
+// It is followed by the RFC 1924 encoding of the bytes padded with zero bytes to a multiple of 4
+// and a line feed.
+package gitbase85
+
+import (
+	"github.com/xformerfhs/z85"
+	"strings"
+)
+
+// ******** Public constants ********
+
+// MaxLineBytes is the maximum number of bytes encoded in one line.
+const MaxLineBytes = 52
+
+// ******** Private constants ********
+
+// byteChunkSize is the size of a raw chunk.
+const byteChunkSize = 4
+
+// encodedChunkSize is the size of an encoded chunk.
+const encodedChunkSize = 5
+
+// shortLineBytes is the maximum number of bytes whose length is encoded with an upper case letter.
+const shortLineBytes = 26
+
+// lineFeed terminates each line.
+const lineFeed = '\n'
+
+// ******** Public functions ********
+
+// Encode encodes a byte slice of any length into the lines of a Git binary patch.
+// Each line is terminated by a line feed.
+func Encode(source []byte) string {
+	var result strings.Builder
+	result.Grow(encodedLen(len(source)))
+
+	var line [MaxLineBytes]byte
+	var encodedLine [MaxLineBytes / byteChunkSize * encodedChunkSize]byte
+	for len(source) > 0 {
+		lineLen := min(len(source), MaxLineBytes)
+		paddedLen := paddedLength(lineLen)
+
+		clear(line[lineLen:paddedLen])
+		copy(line[:], source[:lineLen])
+
+		// The padded length is a multiple of 4, so the encoding cannot fail.
+		n, _ := z85.RFC1924Encoding.Encode(encodedLine[:], line[:paddedLen])
+
+		result.WriteByte(lengthChar(lineLen))
+		result.Write(encodedLine[:n])
+		result.WriteByte(lineFeed)
+
+		source = source[lineLen:]
+	}
+
+	return result.String()
+}
+
+// Decode decodes the lines of a Git binary patch.
+// The last line does not need to be terminated by a line feed.
+func Decode(source string) ([]byte, error) {
+	result := make([]byte, 0, len(source)/encodedChunkSize*byteChunkSize)
+
+	lineNumber := 0
+	for len(source) > 0 {
+		lineNumber++
+
+		encodedLine, rest, _ := strings.Cut(source, string(lineFeed))
+		source = rest
+
+		lineLen := lineLength(encodedLine)
+		if lineLen == 0 {
+			return nil, ErrCorruptLine(lineNumber)
+		}
+
+		paddedLen := paddedLength(lineLen)
+		if len(encodedLine) != 1+paddedLen/byteChunkSize*encodedChunkSize {
+			return nil, ErrCorruptLine(lineNumber)
+		}
+
+		decoded, err := z85.RFC1924Encoding.AppendDecode(result, encodedLine[1:])
+		if err != nil {
+			return nil, ErrCorruptLine(lineNumber)
+		}
+
+		// Remove the padding bytes.
+		result = decoded[:len(decoded)-(paddedLen-lineLen)]
+	}
+
+	return result, nil
+}
+
+// ******** Private functions ********
+
+// encodedLen returns the length of the encoding of n bytes.
+func encodedLen(n int) int {
+	fullLines := n / MaxLineBytes
+	result := fullLines * (1 + MaxLineBytes/byteChunkSize*encodedChunkSize + 1)
+
+	rest := n - fullLines*MaxLineBytes
+	if rest > 0 {
+		result += 1 + paddedLength(rest)/byteChunkSize*encodedChunkSize + 1
+	}
+
+	return result
+}
+
+// paddedLength returns n rounded up to a multiple of 4.
+func paddedLength(n int) int {
+	return (n + byteChunkSize - 1) &^ (byteChunkSize - 1)
+}
+
+// lengthChar returns the character that encodes the length of a line.
+func lengthChar(n int) byte {
+	if n <= shortLineBytes {
+		return byte('A' + n - 1)
+	}
+
+	return byte('a' + n - shortLineBytes - 1)
+}
+
+// lineLength returns the number of bytes in an encoded line, or 0, if the line has no valid length character.
+func lineLength(encodedLine string) int {
+	if len(encodedLine) == 0 {
+		return 0
+	}
+
+	c := encodedLine[0]
+	switch {
+	case c >= 'A' && c <= 'Z':
+		return int(c-'A') + 1
+	case c >= 'a' && c <= 'z':
+		return int(c-'a') + shortLineBytes + 1
+	default:
+		return 0
+	}
+}
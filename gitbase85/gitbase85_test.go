@@ -0,0 +1,135 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package gitbase85_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	crand "crypto/rand"
+	"github.com/xformerfhs/z85/gitbase85"
+	"io"
+	"strings"
+	"testing"
+)
+
+// ******** Private constants ********
+
+// gitLiteral contains the lines of a "literal 301" section that was created by "git show --binary".
+// The data is compressed with zlib before it is encoded.
+const gitLiteral = "zcmZQz=8|_R-t<4}u+{#c&x_)<m|xw0^jXNh>abM(r(&l0Ud*TFHx(Dv&pQ0ee$wZp\n" +
+	"z`&rDZ;*~yM+P~}Yt^Y>Fhvd7MyW|fQ8~wj^c-Q_*pOxZQF=yRR`aH@0*Wp?9Ma50?\n" +
+	"qPceJVXDa?wFLk)eUg-0q`>&X_;un1m+HZ9@>i?!<CwZ=+WIq6h$eSww\n"
+
+// gitLiteralSize is the size of the uncompressed data in gitLiteral.
+const gitLiteralSize = 301
+
+// ******** Test functions ********
+
+// TestDecodeGitOutput tests if the output of Git is decoded correctly.
+func TestDecodeGitOutput(t *testing.T) {
+	compressed, err := gitbase85.Decode(gitLiteral)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatalf(`Decoded data is not zlib compressed: %v`, err)
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf(`Decompression failed: %v`, err)
+	}
+
+	if !bytes.Equal(data, gitLiteralData()) {
+		t.Fatalf(`Decoded data is '% 02x'`, data)
+	}
+
+	if gitbase85.Encode(compressed) != gitLiteral {
+		t.Fatalf(`Encoding is '%s', but should be '%s'`, gitbase85.Encode(compressed), gitLiteral)
+	}
+}
+
+// TestRoundTrip tests if data of different lengths survives encoding and decoding.
+func TestRoundTrip(t *testing.T) {
+	for _, size := range []int{0, 1, 3, 4, 26, 27, 51, 52, 53, 104, 105, 1000} {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		encoded := gitbase85.Encode(data)
+		if strings.Count(encoded, "\n") != (size+gitbase85.MaxLineBytes-1)/gitbase85.MaxLineBytes {
+			t.Fatalf(`Encoding of size %d has a wrong number of lines: '%s'`, size, encoded)
+		}
+
+		decoded, err := gitbase85.Decode(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoding of size %d is '% 02x', but should be '% 02x'`, size, decoded, data)
+		}
+	}
+}
+
+// TestDecodeInvalid tests if invalid lines are rejected with their line number.
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		input string
+		line  int
+	}{
+		{"\n", 1},
+		{"A\n", 1},
+		{"B0000\n", 1},
+		{"E00000\n", 1},
+		{"D00000\n1", 2},
+		{"D00000\nD0000\"\n", 2},
+		{"D|NsC1\n", 1},
+	}
+
+	for _, test := range tests {
+		_, err := gitbase85.Decode(test.input)
+		if err != gitbase85.ErrCorruptLine(test.line) {
+			t.Fatalf(`Error for %q is '%v', but should be for line %d`, test.input, err, test.line)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// gitLiteralData returns the uncompressed data of gitLiteral.
+func gitLiteralData() []byte {
+	result := make([]byte, gitLiteralSize)
+	for i := 1; i < gitLiteralSize; i++ {
+		result[i] = byte(((i-1)*(i-1)*7 + 3) % 256)
+	}
+
+	return result
+}
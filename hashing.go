@@ -0,0 +1,85 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"hash"
+	"io"
+)
+
+// ******** Public types ********
+
+// HashingEncoder encodes the data written to it and feeds the same data to a hash in a single pass.
+// This way large data does not need to be read twice to get both the encoding and a checksum.
+type HashingEncoder struct {
+	encoder *encoder
+	hash    hash.Hash
+	sum     []byte
+}
+
+// ******** Public creation functions ********
+
+// NewHashingEncoder creates a new HashingEncoder that writes the Z85 encoding to w
+// and feeds the raw data to h.
+// The length of the data written must be a multiple of 4.
+func NewHashingEncoder(w io.Writer, h hash.Hash) *HashingEncoder {
+	return &HashingEncoder{
+		encoder: newEncoder(StdEncoding, w),
+		hash:    h,
+	}
+}
+
+// ******** Public functions ********
+
+// Write encodes p and adds it to the hash.
+// Only the bytes that were accepted by the encoder are added to the hash.
+func (he *HashingEncoder) Write(p []byte) (int, error) {
+	n, err := he.encoder.Write(p)
+	he.hash.Write(p[:n])
+
+	return n, err
+}
+
+// Close finishes the encoding and computes the digest.
+// It returns an error, if the length of the data written is not a multiple of 4.
+// It does not close the underlying writer.
+func (he *HashingEncoder) Close() error {
+	err := he.encoder.Close()
+	if he.sum == nil {
+		he.sum = he.hash.Sum(nil)
+	}
+
+	return err
+}
+
+// Sum returns the digest of the data written.
+// It returns nil, if Close has not been called.
+func (he *HashingEncoder) Sum() []byte {
+	return he.sum
+}
@@ -0,0 +1,105 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"github.com/xformerfhs/z85"
+	"strings"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestHashingEncoder tests if the HashingEncoder produces the same encoding and digest as separate passes.
+func TestHashingEncoder(t *testing.T) {
+	data := make([]byte, 10000)
+	_, _ = crand.Read(data)
+
+	var out strings.Builder
+	he := z85.NewHashingEncoder(&out, sha256.New())
+
+	// Writing 7 bytes at a time splits chunks between writes.
+	for i := 0; i < len(data); i += 7 {
+		_, err := he.Write(data[i:min(i+7, len(data))])
+		if err != nil {
+			t.Fatalf(`Write failed: %v`, err)
+		}
+	}
+
+	if he.Sum() != nil {
+		t.Fatal(`Sum is not nil before Close`)
+	}
+
+	err := he.Close()
+	if err != nil {
+		t.Fatalf(`Close failed: %v`, err)
+	}
+
+	expected, _ := z85.Encode(data)
+	if out.String() != expected {
+		t.Fatal(`Encoding differs from encoding of slice`)
+	}
+
+	expectedSum := sha256.Sum256(data)
+	if !bytes.Equal(he.Sum(), expectedSum[:]) {
+		t.Fatalf(`Digest is '% 02x', but should be '% 02x'`, he.Sum(), expectedSum)
+	}
+}
+
+// TestHashingEncoderPartialChunk tests if Close returns an error for a trailing partial chunk.
+func TestHashingEncoderPartialChunk(t *testing.T) {
+	var out strings.Builder
+	he := z85.NewHashingEncoder(&out, sha256.New())
+
+	_, _ = he.Write(clearTheOne)
+	_, _ = he.Write([]byte{1, 2, 3})
+
+	err := he.Close()
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	var codecErr *z85.CodecError
+	if errors.As(err, &codecErr) && codecErr.RawOffset != int64(len(clearTheOne)) {
+		t.Fatalf(`Raw offset is %d, but should be %d`, codecErr.RawOffset, len(clearTheOne))
+	}
+
+	if out.String() != encodedTheOne {
+		t.Fatalf(`Encoding is '%s', but should be '%s'`, out.String(), encodedTheOne)
+	}
+
+	_, err = he.Write(clearTheOne)
+	if err == nil {
+		t.Fatal(`Write after Close did not fail`)
+	}
+}
@@ -0,0 +1,77 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"strings"
+)
+
+// ******** Private variables ********
+
+// htmlUnescaper replaces the HTML entities of the Z85 characters that are escaped in HTML.
+// Only entities with a terminating semicolon are replaced, as ';' is not part of the Z85 alphabet
+// and so an entity can never be confused with a valid Z85 sequence.
+var htmlUnescaper = strings.NewReplacer(
+	`&amp;`, `&`,
+	`&lt;`, `<`,
+	`&gt;`, `>`,
+	`&#38;`, `&`,
+	`&#60;`, `<`,
+	`&#62;`, `>`,
+	`&#x26;`, `&`,
+	`&#x3c;`, `<`,
+	`&#x3C;`, `<`,
+	`&#x3e;`, `>`,
+	`&#x3E;`, `>`,
+	`&#X26;`, `&`,
+	`&#X3c;`, `<`,
+	`&#X3C;`, `<`,
+	`&#X3e;`, `>`,
+	`&#X3E;`, `>`,
+)
+
+// ******** Public functions ********
+
+// UnescapeHTML reverses the HTML escaping of the characters '&', '<' and '>'.
+// It replaces the named entities "&amp;", "&lt;" and "&gt;" and the corresponding numeric entities.
+// Each entity is replaced only once, so "&amp;lt;" becomes "&lt;".
+func UnescapeHTML(source string) string {
+	if strings.IndexByte(source, '&') < 0 {
+		return source
+	}
+
+	return htmlUnescaper.Replace(source)
+}
+
+// DecodeHTML decodes a Z85 string that may contain HTML entities,
+// as it is found in web pages or HTML emails.
+// The offsets in a returned error refer to the string after the entities have been replaced.
+func DecodeHTML(source string) ([]byte, error) {
+	return Decode(UnescapeHTML(source))
+}
@@ -0,0 +1,81 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestUnescapeHTML tests if the HTML entities of the Z85 characters are replaced.
+func TestUnescapeHTML(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{``, ``},
+		{`HelloWorld`, `HelloWorld`},
+		{`Hello&lt;&gt;&amp;ab`, `Hello<>&ab`},
+		{`Hello&#60;&#62;&#38;ab`, `Hello<>&ab`},
+		{`Hello&#x3C;&#x3e;&#x26;ab`, `Hello<>&ab`},
+		{`&amp;lt;`, `&lt;`},
+		{`&lt&gt&amp`, `&lt&gt&amp`},
+		{`&quot;`, `&quot;`},
+	}
+
+	for _, test := range tests {
+		result := z85.UnescapeHTML(test.input)
+		if result != test.expected {
+			t.Fatalf(`Unescaping '%s' yields '%s', but should yield '%s'`, test.input, result, test.expected)
+		}
+	}
+}
+
+// TestDecodeHTML tests if an HTML escaped encoding is decoded.
+func TestDecodeHTML(t *testing.T) {
+	expected := []byte{0x86, 0x4f, 0xd2, 0x6f, 0xe5, 0xdf, 0x3a, 0x60}
+
+	decoded, err := z85.DecodeHTML(`Hello&lt;&gt;&amp;ab`)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, expected) {
+		t.Fatalf(`Decoded data is '% 02x', but should be '% 02x'`, decoded, expected)
+	}
+
+	_, err = z85.DecodeHTML(`Hello&quot;ab`)
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+}
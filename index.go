@@ -0,0 +1,203 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+)
+
+// ******** Private constants ********
+
+// minTranscodeLen is the minimum length of a pattern that contains a complete chunk at every alignment.
+// Shorter patterns are searched by decoding the encoded data block by block.
+const minTranscodeLen = 2*byteChunkSize - 1
+
+// scanBlockChunks is the number of chunks that are decoded at once when the encoded data is scanned.
+const scanBlockChunks = 1024
+
+// ******** Public types ********
+
+// DecodedIndex is a read-only view of Z85 encoded data that can be searched for raw byte patterns
+// without decoding the data.
+// Instead, the pattern is encoded at all 4 possible chunk alignments and searched in the encoded data.
+// Only the chunks at the edges of a match are decoded to verify it.
+// A DecodedIndex is safe for concurrent use.
+type DecodedIndex struct {
+	encoded string
+}
+
+// ******** Public creation functions ********
+
+// NewDecodedIndex creates a new DecodedIndex for the encoded data.
+// The data is checked once, so the searches can not fail.
+func NewDecodedIndex(encoded string) (*DecodedIndex, error) {
+	err := Validate(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DecodedIndex{encoded: encoded}, nil
+}
+
+// ******** Public functions ********
+
+// Len returns the length of the decoded data.
+func (di *DecodedIndex) Len() int {
+	return len(di.encoded) / encodedChunkSize * byteChunkSize
+}
+
+// IndexOf returns the offset of the first occurrence of pattern in the decoded data,
+// or -1, if pattern is not present.
+func (di *DecodedIndex) IndexOf(pattern []byte) int {
+	if len(pattern) < minTranscodeLen {
+		return di.scan(pattern)
+	}
+
+	result := -1
+	for headLen := 0; headLen < byteChunkSize; headLen++ {
+		index := di.indexAligned(pattern, headLen)
+		if index >= 0 && (result < 0 || index < result) {
+			result = index
+		}
+	}
+
+	return result
+}
+
+// Contains reports whether pattern is present in the decoded data.
+func (di *DecodedIndex) Contains(pattern []byte) bool {
+	return di.IndexOf(pattern) >= 0
+}
+
+// ******** Private functions ********
+
+// indexAligned returns the offset of the first occurrence of pattern, whose first headLen bytes
+// are the end of a chunk, or -1, if there is no such occurrence.
+func (di *DecodedIndex) indexAligned(pattern []byte, headLen int) int {
+	innerLen := (len(pattern) - headLen) &^ byteChunkMask
+	head := pattern[:headLen]
+	tail := pattern[headLen+innerLen:]
+
+	// The inner length is a multiple of 4, so the encoding can not fail.
+	encodedInner, _ := Encode(pattern[headLen : headLen+innerLen])
+
+	// The head needs a chunk before the inner chunks.
+	from := 0
+	if headLen > 0 {
+		from = encodedChunkSize
+	}
+
+	for from < len(di.encoded) {
+		index := strings.Index(di.encoded[from:], encodedInner)
+		if index < 0 {
+			return -1
+		}
+
+		position := from + index
+		misalignment := position % encodedChunkSize
+		if misalignment != 0 {
+			from = position - misalignment + encodedChunkSize
+			continue
+		}
+
+		if di.matchHead(position, head) && di.matchTail(position+len(encodedInner), tail) {
+			return position/encodedChunkSize*byteChunkSize - headLen
+		}
+
+		from = position + encodedChunkSize
+	}
+
+	return -1
+}
+
+// matchHead reports whether head is the end of the chunk before the encoded position.
+func (di *DecodedIndex) matchHead(position int, head []byte) bool {
+	if len(head) == 0 {
+		return true
+	}
+
+	chunk := di.chunkAt(position - encodedChunkSize)
+
+	return bytes.Equal(chunk[byteChunkSize-len(head):], head)
+}
+
+// matchTail reports whether tail is the start of the chunk at the encoded position.
+func (di *DecodedIndex) matchTail(position int, tail []byte) bool {
+	if len(tail) == 0 {
+		return true
+	}
+
+	if position+encodedChunkSize > len(di.encoded) {
+		return false
+	}
+
+	chunk := di.chunkAt(position)
+
+	return bytes.Equal(chunk[:len(tail)], tail)
+}
+
+// chunkAt decodes the chunk at the encoded position.
+func (di *DecodedIndex) chunkAt(position int) [byteChunkSize]byte {
+	var result [byteChunkSize]byte
+
+	// The encoded data has been checked, so decoding can not fail.
+	value, _ := StdEncoding.decodeChunkValue(di.encoded[position:position+encodedChunkSize], uint(position))
+	binary.BigEndian.PutUint32(result[:], value)
+
+	return result
+}
+
+// scan searches pattern by decoding the encoded data block by block.
+func (di *DecodedIndex) scan(pattern []byte) int {
+	if len(pattern) == 0 {
+		return 0
+	}
+
+	overlap := len(pattern) - 1
+	buffer := make([]byte, 0, scanBlockChunks*byteChunkSize+overlap)
+	bufferOffset := 0
+	for position := 0; position < len(di.encoded); position += scanBlockChunks * encodedChunkSize {
+		// Keep the end of the previous block, as the pattern may span two blocks.
+		keep := min(len(buffer), overlap)
+		bufferOffset += len(buffer) - keep
+		buffer = buffer[:copy(buffer, buffer[len(buffer)-keep:])]
+
+		end := min(position+scanBlockChunks*encodedChunkSize, len(di.encoded))
+		buffer, _ = StdEncoding.AppendDecode(buffer, di.encoded[position:end])
+
+		index := bytes.Index(buffer, pattern)
+		if index >= 0 {
+			return bufferOffset + index
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,132 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestDecodedIndexIndexOf tests if IndexOf finds the same offsets as a search in the decoded data.
+func TestDecodedIndexIndexOf(t *testing.T) {
+	for i := 0; i < iterationCount; i++ {
+		raw := make([]byte, (rand.Intn(maxSliceSize)+1)<<2)
+		for i := range raw {
+			// Use a small set of values, so patterns occur more than once.
+			raw[i] = byte(rand.Intn(4))
+		}
+
+		encoded, _ := z85.Encode(raw)
+		index, err := z85.NewDecodedIndex(encoded)
+		if err != nil {
+			t.Fatalf(`NewDecodedIndex failed: %v`, err)
+		}
+
+		if index.Len() != len(raw) {
+			t.Fatalf(`Len returned %d instead of %d`, index.Len(), len(raw))
+		}
+
+		start := rand.Intn(len(raw))
+		end := start + rand.Intn(min(len(raw)-start, 16)+1)
+		pattern := raw[start:end]
+
+		got := index.IndexOf(pattern)
+		expected := bytes.Index(raw, pattern)
+		if got != expected {
+			t.Fatalf(`IndexOf '% 02x' returned %d instead of %d`, pattern, got, expected)
+		}
+
+		if !index.Contains(pattern) {
+			t.Fatalf(`Contains '% 02x' returned false`, pattern)
+		}
+	}
+}
+
+// TestDecodedIndexNotFound tests if patterns that differ only at the edges are not found.
+func TestDecodedIndexNotFound(t *testing.T) {
+	index, _ := z85.NewDecodedIndex(encodedTheOne)
+
+	for _, pattern := range [][]byte{
+		{0x86, 0x4f, 0xd2, 0x6f, 0xb5, 0x59, 0xf7, 0x5c},
+		{0x4f, 0xd2, 0x6f, 0xb5, 0x59, 0xf7, 0x5b, 0x00},
+		{0x00, 0x86, 0x4f, 0xd2, 0x6f, 0xb5, 0x59},
+		{0x5b, 0x86},
+		clearTheOne[:len(clearTheOne)-1],
+	} {
+		expected := bytes.Index(clearTheOne, pattern)
+		got := index.IndexOf(pattern)
+		if got != expected {
+			t.Fatalf(`IndexOf '% 02x' returned %d instead of %d`, pattern, got, expected)
+		}
+	}
+}
+
+// TestDecodedIndexLongData tests searches in data that is longer than a scan block.
+func TestDecodedIndexLongData(t *testing.T) {
+	// The data is longer than a scan block.
+	raw := make([]byte, 5000<<2)
+	copy(raw[len(raw)-6:], []byte{1, 2, 3, 4, 5, 6})
+	copy(raw[4095:], []byte{7, 8})
+
+	encoded, _ := z85.Encode(raw)
+	index, _ := z85.NewDecodedIndex(encoded)
+
+	for _, tc := range []struct {
+		pattern  []byte
+		expected int
+	}{
+		{[]byte{1, 2, 3, 4, 5, 6}, len(raw) - 6},
+		{[]byte{0, 1, 2, 3, 4, 5, 6}, len(raw) - 7},
+		{[]byte{7, 8}, 4095},
+		{[]byte{6, 7}, -1},
+	} {
+		got := index.IndexOf(tc.pattern)
+		if got != tc.expected {
+			t.Fatalf(`IndexOf '% 02x' returned %d instead of %d`, tc.pattern, got, tc.expected)
+		}
+	}
+}
+
+// TestDecodedIndexInvalid tests if invalid encoded data is rejected.
+func TestDecodedIndexInvalid(t *testing.T) {
+	_, err := z85.NewDecodedIndex(`Hello`[:4])
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Invalid length not detected: %v`, err)
+	}
+
+	_, err = z85.NewDecodedIndex(`Hell~`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Invalid byte not detected: %v`, err)
+	}
+}
@@ -0,0 +1,55 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Package cpu detects the processor features that the accelerated code paths of the z85 package need.
+// The detection runs once, when the package is initialized.
+package cpu
+
+// ******** Public variables ********
+
+// X86 contains the features of amd64 processors.
+// All fields are false on other architectures.
+var X86 struct {
+	// HasAVX is true, if the processor supports AVX and the operating system saves the AVX registers.
+	HasAVX bool
+	// HasAVX2 is true, if the processor supports AVX2 and the operating system saves the AVX registers.
+	HasAVX2 bool
+}
+
+// ARM64 contains the features of arm64 processors.
+// All fields are false on other architectures.
+var ARM64 struct {
+	// HasASIMD is true, if the processor supports the Advanced SIMD (NEON) instructions.
+	HasASIMD bool
+}
+
+// ******** Private functions ********
+
+func init() {
+	detect()
+}
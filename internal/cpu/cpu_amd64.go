@@ -0,0 +1,81 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+//go:build amd64
+
+package cpu
+
+// ******** Private constants ********
+
+// CPUID and XGETBV bits that are needed for AVX and AVX2.
+const (
+	cpuidOSXSAVE = 1 << 27
+	cpuidAVX     = 1 << 28
+	cpuidAVX2    = 1 << 5
+	xcrSSEAndAVX = 0b110
+)
+
+// ******** Private functions ********
+
+// detect sets the features of the processor.
+// A program that is built with GOAMD64=v3 or higher only runs on processors with AVX2,
+// so no detection is needed in this case.
+func detect() {
+	if avx2Guaranteed {
+		X86.HasAVX = true
+		X86.HasAVX2 = true
+		return
+	}
+
+	maxLeaf, _, _, _ := cpuid(0, 0)
+
+	_, _, ecx1, _ := cpuid(1, 0)
+	if ecx1&(cpuidOSXSAVE|cpuidAVX) != cpuidOSXSAVE|cpuidAVX {
+		return
+	}
+
+	xcr0, _ := xgetbv()
+	if xcr0&xcrSSEAndAVX != xcrSSEAndAVX {
+		return
+	}
+
+	X86.HasAVX = true
+
+	if maxLeaf >= 7 {
+		_, ebx7, _, _ := cpuid(7, 0)
+		X86.HasAVX2 = ebx7&cpuidAVX2 != 0
+	}
+}
+
+// ******** Assembler functions ********
+
+// cpuid executes the CPUID instruction with the given leaf and sub-leaf.
+func cpuid(leaf uint32, subLeaf uint32) (eax uint32, ebx uint32, ecx uint32, edx uint32)
+
+// xgetbv reads the extended control register 0.
+func xgetbv() (eax uint32, edx uint32)
@@ -0,0 +1,53 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package cpu_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/xformerfhs/z85/internal/cpu"
+)
+
+// ******** Test functions ********
+
+// TestFeatures tests if the detected features are consistent with the architecture.
+func TestFeatures(t *testing.T) {
+	if cpu.X86.HasAVX2 && !cpu.X86.HasAVX {
+		t.Fatal(`AVX2 is detected without AVX`)
+	}
+
+	if runtime.GOARCH != `amd64` && (cpu.X86.HasAVX || cpu.X86.HasAVX2) {
+		t.Fatalf(`x86 features are detected on %s`, runtime.GOARCH)
+	}
+
+	if (runtime.GOARCH == `arm64`) != cpu.ARM64.HasASIMD {
+		t.Fatalf(`Advanced SIMD detection is wrong on %s`, runtime.GOARCH)
+	}
+}
@@ -0,0 +1,661 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Package qr encodes data as QR codes of the model 2 of ISO/IEC 18004 in byte mode.
+// It implements only the parts of the standard that the z85 command needs to print encoded keys.
+package qr
+
+import "errors"
+
+// ******** Public types ********
+
+// Level is the error correction level of a QR code.
+type Level int
+
+// Error correction levels.
+const (
+	// Low restores about 7% of the code.
+	Low Level = iota
+	// Medium restores about 15% of the code.
+	Medium
+	// Quartile restores about 25% of the code.
+	Quartile
+	// High restores about 30% of the code.
+	High
+)
+
+// Code is a QR code.
+type Code struct {
+	// Version is the version of the code between 1 and 40.
+	Version int
+	// Size is the number of modules in each row and column.
+	Size int
+
+	modules    []bool
+	isFunction []bool
+}
+
+// ******** Public variables ********
+
+// ErrTooLarge is returned when the data does not fit into a QR code of the largest version.
+var ErrTooLarge = errors.New(`qr: data too large for a QR code`)
+
+// ******** Private constants ********
+
+// Versions of the QR codes.
+const (
+	minVersion = 1
+	maxVersion = 40
+)
+
+// modeByte is the mode indicator of the byte mode.
+const modeByte = 0b0100
+
+// Pad codewords that fill the data capacity.
+const (
+	padFirst  = 0xec
+	padSecond = 0x11
+)
+
+// Polynomials and masks of the BCH codes of the format and version information.
+const (
+	formatPolynomial  = 0x537
+	formatMask        = 0x5412
+	versionPolynomial = 0x1f25
+)
+
+// fieldPolynomial is the reduction polynomial of the Galois field GF(256) of the Reed-Solomon codes.
+const fieldPolynomial = 0x11d
+
+// maskCount is the number of mask patterns.
+const maskCount = 8
+
+// Weights of the penalty rules that select the mask.
+const (
+	penaltyRun     = 3
+	penaltyBlock   = 3
+	penaltyFinder  = 40
+	penaltyBalance = 10
+)
+
+// ******** Private variables ********
+
+// formatLevelBits are the bits of the error correction levels in the format information.
+var formatLevelBits = [...]int{Low: 0b01, Medium: 0b00, Quartile: 0b11, High: 0b10}
+
+// eccCodewordsPerBlock contains the number of error correction codewords of each block, indexed by level and version.
+var eccCodewordsPerBlock = [...][maxVersion + 1]int{
+	Low:      {-1, 7, 10, 15, 20, 26, 18, 20, 24, 30, 18, 20, 24, 26, 30, 22, 24, 28, 30, 28, 28, 28, 28, 30, 30, 26, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	Medium:   {-1, 10, 16, 26, 18, 24, 16, 18, 22, 22, 26, 30, 22, 22, 24, 24, 28, 28, 26, 26, 26, 26, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+	Quartile: {-1, 13, 22, 18, 26, 18, 24, 18, 22, 20, 24, 28, 26, 24, 20, 30, 24, 28, 28, 26, 30, 28, 30, 30, 30, 30, 28, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+	High:     {-1, 17, 28, 22, 16, 22, 28, 26, 26, 24, 28, 24, 28, 22, 24, 24, 30, 28, 28, 26, 28, 30, 24, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
+}
+
+// errorCorrectionBlocks contains the number of error correction blocks, indexed by level and version.
+var errorCorrectionBlocks = [...][maxVersion + 1]int{
+	Low:      {-1, 1, 1, 1, 1, 1, 2, 2, 2, 2, 4, 4, 4, 4, 4, 6, 6, 6, 6, 7, 8, 8, 9, 9, 10, 12, 12, 12, 13, 14, 15, 16, 17, 18, 19, 19, 20, 21, 22, 24, 25},
+	Medium:   {-1, 1, 1, 1, 2, 2, 4, 4, 4, 5, 5, 5, 8, 9, 9, 10, 10, 11, 13, 14, 16, 17, 17, 18, 20, 21, 23, 25, 26, 28, 29, 31, 33, 35, 37, 38, 40, 43, 45, 47, 49},
+	Quartile: {-1, 1, 1, 2, 2, 4, 4, 6, 6, 8, 8, 8, 10, 12, 16, 12, 17, 16, 18, 21, 20, 23, 23, 25, 27, 29, 34, 34, 35, 38, 40, 43, 45, 48, 51, 53, 56, 59, 62, 65, 68},
+	High:     {-1, 1, 1, 2, 4, 4, 4, 5, 6, 8, 8, 11, 11, 16, 16, 18, 16, 19, 21, 25, 25, 25, 34, 30, 32, 35, 37, 40, 42, 45, 48, 51, 54, 57, 60, 63, 66, 70, 74, 77, 81},
+}
+
+// finderLike is the pattern of a finder pattern that is followed or preceded by four light modules.
+var finderLike = [2][11]bool{
+	{true, false, true, true, true, false, true, false, false, false, false},
+	{false, false, false, false, true, false, true, true, true, false, true},
+}
+
+// ******** Private types ********
+
+// bitWriter collects bits in bytes, the most significant bit first.
+type bitWriter struct {
+	bytes  []byte
+	length int
+}
+
+// ******** Public functions ********
+
+// Encode encodes data in byte mode as a QR code with the error correction level.
+// It selects the smallest version the data fits into and the mask with the lowest penalty.
+func Encode(data []byte, level Level) (*Code, error) {
+	version := minVersion
+	for ; version <= maxVersion; version++ {
+		if dataBits(len(data), version) <= dataCodewords(version, level)*8 {
+			break
+		}
+	}
+
+	if version > maxVersion {
+		return nil, ErrTooLarge
+	}
+
+	c := newCode(version)
+	c.drawFunctionPatterns(level)
+	c.drawCodewords(c.addErrorCorrection(c.segment(data, level), level))
+
+	bestMask := 0
+	bestPenalty := -1
+	for mask := 0; mask < maskCount; mask++ {
+		c.applyMask(mask)
+		c.drawFormatBits(level, mask)
+		if penalty := c.penalty(); bestPenalty < 0 || penalty < bestPenalty {
+			bestMask = mask
+			bestPenalty = penalty
+		}
+
+		// Applying a mask a second time removes it.
+		c.applyMask(mask)
+	}
+
+	c.applyMask(bestMask)
+	c.drawFormatBits(level, bestMask)
+
+	return c, nil
+}
+
+// MaxLen returns the maximum number of bytes that a QR code with the error correction level can contain.
+func MaxLen(level Level) int {
+	return (dataCodewords(maxVersion, level)*8 - dataBits(0, maxVersion)) / 8
+}
+
+// Dark reports whether the module in column x and row y is dark.
+// Modules outside the code are light.
+func (c *Code) Dark(x int, y int) bool {
+	if x < 0 || y < 0 || x >= c.Size || y >= c.Size {
+		return false
+	}
+
+	return c.modules[y*c.Size+x]
+}
+
+// ******** Private creation functions ********
+
+// newCode creates a new light code of the version.
+func newCode(version int) *Code {
+	size := version*4 + 17
+
+	return &Code{
+		Version:    version,
+		Size:       size,
+		modules:    make([]bool, size*size),
+		isFunction: make([]bool, size*size),
+	}
+}
+
+// ******** Private functions ********
+
+// countBits returns the number of bits of the character count in byte mode.
+func countBits(version int) int {
+	if version < 10 {
+		return 8
+	}
+
+	return 16
+}
+
+// dataBits returns the number of bits of a byte mode segment with n bytes.
+func dataBits(n int, version int) int {
+	return 4 + countBits(version) + n*8
+}
+
+// rawDataModules returns the number of modules of the version that contain data or error correction codewords.
+func rawDataModules(version int) int {
+	result := (16*version+128)*version + 64
+	if version >= 2 {
+		alignments := version/7 + 2
+		result -= (25*alignments-10)*alignments - 55
+		if version >= 7 {
+			// Version information.
+			result -= 36
+		}
+	}
+
+	return result
+}
+
+// dataCodewords returns the number of data codewords of the version and the error correction level.
+func dataCodewords(version int, level Level) int {
+	return rawDataModules(version)/8 - eccCodewordsPerBlock[level][version]*errorCorrectionBlocks[level][version]
+}
+
+// alignmentPositions returns the centre coordinates of the alignment patterns of the version.
+func alignmentPositions(version int) []int {
+	if version == 1 {
+		return nil
+	}
+
+	count := version/7 + 2
+	step := (version*8 + count*3 + 5) / (count*4 - 4) * 2
+	result := make([]int, count)
+	result[0] = 6
+	for i, position := count-1, version*4+10; i > 0; i, position = i-1, position-step {
+		result[i] = position
+	}
+
+	return result
+}
+
+// set sets the module in column x and row y.
+func (c *Code) set(x int, y int, dark bool) {
+	c.modules[y*c.Size+x] = dark
+}
+
+// setFunction sets the module in column x and row y and marks it as part of a function pattern.
+func (c *Code) setFunction(x int, y int, dark bool) {
+	c.set(x, y, dark)
+	c.isFunction[y*c.Size+x] = true
+}
+
+// drawFunctionPatterns draws the finder, timing and alignment patterns and reserves the format and version areas.
+func (c *Code) drawFunctionPatterns(level Level) {
+	for i := 0; i < c.Size; i++ {
+		c.setFunction(6, i, i%2 == 0)
+		c.setFunction(i, 6, i%2 == 0)
+	}
+
+	c.drawFinder(3, 3)
+	c.drawFinder(c.Size-4, 3)
+	c.drawFinder(3, c.Size-4)
+
+	positions := alignmentPositions(c.Version)
+	last := len(positions) - 1
+	for i, x := range positions {
+		for j, y := range positions {
+			// The finder patterns take the place of three corners.
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+
+			c.drawAlignment(x, y)
+		}
+	}
+
+	// The format bits are drawn with a mask later, but the area has to be reserved now.
+	c.drawFormatBits(level, 0)
+	c.drawVersion()
+}
+
+// drawFinder draws a finder pattern with its separator around the centre x, y.
+func (c *Code) drawFinder(x int, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			xx, yy := x+dx, y+dy
+			if xx < 0 || yy < 0 || xx >= c.Size || yy >= c.Size {
+				continue
+			}
+
+			distance := max(abs(dx), abs(dy))
+			c.setFunction(xx, yy, distance != 2 && distance != 4)
+		}
+	}
+}
+
+// drawAlignment draws an alignment pattern around the centre x, y.
+func (c *Code) drawAlignment(x int, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+// formatBits returns the 15 bits of the format information of the error correction level and the mask.
+func formatBits(level Level, mask int) int {
+	data := formatLevelBits[level]<<3 | mask
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = remainder<<1 ^ (remainder>>9)*formatPolynomial
+	}
+
+	return (data<<10 | remainder) ^ formatMask
+}
+
+// versionBits returns the 18 bits of the version information.
+func versionBits(version int) int {
+	remainder := version
+	for i := 0; i < 12; i++ {
+		remainder = remainder<<1 ^ (remainder>>11)*versionPolynomial
+	}
+
+	return version<<12 | remainder
+}
+
+// drawFormatBits draws both copies of the format information.
+func (c *Code) drawFormatBits(level Level, mask int) {
+	bits := formatBits(level, mask)
+
+	// The copy around the top left finder pattern.
+	for i := 0; i < 6; i++ {
+		c.setFunction(8, i, bit(bits, i))
+	}
+
+	c.setFunction(8, 7, bit(bits, 6))
+	c.setFunction(8, 8, bit(bits, 7))
+	c.setFunction(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		c.setFunction(14-i, 8, bit(bits, i))
+	}
+
+	// The copy that is split between the other finder patterns.
+	for i := 0; i < 8; i++ {
+		c.setFunction(c.Size-1-i, 8, bit(bits, i))
+	}
+
+	for i := 8; i < 15; i++ {
+		c.setFunction(8, c.Size-15+i, bit(bits, i))
+	}
+
+	// The dark module is always dark.
+	c.setFunction(8, c.Size-8, true)
+}
+
+// drawVersion draws both copies of the version information of versions 7 and higher.
+func (c *Code) drawVersion() {
+	if c.Version < 7 {
+		return
+	}
+
+	bits := versionBits(c.Version)
+	for i := 0; i < 18; i++ {
+		a := c.Size - 11 + i%3
+		b := i / 3
+		c.setFunction(a, b, bit(bits, i))
+		c.setFunction(b, a, bit(bits, i))
+	}
+}
+
+// segment returns the byte mode segment of data with terminator and pad codewords that fill the capacity of the code.
+func (c *Code) segment(data []byte, level Level) []byte {
+	capacity := dataCodewords(c.Version, level)
+	result := make([]byte, 0, capacity)
+
+	// The mode indicator and the character count are not aligned to bytes, so the data is shifted by 4 bits.
+	var w bitWriter
+	w.write(modeByte, 4)
+	w.write(len(data), countBits(c.Version))
+	for _, b := range data {
+		w.write(int(b), 8)
+	}
+
+	w.write(0, min(4, capacity*8-w.length))
+	w.write(0, (8-w.length%8)%8)
+	result = append(result, w.bytes...)
+
+	for pad := byte(padFirst); len(result) < capacity; pad ^= padFirst ^ padSecond {
+		result = append(result, pad)
+	}
+
+	return result
+}
+
+// addErrorCorrection splits data into blocks, appends their error correction codewords and interleaves them.
+func (c *Code) addErrorCorrection(data []byte, level Level) []byte {
+	blockCount := errorCorrectionBlocks[level][c.Version]
+	eccLen := eccCodewordsPerBlock[level][c.Version]
+	rawCodewords := rawDataModules(c.Version) / 8
+	shortBlocks := blockCount - rawCodewords%blockCount
+	shortBlockLen := rawCodewords / blockCount
+
+	divisor := reedSolomonDivisor(eccLen)
+	blocks := make([][]byte, blockCount)
+	for i, offset := 0, 0; i < blockCount; i++ {
+		dataLen := shortBlockLen - eccLen
+		if i >= shortBlocks {
+			dataLen++
+		}
+
+		block := make([]byte, 0, shortBlockLen+1)
+		block = append(block, data[offset:offset+dataLen]...)
+		offset += dataLen
+		ecc := reedSolomonRemainder(block, divisor)
+		if i < shortBlocks {
+			// A placeholder that aligns the error correction codewords of short and long blocks.
+			block = append(block, 0)
+		}
+
+		blocks[i] = append(block, ecc...)
+	}
+
+	result := make([]byte, 0, rawCodewords)
+	for i := 0; i <= shortBlockLen; i++ {
+		for j, block := range blocks {
+			if i != shortBlockLen-eccLen || j >= shortBlocks {
+				result = append(result, block[i])
+			}
+		}
+	}
+
+	return result
+}
+
+// drawCodewords draws the codewords in the zigzag order of the standard into the modules that are no function patterns.
+func (c *Code) drawCodewords(codewords []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		// The vertical timing pattern is skipped.
+		if right == 6 {
+			right = 5
+		}
+
+		upward := (right+1)&2 == 0
+		for vertical := 0; vertical < c.Size; vertical++ {
+			y := vertical
+			if upward {
+				y = c.Size - 1 - vertical
+			}
+
+			for j := 0; j < 2; j++ {
+				x := right - j
+				if !c.isFunction[y*c.Size+x] && i < len(codewords)*8 {
+					c.set(x, y, codewords[i>>3]>>(7-i&7)&1 != 0)
+					i++
+				}
+			}
+		}
+	}
+}
+
+// applyMask inverts the modules that are no function patterns where the mask pattern is true.
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if !c.isFunction[y*c.Size+x] && maskPattern(mask, x, y) {
+				c.modules[y*c.Size+x] = !c.modules[y*c.Size+x]
+			}
+		}
+	}
+}
+
+// maskPattern reports whether the mask pattern is true in column x and row y.
+func maskPattern(mask int, x int, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	default:
+		return ((x+y)%2+x*y%3)%2 == 0
+	}
+}
+
+// penalty returns the penalty of the code with the four rules of the standard.
+// The mask with the lowest penalty is the easiest to scan.
+func (c *Code) penalty() int {
+	result := 0
+
+	// Rule 1 and 3: runs of modules of the same colour and patterns that look like finder patterns.
+	for i := 0; i < c.Size; i++ {
+		result += c.linePenalty(func(j int) bool { return c.Dark(j, i) })
+		result += c.linePenalty(func(j int) bool { return c.Dark(i, j) })
+	}
+
+	// Rule 2: blocks of 2 x 2 modules of the same colour.
+	dark := 0
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			colour := c.Dark(x, y)
+			if colour {
+				dark++
+			}
+
+			if x+1 < c.Size && y+1 < c.Size &&
+				c.Dark(x+1, y) == colour && c.Dark(x, y+1) == colour && c.Dark(x+1, y+1) == colour {
+				result += penaltyBlock
+			}
+		}
+	}
+
+	// Rule 4: the deviation of the proportion of dark modules from 50% in steps of 5%.
+	total := c.Size * c.Size
+	result += abs(dark*20-total*10) / total * penaltyBalance
+
+	return result
+}
+
+// linePenalty returns the penalty of the rules 1 and 3 for the line whose modules dark returns.
+func (c *Code) linePenalty(dark func(int) bool) int {
+	result := 0
+
+	run := 1
+	for j := 1; j <= c.Size; j++ {
+		if j < c.Size && dark(j) == dark(j-1) {
+			run++
+			continue
+		}
+
+		if run >= 5 {
+			result += penaltyRun + run - 5
+		}
+
+		run = 1
+	}
+
+	for j := 0; j+len(finderLike[0]) <= c.Size; j++ {
+		for _, pattern := range finderLike {
+			matches := true
+			for k, colour := range pattern {
+				if dark(j+k) != colour {
+					matches = false
+					break
+				}
+			}
+
+			if matches {
+				result += penaltyFinder
+			}
+		}
+	}
+
+	return result
+}
+
+// reedSolomonDivisor returns the generator polynomial of the Reed-Solomon code with degree codewords,
+// without the leading coefficient 1.
+func reedSolomonDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = multiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+
+		root = multiply(root, 0x02)
+	}
+
+	return result
+}
+
+// reedSolomonRemainder returns the error correction codewords of data for the generator polynomial divisor.
+func reedSolomonRemainder(data []byte, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, d := range divisor {
+			result[i] ^= multiply(d, factor)
+		}
+	}
+
+	return result
+}
+
+// multiply returns the product of x and y in GF(256).
+func multiply(x byte, y byte) byte {
+	z := 0
+	for i := 7; i >= 0; i-- {
+		z = z<<1 ^ (z>>7)*fieldPolynomial
+		z ^= int(y>>i&1) * int(x)
+	}
+
+	return byte(z)
+}
+
+// write appends the n lowest bits of value.
+func (w *bitWriter) write(value int, n int) {
+	for i := n - 1; i >= 0; i-- {
+		if w.length%8 == 0 {
+			w.bytes = append(w.bytes, 0)
+		}
+
+		if bit(value, i) {
+			w.bytes[len(w.bytes)-1] |= 0x80 >> (w.length % 8)
+		}
+
+		w.length++
+	}
+}
+
+// bit reports whether bit i of x is set.
+func bit(x int, i int) bool {
+	return x>>i&1 != 0
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
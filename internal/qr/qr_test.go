@@ -0,0 +1,274 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package qr
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestReedSolomon tests the error correction codewords with the example of version 1-M from the standard.
+func TestReedSolomon(t *testing.T) {
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	expected := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	ecc := reedSolomonRemainder(data, reedSolomonDivisor(len(expected)))
+	if !bytes.Equal(ecc, expected) {
+		t.Fatalf(`Error correction codewords are not '% d', but '% d'`, expected, ecc)
+	}
+}
+
+// TestFormatAndVersionBits tests the BCH codes of the format and version information.
+func TestFormatAndVersionBits(t *testing.T) {
+	for _, test := range []struct {
+		level    Level
+		mask     int
+		expected int
+	}{
+		{Low, 0, 0b111011111000100},
+		{Low, 4, 0b110011000101111},
+		{Medium, 0, 0b101010000010010},
+		{Quartile, 0, 0b011010101011111},
+	} {
+		if bits := formatBits(test.level, test.mask); bits != test.expected {
+			t.Fatalf(`Format bits of level %d and mask %d are not %015b, but %015b`, test.level, test.mask, test.expected, bits)
+		}
+	}
+
+	for _, test := range []struct {
+		version  int
+		expected int
+	}{
+		{7, 0b000111110010010100},
+		{40, 0b101000110001101001},
+	} {
+		if bits := versionBits(test.version); bits != test.expected {
+			t.Fatalf(`Version bits of version %d are not %018b, but %018b`, test.version, test.expected, bits)
+		}
+	}
+}
+
+// TestCapacity tests the capacities and alignment pattern positions against the tables of the standard.
+func TestCapacity(t *testing.T) {
+	for _, test := range []struct {
+		version  int
+		level    Level
+		expected int
+	}{
+		{1, Low, 19},
+		{1, High, 9},
+		{5, Quartile, 62},
+		{10, Medium, 216},
+		{20, High, 385},
+		{40, Low, 2956},
+		{40, Medium, 2334},
+	} {
+		if n := dataCodewords(test.version, test.level); n != test.expected {
+			t.Fatalf(`Version %d with level %d has not %d, but %d data codewords`, test.version, test.level, test.expected, n)
+		}
+	}
+
+	if n := MaxLen(Medium); n != 2331 {
+		t.Fatalf(`Maximum length is not 2331, but %d`, n)
+	}
+
+	for _, test := range []struct {
+		version  int
+		expected []int
+	}{
+		{1, nil},
+		{2, []int{6, 18}},
+		{7, []int{6, 22, 38}},
+		{32, []int{6, 34, 60, 86, 112, 138}},
+		{40, []int{6, 30, 58, 86, 114, 142, 170}},
+	} {
+		if positions := alignmentPositions(test.version); !slices.Equal(positions, test.expected) {
+			t.Fatalf(`Alignment patterns of version %d are not at %v, but at %v`, test.version, test.expected, positions)
+		}
+	}
+}
+
+// TestEncode tests if the data can be read back from codes of all sizes.
+func TestEncode(t *testing.T) {
+	for _, n := range []int{0, 1, 14, 15, 100, 213, 214, 1000, 2331} {
+		data := make([]byte, n)
+		for i := range data {
+			data[i] = byte(i*7 + 3)
+		}
+
+		c, err := Encode(data, Medium)
+		if err != nil {
+			t.Fatalf(`Encoding of %d bytes failed: %v`, n, err)
+		}
+
+		if c.Size != c.Version*4+17 {
+			t.Fatalf(`Code of version %d has size %d`, c.Version, c.Size)
+		}
+
+		// The top left finder pattern is followed by its separator and the timing pattern alternates.
+		for i := 0; i < 7; i++ {
+			if !c.Dark(i, 0) || !c.Dark(0, i) || c.Dark(7, i) || c.Dark(i, 7) {
+				t.Fatalf(`Finder pattern of %d bytes is damaged`, n)
+			}
+		}
+
+		for i := 8; i < c.Size-8; i++ {
+			if c.Dark(i, 6) != (i%2 == 0) || c.Dark(6, i) != (i%2 == 0) {
+				t.Fatalf(`Timing pattern of %d bytes is damaged`, n)
+			}
+		}
+
+		if decoded := readBack(t, c, Medium); !bytes.Equal(decoded, data) {
+			t.Fatalf(`Data of %d bytes could not be read back`, n)
+		}
+	}
+
+	_, err := Encode(make([]byte, MaxLen(Medium)+1), Medium)
+	if !errors.Is(err, ErrTooLarge) {
+		t.Fatalf(`Wrong error for too much data: %v`, err)
+	}
+}
+
+// ******** Private functions ********
+
+// readBack reads the format information and the data of c like a scanner.
+func readBack(t *testing.T, c *Code, level Level) []byte {
+	t.Helper()
+
+	format := 0
+	for i := 0; i < 15; i++ {
+		// The copy next to the top right and bottom left finder patterns.
+		var dark bool
+		if i < 8 {
+			dark = c.Dark(c.Size-1-i, 8)
+		} else {
+			dark = c.Dark(8, c.Size-15+i)
+		}
+
+		if dark {
+			format |= 1 << i
+		}
+	}
+
+	mask := -1
+	for m := 0; m < maskCount; m++ {
+		if formatBits(level, m) == format {
+			mask = m
+		}
+	}
+
+	if mask < 0 {
+		t.Fatalf(`Format information %015b is invalid`, format)
+	}
+
+	// The function patterns of an empty code tell the positions of the data modules.
+	empty := newCode(c.Version)
+	empty.drawFunctionPatterns(level)
+
+	var w bitWriter
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+
+		for vertical := 0; vertical < c.Size; vertical++ {
+			y := vertical
+			if (right+1)&2 == 0 {
+				y = c.Size - 1 - vertical
+			}
+
+			for x := right; x >= right-1; x-- {
+				if !empty.isFunction[y*c.Size+x] {
+					value := 0
+					if c.Dark(x, y) != maskPattern(mask, x, y) {
+						value = 1
+					}
+
+					w.write(value, 1)
+				}
+			}
+		}
+	}
+
+	// The data codewords are interleaved before the error correction codewords.
+	blockCount := errorCorrectionBlocks[level][c.Version]
+	eccLen := eccCodewordsPerBlock[level][c.Version]
+	rawCodewords := rawDataModules(c.Version) / 8
+	shortBlocks := blockCount - rawCodewords%blockCount
+	shortDataLen := rawCodewords/blockCount - eccLen
+
+	blocks := make([][]byte, blockCount)
+	k := 0
+	for i := 0; i <= shortDataLen; i++ {
+		for j := range blocks {
+			if i < shortDataLen || j >= shortBlocks {
+				blocks[j] = append(blocks[j], w.bytes[k])
+				k++
+			}
+		}
+	}
+
+	divisor := reedSolomonDivisor(eccLen)
+	var codewords []byte
+	for j, block := range blocks {
+		for i := 0; i < eccLen; i++ {
+			if ecc := w.bytes[k+i*blockCount+j]; ecc != reedSolomonRemainder(block, divisor)[i] {
+				t.Fatalf(`Error correction codeword %d of block %d is wrong`, i, j)
+			}
+		}
+
+		codewords = append(codewords, block...)
+	}
+
+	if mode := readBits(codewords, 0, 4); mode != modeByte {
+		t.Fatalf(`Mode is not byte mode, but %04b`, mode)
+	}
+
+	n := readBits(codewords, 4, countBits(c.Version))
+	result := make([]byte, n)
+	for i := range result {
+		result[i] = byte(readBits(codewords, 4+countBits(c.Version)+i*8, 8))
+	}
+
+	return result
+}
+
+// readBits returns the n bits of data that start at bit offset.
+func readBits(data []byte, offset int, n int) int {
+	result := 0
+	for i := offset; i < offset+n; i++ {
+		result = result<<1 | int(data[i/8]>>(7-i%8)&1)
+	}
+
+	return result
+}
@@ -0,0 +1,148 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdh"
+	"embed"
+	"encoding/hex"
+	"github.com/xformerfhs/z85"
+	"strings"
+	"testing"
+)
+
+// ******** Private variables ********
+
+// interopFiles contains the interoperability samples produced by other implementations.
+//
+//go:embed testdata/interop
+var interopFiles embed.FS
+
+// ******** Test functions ********
+
+// TestInteropVectors tests if the encodings of other implementations are decoded and encoded identically.
+func TestInteropVectors(t *testing.T) {
+	records := readInteropFile(t, `testdata/interop/vectors.txt`)
+	if len(records) == 0 {
+		t.Fatal(`No interop vectors found`)
+	}
+
+	for _, record := range records {
+		name, rawHex, encoded := record[0], record[1], record[2]
+
+		raw, err := hex.DecodeString(rawHex)
+		if err != nil {
+			t.Fatalf(`Vector '%s' has invalid hex data: %v`, name, err)
+		}
+
+		decoded, err := z85.Decode(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding of vector '%s' failed: %v`, name, err)
+		}
+
+		if !bytes.Equal(decoded, raw) {
+			t.Fatalf(`Vector '%s' is decoded to '% 02x', but should be '% 02x'`, name, decoded, raw)
+		}
+
+		reencoded, err := z85.Encode(raw)
+		if err != nil {
+			t.Fatalf(`Encoding of vector '%s' failed: %v`, name, err)
+		}
+
+		if reencoded != encoded {
+			t.Fatalf(`Vector '%s' is encoded to '%s', but should be '%s'`, name, reencoded, encoded)
+		}
+	}
+}
+
+// TestInteropCurveKeys tests if the decoded secret keys of other implementations
+// result in the decoded public keys.
+func TestInteropCurveKeys(t *testing.T) {
+	records := readInteropFile(t, `testdata/interop/curve-keys.txt`)
+	if len(records) == 0 {
+		t.Fatal(`No interop key pairs found`)
+	}
+
+	for _, record := range records {
+		name, encodedSecret, encodedPublic := record[0], record[1], record[2]
+
+		secret, err := z85.Decode40(encodedSecret)
+		if err != nil {
+			t.Fatalf(`Decoding of secret key '%s' failed: %v`, name, err)
+		}
+
+		public, err := z85.Decode40(encodedPublic)
+		if err != nil {
+			t.Fatalf(`Decoding of public key '%s' failed: %v`, name, err)
+		}
+
+		privateKey, err := ecdh.X25519().NewPrivateKey(secret[:])
+		if err != nil {
+			t.Fatalf(`Secret key '%s' is not valid: %v`, name, err)
+		}
+
+		if !bytes.Equal(privateKey.PublicKey().Bytes(), public[:]) {
+			t.Fatalf(`Public key '%s' does not belong to its secret key`, name)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// readInteropFile reads the tab-separated records of an interop file.
+// Empty lines and comment lines are skipped.
+func readInteropFile(t *testing.T, name string) [][]string {
+	t.Helper()
+
+	data, err := interopFiles.ReadFile(name)
+	if err != nil {
+		t.Fatalf(`Could not read interop file '%s': %v`, name, err)
+	}
+
+	var result [][]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := scanner.Text()
+		if len(line) == 0 || strings.HasPrefix(line, `#`) {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 {
+			t.Fatalf(`Line %d of interop file '%s' has %d fields instead of 3`, lineNumber, name, len(fields))
+		}
+
+		result = append(result, fields)
+	}
+
+	return result
+}
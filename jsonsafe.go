@@ -0,0 +1,79 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Private constants ********
+
+// jsonSafePrefix is the character that precedes the encoding in the JSON-safe variant.
+// It is a lower case letter, so the result never looks like a number, a boolean, null,
+// an object or an array to parsers that guess types of unquoted values.
+// A single 'z' is no keyword in YAML.
+const jsonSafePrefix = 'z'
+
+// ******** Public functions ********
+
+// EncodeJSONSafe encodes a byte slice into a Z85 encoded string that always starts with the letter 'z'.
+// Such strings are never mistaken for numbers, booleans, objects or arrays by lax JSON or YAML parsers.
+// The length of the slice must be a multiple of 4.
+func EncodeJSONSafe(source []byte) (string, error) {
+	encodedLen, err := encodedLength(len(source))
+	if err != nil {
+		return ``, err
+	}
+
+	result := make([]byte, encodedLen+1)
+	result[0] = jsonSafePrefix
+	StdEncoding.encodeChunks(result[1:], source)
+
+	return string(result), nil
+}
+
+// DecodeJSONSafe decodes a string that was encoded by EncodeJSONSafe into a byte slice.
+func DecodeJSONSafe(source string) ([]byte, error) {
+	if len(source) == 0 {
+		return nil, newInvalidLengthError(encodedChunkSize, 0)
+	}
+
+	if source[0] != jsonSafePrefix {
+		return nil, newInvalidByteError(0, source[0])
+	}
+
+	decodedLen, err := decodedLength(len(source) - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, decodedLen)
+	err = decodeChunks(StdEncoding, result, source[1:], 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,98 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"encoding/json"
+	"github.com/xformerfhs/z85"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestJSONSafeRoundTrip tests if JSON-safe strings start with 'z' and decode to the original bytes.
+func TestJSONSafeRoundTrip(t *testing.T) {
+	buffer := make([]byte, 8)
+	for i := 0; i < iterationCount; i++ {
+		_, _ = crand.Read(buffer)
+
+		encoded, err := z85.EncodeJSONSafe(buffer)
+		if err != nil {
+			t.Fatalf(`Encoding failed: %v`, err)
+		}
+
+		if encoded[0] != 'z' {
+			t.Fatalf(`Encoding does not start with 'z': '%s'`, encoded)
+		}
+
+		// A lax parser must not recognize the value as anything but a string.
+		var value any
+		if json.Unmarshal([]byte(encoded), &value) == nil {
+			t.Fatalf(`Encoding is valid JSON: '%s'`, encoded)
+		}
+
+		var decoded []byte
+		decoded, err = z85.DecodeJSONSafe(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding failed: %v`, err)
+		}
+
+		if !bytes.Equal(decoded, buffer) {
+			t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, decoded)
+		}
+	}
+}
+
+// TestEncodeJSONSafeDigits tests if data whose plain encoding consists of digits only is encoded safely.
+func TestEncodeJSONSafeDigits(t *testing.T) {
+	plain, _ := z85.Encode([]byte{0, 0, 0, 0})
+	if plain != `00000` {
+		t.Fatalf(`Plain encoding is not '00000', but '%s'`, plain)
+	}
+
+	encoded, _ := z85.EncodeJSONSafe([]byte{0, 0, 0, 0})
+	if encoded != `z00000` {
+		t.Fatalf(`Encoding is not 'z00000', but '%s'`, encoded)
+	}
+}
+
+// TestDecodeJSONSafeMissingPrefix tests if a string without prefix is rejected.
+func TestDecodeJSONSafeMissingPrefix(t *testing.T) {
+	_, err := z85.DecodeJSONSafe(encodedTheOne)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Wrong error for missing prefix: '%v'`, err)
+	}
+
+	_, err = z85.DecodeJSONSafe(``)
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Wrong error for empty string: '%v'`, err)
+	}
+}
@@ -0,0 +1,78 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Package keys85 contains allocation-free Z85 functions for keys of 32 and 64 bytes.
+//
+// All functions work on arrays and never allocate memory when they succeed.
+// This makes them suitable for code paths where allocations are not acceptable,
+// e.g. when keys are handled close to hardware security modules or in real-time code.
+package keys85
+
+import (
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Public constants ********
+
+// EncodedLen32 is the length of the encoding of a 32 byte key.
+const EncodedLen32 = 40
+
+// EncodedLen64 is the length of the encoding of a 64 byte key.
+const EncodedLen64 = 80
+
+// ******** Public functions ********
+
+// Encode32 encodes a 32 byte key, e.g. a CURVE key, into its 40 character Z85 encoding.
+func Encode32(key [32]byte) [EncodedLen32]byte {
+	var result [EncodedLen32]byte
+
+	// The length of the key is a multiple of 4 and the result is large enough, so this cannot fail.
+	_, _ = z85.EncodeInto(result[:], key[:])
+
+	return result
+}
+
+// Decode32 decodes the 40 character Z85 encoding of a 32 byte key.
+func Decode32(encoded string) ([32]byte, error) {
+	return z85.Decode40(encoded)
+}
+
+// Encode64 encodes a 64 byte key into its 80 character Z85 encoding.
+func Encode64(key [64]byte) [EncodedLen64]byte {
+	var result [EncodedLen64]byte
+
+	// The length of the key is a multiple of 4 and the result is large enough, so this cannot fail.
+	_, _ = z85.EncodeInto(result[:], key[:])
+
+	return result
+}
+
+// Decode64 decodes the 80 character Z85 encoding of a 64 byte key.
+func Decode64(encoded string) ([64]byte, error) {
+	return z85.Decode80(encoded)
+}
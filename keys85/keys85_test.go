@@ -0,0 +1,159 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package keys85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"github.com/xformerfhs/z85"
+	"github.com/xformerfhs/z85/keys85"
+	"testing"
+)
+
+// ******** Private constants ********
+
+// allocRuns is the number of runs to measure the allocations.
+const allocRuns = 100
+
+// ******** Private variables ********
+
+// Results are stored in package variables, so the compiler can not optimize the calls away.
+var (
+	sink32  [32]byte
+	sink64  [64]byte
+	sink40  [keys85.EncodedLen32]byte
+	sink80  [keys85.EncodedLen64]byte
+	sinkErr error
+)
+
+// ******** Test functions ********
+
+// TestRoundTrip32 tests if a 32 byte key survives encoding and decoding.
+func TestRoundTrip32(t *testing.T) {
+	var key [32]byte
+	_, _ = crand.Read(key[:])
+
+	encoded := keys85.Encode32(key)
+
+	expected, _ := z85.Encode(key[:])
+	if string(encoded[:]) != expected {
+		t.Fatalf(`Encoding is '%s', but should be '%s'`, encoded, expected)
+	}
+
+	decoded, err := keys85.Decode32(string(encoded[:]))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if decoded != key {
+		t.Fatalf(`Decoded key is '% 02x', but should be '% 02x'`, decoded, key)
+	}
+}
+
+// TestRoundTrip64 tests if a 64 byte key survives encoding and decoding.
+func TestRoundTrip64(t *testing.T) {
+	var key [64]byte
+	_, _ = crand.Read(key[:])
+
+	encoded := keys85.Encode64(key)
+
+	expected, _ := z85.Encode(key[:])
+	if string(encoded[:]) != expected {
+		t.Fatalf(`Encoding is '%s', but should be '%s'`, encoded, expected)
+	}
+
+	decoded, err := keys85.Decode64(string(encoded[:]))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded[:], key[:]) {
+		t.Fatalf(`Decoded key is '% 02x', but should be '% 02x'`, decoded, key)
+	}
+}
+
+// TestDecodeInvalid tests if invalid encodings are rejected.
+func TestDecodeInvalid(t *testing.T) {
+	_, err := keys85.Decode32(`HelloWorld`)
+	if !z85.IsErrUnexpectedLength(err) {
+		t.Fatalf(`Expected unexpected length error, but got: %v`, err)
+	}
+
+	_, err = keys85.Decode64(`HelloWorld`)
+	if !z85.IsErrUnexpectedLength(err) {
+		t.Fatalf(`Expected unexpected length error, but got: %v`, err)
+	}
+
+	var key [64]byte
+	encoded := keys85.Encode64(key)
+	encoded[42] = '~'
+
+	_, err = keys85.Decode64(string(encoded[:]))
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Expected invalid byte error, but got: %v`, err)
+	}
+}
+
+// TestNoAllocations tests if the functions do not allocate memory.
+func TestNoAllocations(t *testing.T) {
+	var key32 [32]byte
+	var key64 [64]byte
+	_, _ = crand.Read(key32[:])
+	_, _ = crand.Read(key64[:])
+
+	encoded32 := string(sliceOf40(keys85.Encode32(key32)))
+	encoded64 := string(sliceOf80(keys85.Encode64(key64)))
+
+	checkNoAllocations(t, `Encode32`, func() { sink40 = keys85.Encode32(key32) })
+	checkNoAllocations(t, `Decode32`, func() { sink32, sinkErr = keys85.Decode32(encoded32) })
+	checkNoAllocations(t, `Encode64`, func() { sink80 = keys85.Encode64(key64) })
+	checkNoAllocations(t, `Decode64`, func() { sink64, sinkErr = keys85.Decode64(encoded64) })
+}
+
+// ******** Private functions ********
+
+// checkNoAllocations fails the test, if f allocates memory.
+func checkNoAllocations(t *testing.T, name string, f func()) {
+	t.Helper()
+
+	allocs := testing.AllocsPerRun(allocRuns, f)
+	if allocs != 0 {
+		t.Fatalf(`%s allocates %.1f times per run`, name, allocs)
+	}
+}
+
+// sliceOf40 returns the encoded 32 byte key as a slice.
+func sliceOf40(a [keys85.EncodedLen32]byte) []byte {
+	return a[:]
+}
+
+// sliceOf80 returns the encoded 64 byte key as a slice.
+func sliceOf80(a [keys85.EncodedLen64]byte) []byte {
+	return a[:]
+}
@@ -0,0 +1,95 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"math"
+)
+
+// ******** Public constants ********
+
+// MaxEncodeInputLen is the maximum length of data that can be encoded
+// without the length of the encoding overflowing an int.
+// On 32-bit platforms this limit can be reached in practice.
+const MaxEncodeInputLen = (math.MaxInt / encodedChunkSize) * byteChunkSize
+
+// MaxDecodeInputLen is the maximum length of an encoded string that can be decoded.
+const MaxDecodeInputLen = (math.MaxInt / encodedChunkSize) * encodedChunkSize
+
+// ******** Public functions ********
+
+// EncodedLen returns the length of the Z85 encoding of n bytes.
+// It returns -1, if n is negative, not a multiple of 4 or larger than MaxEncodeInputLen.
+func EncodedLen(n int) int {
+	if n < 0 {
+		return -1
+	}
+
+	result, err := encodedLength(n)
+	if err != nil {
+		return -1
+	}
+
+	return result
+}
+
+// DecodedLen returns the length of the decoding of a Z85 string with n characters.
+// It returns an error, if n is negative or not a multiple of 5.
+func DecodedLen(n int) (int, error) {
+	if n < 0 {
+		return 0, newInvalidLengthError(encodedChunkSize, 0)
+	}
+
+	return decodedLength(n)
+}
+
+// ******** Private functions ********
+
+// encodedLength checks the length of data to encode and returns the length of its encoding.
+func encodedLength(n int) (int, error) {
+	if (n & byteChunkMask) != 0 {
+		return 0, newInvalidLengthError(byteChunkSize, uint(n)&^byteChunkMask)
+	}
+
+	if n > MaxEncodeInputLen {
+		return 0, newInputTooLargeError(MaxEncodeInputLen)
+	}
+
+	return n + (n >> byteChunkShift), nil
+}
+
+// decodedLength checks the length of an encoded string and returns the length of its decoding.
+func decodedLength(n int) (int, error) {
+	chunkCount := n / encodedChunkSize
+	if n != chunkCount*encodedChunkSize {
+		return 0, newInvalidLengthError(encodedChunkSize, uint(chunkCount*encodedChunkSize))
+	}
+
+	return n - chunkCount, nil
+}
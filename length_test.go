@@ -0,0 +1,129 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"github.com/xformerfhs/z85"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestMaxEncodeInputLen tests if MaxEncodeInputLen is the largest length whose encoding length fits into an int.
+func TestMaxEncodeInputLen(t *testing.T) {
+	if z85.MaxEncodeInputLen%4 != 0 {
+		t.Fatalf(`MaxEncodeInputLen is not a multiple of 4: %d`, z85.MaxEncodeInputLen)
+	}
+
+	maxInt := big.NewInt(math.MaxInt)
+
+	if encodedBigLen(z85.MaxEncodeInputLen).Cmp(maxInt) > 0 {
+		t.Fatal(`Encoding length of MaxEncodeInputLen overflows`)
+	}
+
+	if encodedBigLen(z85.MaxEncodeInputLen+4).Cmp(maxInt) <= 0 {
+		t.Fatal(`MaxEncodeInputLen is not the largest possible length`)
+	}
+}
+
+// TestMaxDecodeInputLen tests if MaxDecodeInputLen is the largest multiple of 5 that fits into an int.
+func TestMaxDecodeInputLen(t *testing.T) {
+	if z85.MaxDecodeInputLen%5 != 0 {
+		t.Fatalf(`MaxDecodeInputLen is not a multiple of 5: %d`, z85.MaxDecodeInputLen)
+	}
+
+	if z85.MaxDecodeInputLen <= math.MaxInt-5 {
+		t.Fatal(`MaxDecodeInputLen is not the largest possible length`)
+	}
+}
+
+// TestEncodedLen tests the calculation of encoding lengths.
+func TestEncodedLen(t *testing.T) {
+	testCases := []struct {
+		n        int
+		expected int
+	}{
+		{0, 0},
+		{4, 5},
+		{32, 40},
+		{3, -1},
+		{-4, -1},
+		{z85.MaxEncodeInputLen, z85.MaxEncodeInputLen / 4 * 5},
+		{z85.MaxEncodeInputLen + 4, -1},
+	}
+
+	for _, tc := range testCases {
+		result := z85.EncodedLen(tc.n)
+		if result != tc.expected {
+			t.Fatalf(`EncodedLen(%d) returned %d instead of %d`, tc.n, result, tc.expected)
+		}
+	}
+}
+
+// TestDecodedLen tests the calculation of decoding lengths.
+func TestDecodedLen(t *testing.T) {
+	testCases := []struct {
+		n        int
+		expected int
+	}{
+		{0, 0},
+		{5, 4},
+		{40, 32},
+		{z85.MaxDecodeInputLen, z85.MaxDecodeInputLen / 5 * 4},
+	}
+
+	for _, tc := range testCases {
+		result, err := z85.DecodedLen(tc.n)
+		if err != nil {
+			t.Fatalf(`DecodedLen(%d) failed: %v`, tc.n, err)
+		}
+
+		if result != tc.expected {
+			t.Fatalf(`DecodedLen(%d) returned %d instead of %d`, tc.n, result, tc.expected)
+		}
+	}
+
+	for _, n := range []int{-5, 4, 41} {
+		_, err := z85.DecodedLen(n)
+		if !z85.IsErrInvalidLength(err) {
+			t.Fatalf(`Wrong error for DecodedLen(%d): '%v'`, n, err)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// encodedBigLen returns the encoding length of n bytes without overflow.
+func encodedBigLen(n uint64) *big.Int {
+	result := new(big.Int).SetUint64(n)
+	result.Div(result, big.NewInt(4))
+	return result.Mul(result, big.NewInt(5))
+}
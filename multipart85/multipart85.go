@@ -0,0 +1,132 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Package multipart85 decodes and encodes Z85 armored parts of multipart/form-data messages on the fly.
+//
+// A part is Z85 armored, if its header X-Content-Encoding is "z85" or "z85p".
+// "z85" is the plain Z85 encoding, which requires a length that is a multiple of 4.
+// "z85p" is the padded encoding, which can encode data of any length.
+// Line breaks in the encoded content are ignored.
+package multipart85
+
+import (
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Public constants ********
+
+// HeaderContentEncoding is the name of the part header that contains the encoding of the content.
+const HeaderContentEncoding = `X-Content-Encoding`
+
+// Values of the header HeaderContentEncoding.
+const (
+	EncodingZ85       = `z85`
+	EncodingZ85Padded = `z85p`
+)
+
+// LineLength is the length of the lines that CreateFormFile writes.
+const LineLength = 76
+
+// ******** Private variables ********
+
+// plainEncoding is the plain encoding that ignores line breaks.
+var plainEncoding = z85.StdEncoding.WithWrap(LineLength)
+
+// paddedEncoding is the padded encoding that writes lines of LineLength characters and ignores line breaks.
+var paddedEncoding = z85.PaddedEncoding.WithWrap(LineLength)
+
+// quoteEscaper escapes the characters that are not allowed in a quoted header parameter.
+var quoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// ******** Public functions ********
+
+// IsEncoded reports whether header marks a Z85 armored part.
+func IsEncoded(header textproto.MIMEHeader) bool {
+	return encodingOf(header) != nil
+}
+
+// PartReader returns a reader for the content of part.
+// If the part is Z85 armored, the reader decodes it on the fly. Otherwise, part itself is returned.
+// Decoding errors are z85.CodecErrors with the offsets in the part.
+func PartReader(part *multipart.Part) io.Reader {
+	encoding := encodingOf(part.Header)
+	if encoding == nil {
+		return part
+	}
+
+	return encoding.NewDecoder(part)
+}
+
+// NextPart returns the next part of r and a reader for its decoded content.
+// It returns io.EOF, if there are no more parts.
+func NextPart(r *multipart.Reader) (*multipart.Part, io.Reader, error) {
+	part, err := r.NextPart()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return part, PartReader(part), nil
+}
+
+// CreateFormFile creates a Z85 armored form file part with the given field name and file name.
+// The data written to the returned writer is encoded with the padded encoding.
+// The caller must close the returned writer before the next part is created
+// or w is closed, so the last chunk is written.
+func CreateFormFile(w *multipart.Writer, fieldName string, fileName string) (io.WriteCloser, error) {
+	header := make(textproto.MIMEHeader)
+	header.Set(`Content-Disposition`,
+		`form-data; name="`+quoteEscaper.Replace(fieldName)+`"; filename="`+quoteEscaper.Replace(fileName)+`"`)
+	header.Set(`Content-Type`, `text/plain; charset=us-ascii`)
+	header.Set(HeaderContentEncoding, EncodingZ85Padded)
+
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return paddedEncoding.NewEncoder(part), nil
+}
+
+// ******** Private functions ********
+
+// encodingOf returns the Z85 encoding that header specifies or nil, if the part is not Z85 armored.
+func encodingOf(header textproto.MIMEHeader) *z85.Encoding {
+	switch strings.ToLower(strings.TrimSpace(header.Get(HeaderContentEncoding))) {
+	case EncodingZ85:
+		return plainEncoding
+	case EncodingZ85Padded:
+		return paddedEncoding
+	default:
+		return nil
+	}
+}
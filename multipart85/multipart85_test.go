@@ -0,0 +1,155 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package multipart85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+	"github.com/xformerfhs/z85/multipart85"
+)
+
+// ******** Test functions ********
+
+// TestRoundTrip tests if armored and plain parts are read correctly.
+func TestRoundTrip(t *testing.T) {
+	data := make([]byte, 1001)
+	_, _ = crand.Read(data)
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	fw, err := multipart85.CreateFormFile(w, `upload`, `data "1".bin`)
+	if err != nil {
+		t.Fatalf(`Creating the form file failed: %v`, err)
+	}
+
+	_, _ = fw.Write(data)
+	if err = fw.Close(); err != nil {
+		t.Fatalf(`Closing the form file failed: %v`, err)
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set(`Content-Disposition`, `form-data; name="key"`)
+	header.Set(multipart85.HeaderContentEncoding, ` Z85 `)
+	pw, _ := w.CreatePart(header)
+	_, _ = pw.Write([]byte("Hello\r\nWorld"))
+
+	_ = w.WriteField(`plain`, `HelloWorld`)
+	_ = w.Close()
+
+	expected := map[string][]byte{
+		`upload`: data,
+		`key`:    {0x86, 0x4f, 0xd2, 0x6f, 0xb5, 0x59, 0xf7, 0x5b},
+		`plain`:  []byte(`HelloWorld`),
+	}
+
+	r := multipart.NewReader(&body, w.Boundary())
+	count := 0
+	for {
+		part, content, err := multipart85.NextPart(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf(`Reading the next part failed: %v`, err)
+		}
+
+		decoded, err := io.ReadAll(content)
+		if err != nil {
+			t.Fatalf(`Reading part '%s' failed: %v`, part.FormName(), err)
+		}
+
+		if !bytes.Equal(decoded, expected[part.FormName()]) {
+			t.Fatalf(`Content of part '%s' is '% 02x'`, part.FormName(), decoded)
+		}
+
+		if part.FormName() == `upload` && part.FileName() != `data "1".bin` {
+			t.Fatalf(`File name is '%s'`, part.FileName())
+		}
+
+		count++
+	}
+
+	if count != len(expected) {
+		t.Fatalf(`Read %d parts, but there should be %d`, count, len(expected))
+	}
+}
+
+// TestIsEncoded tests if the header values are recognized.
+func TestIsEncoded(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected bool
+	}{
+		{``, false},
+		{`gzip`, false},
+		{`z85`, true},
+		{`Z85P`, true},
+	}
+
+	for _, test := range tests {
+		header := make(textproto.MIMEHeader)
+		if test.value != `` {
+			header.Set(multipart85.HeaderContentEncoding, test.value)
+		}
+
+		if multipart85.IsEncoded(header) != test.expected {
+			t.Fatalf(`IsEncoded of '%s' is not %t`, test.value, test.expected)
+		}
+	}
+}
+
+// TestInvalidContent tests if invalid content of an armored part results in a CodecError.
+func TestInvalidContent(t *testing.T) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set(`Content-Disposition`, `form-data; name="key"`)
+	header.Set(multipart85.HeaderContentEncoding, multipart85.EncodingZ85)
+	pw, _ := w.CreatePart(header)
+	_, _ = pw.Write([]byte(`Hello World`))
+	_ = w.Close()
+
+	part, err := multipart.NewReader(&body, w.Boundary()).NextPart()
+	if err != nil {
+		t.Fatalf(`Reading the part failed: %v`, err)
+	}
+
+	_, err = io.ReadAll(multipart85.PartReader(part))
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Expected invalid byte error, but got: %v`, err)
+	}
+}
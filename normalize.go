@@ -0,0 +1,100 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"strings"
+)
+
+// ******** Private constants ********
+
+// normalizeSkipped contains the characters that Normalize removes.
+const normalizeSkipped = " \t\n\v\f\r_"
+
+// ******** Public functions ********
+
+// Normalize converts a Z85 string as it was supplied by a user into its canonical form.
+//
+// A leading byte order mark, whitespace including line breaks and the separator '_' are removed.
+// The result is decoded and encoded again, so the returned string is the strict single-line encoding.
+// The offsets in a returned error refer to source.
+func Normalize(source string) (string, error) {
+	decoded, err := Decode(removeNormalizeSkipped(source))
+	if err != nil {
+		return ``, remapError(err, func(offset int64) int64 {
+			return normalizedOffset(source, offset)
+		})
+	}
+
+	return Encode(decoded)
+}
+
+// ******** Private functions ********
+
+// isNormalizeSkipped reports whether b is a character that Normalize removes.
+func isNormalizeSkipped(b byte) bool {
+	return strings.IndexByte(normalizeSkipped, b) >= 0
+}
+
+// removeNormalizeSkipped removes a leading byte order mark and the characters that Normalize skips from source.
+// The bytes are removed one by one, so that invalid UTF-8 stays unchanged and offsets can be mapped back.
+func removeNormalizeSkipped(source string) string {
+	source = TrimBOM(source)
+	if !strings.ContainsAny(source, normalizeSkipped) {
+		return source
+	}
+
+	var result strings.Builder
+	result.Grow(len(source))
+	for i := 0; i < len(source); i++ {
+		if !isNormalizeSkipped(source[i]) {
+			result.WriteByte(source[i])
+		}
+	}
+
+	return result.String()
+}
+
+// normalizedOffset returns the offset in source of the character at offset in source without the removed characters.
+func normalizedOffset(source string, offset int64) int64 {
+	remaining := offset
+	for i := len(source) - len(TrimBOM(source)); i < len(source); i++ {
+		if isNormalizeSkipped(source[i]) {
+			continue
+		}
+
+		if remaining == 0 {
+			return int64(i)
+		}
+
+		remaining--
+	}
+
+	return int64(len(source))
+}
@@ -0,0 +1,114 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"errors"
+	"github.com/xformerfhs/z85"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestNormalize tests if user supplied variants are converted into the canonical form.
+func TestNormalize(t *testing.T) {
+	inputs := []string{
+		encodedTheOne,
+		"\xef\xbb\xbf" + encodedTheOne,
+		`Hello World`,
+		"Hello\r\nWorld\n",
+		"\tHel lo_Wor ld ",
+		`Hello_World`,
+	}
+
+	for _, input := range inputs {
+		normalized, err := z85.Normalize(input)
+		if err != nil {
+			t.Fatalf(`Normalization of '%s' failed: %v`, input, err)
+		}
+
+		if normalized != encodedTheOne {
+			t.Fatalf(`Normalization of '%s' is '%s', but should be '%s'`, input, normalized, encodedTheOne)
+		}
+	}
+}
+
+// TestNormalizeInvalid tests if invalid strings are rejected.
+func TestNormalizeInvalid(t *testing.T) {
+	_, err := z85.Normalize(`Hello Worl`)
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	_, err = z85.Normalize(`Hell~World`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Expected invalid byte error, but got: %v`, err)
+	}
+}
+
+// TestNormalizeErrorOffsets tests if the offsets of errors refer to the input with the removed characters.
+func TestNormalizeErrorOffsets(t *testing.T) {
+	tests := []struct {
+		input   string
+		kind    z85.ErrorKind
+		offset  int64
+		invalid byte
+	}{
+		{"Hello\r\nWor~d", z85.KindInvalidByte, 10, '~'},
+		{"\xef\xbb\xbf Hello_Wor\xffd", z85.KindInvalidByte, 13, 0xff},
+		{"\tHel lo_Wor", z85.KindInvalidLength, 8, 0},
+	}
+
+	for _, test := range tests {
+		_, err := z85.Normalize(test.input)
+
+		var codecErr *z85.CodecError
+		if !errors.As(err, &codecErr) {
+			t.Fatalf(`Error of '%s' is not a CodecError: '%v'`, test.input, err)
+		}
+
+		if codecErr.Kind != test.kind || codecErr.EncodedOffset != test.offset || codecErr.Byte != test.invalid {
+			t.Fatalf(`Error of '%s' is '%v' at offset %d instead of kind %v at offset %d`, test.input, err, codecErr.EncodedOffset, test.kind, test.offset)
+		}
+	}
+}
+
+// TestNormalizeEmpty tests if an empty string and a string with only removed characters are normalized to an empty string.
+func TestNormalizeEmpty(t *testing.T) {
+	for _, input := range []string{``, " \n_"} {
+		normalized, err := z85.Normalize(input)
+		if err != nil {
+			t.Fatalf(`Normalization of '%s' failed: %v`, input, err)
+		}
+
+		if len(normalized) != 0 {
+			t.Fatalf(`Normalization of '%s' is '%s', but should be empty`, input, normalized)
+		}
+	}
+}
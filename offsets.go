@@ -0,0 +1,83 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Public functions ********
+
+// RawToEncodedOffset returns the offset of the first encoded character of the chunk
+// that contains the raw byte at rawOffset.
+// The encoded characters of the raw byte range [start, end) are in the range
+// [RawToEncodedOffset(start), RawToEncodedOffset(end-1)+5).
+// It returns -1, if rawOffset is negative.
+func RawToEncodedOffset(rawOffset int64) int64 {
+	return StdEncoding.RawToEncodedOffset(rawOffset)
+}
+
+// EncodedToRawOffset returns the offset of the first raw byte of the chunk
+// that contains the encoded character at encodedOffset.
+// The raw bytes of the encoded range [start, end) are in the range
+// [EncodedToRawOffset(start), EncodedToRawOffset(end-1)+4).
+// It returns -1, if encodedOffset is negative.
+func EncodedToRawOffset(encodedOffset int64) int64 {
+	return StdEncoding.EncodedToRawOffset(encodedOffset)
+}
+
+// RawToEncodedOffset returns the offset of the first encoded character of the chunk
+// that contains the raw byte at rawOffset in the encoding e.
+// It works like the package level function RawToEncodedOffset.
+// For a wrapping encoding the line feeds before the chunk are counted.
+func (e *Encoding) RawToEncodedOffset(rawOffset int64) int64 {
+	if rawOffset < 0 {
+		return -1
+	}
+
+	result := (rawOffset >> byteChunkShift) * encodedChunkSize
+	if e.wrap > 0 {
+		result += result / int64(e.wrap)
+	}
+
+	return result
+}
+
+// EncodedToRawOffset returns the offset of the first raw byte of the chunk
+// that contains the encoded character at encodedOffset in the encoding e.
+// It works like the package level function EncodedToRawOffset.
+// For a padded encoding the offset of the pad count is mapped to the end of the padded data.
+// For a wrapping encoding the offset of a line feed is mapped to the chunk of the following character.
+func (e *Encoding) EncodedToRawOffset(encodedOffset int64) int64 {
+	if encodedOffset < 0 {
+		return -1
+	}
+
+	if e.wrap > 0 {
+		encodedOffset -= encodedOffset / int64(e.wrap+1)
+	}
+
+	return (encodedOffset / encodedChunkSize) * byteChunkSize
+}
@@ -0,0 +1,77 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"github.com/xformerfhs/z85"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestRawToEncodedOffset tests the mapping of raw offsets to encoded offsets.
+func TestRawToEncodedOffset(t *testing.T) {
+	tests := [][2]int64{{0, 0}, {1, 0}, {3, 0}, {4, 5}, {7, 5}, {8, 10}, {-1, -1}}
+
+	for _, test := range tests {
+		result := z85.RawToEncodedOffset(test[0])
+		if result != test[1] {
+			t.Fatalf(`Raw offset %d is mapped to %d, but should be mapped to %d`, test[0], result, test[1])
+		}
+	}
+}
+
+// TestEncodedToRawOffset tests the mapping of encoded offsets to raw offsets.
+func TestEncodedToRawOffset(t *testing.T) {
+	tests := [][2]int64{{0, 0}, {4, 0}, {5, 4}, {9, 4}, {10, 8}, {-1, -1}}
+
+	for _, test := range tests {
+		result := z85.EncodedToRawOffset(test[0])
+		if result != test[1] {
+			t.Fatalf(`Encoded offset %d is mapped to %d, but should be mapped to %d`, test[0], result, test[1])
+		}
+	}
+}
+
+// TestOffsetsMatchEncoding tests if the mapped offsets point to the corresponding characters of an encoding.
+func TestOffsetsMatchEncoding(t *testing.T) {
+	for rawOffset := int64(0); rawOffset < int64(len(clearTheOne)); rawOffset++ {
+		encodedOffset := z85.RawToEncodedOffset(rawOffset)
+
+		chunkStart := rawOffset &^ 3
+		encodedChunk, _ := z85.Encode(clearTheOne[chunkStart : chunkStart+4])
+		if encodedTheOne[encodedOffset:encodedOffset+5] != encodedChunk {
+			t.Fatalf(`Raw offset %d is mapped to the wrong chunk`, rawOffset)
+		}
+
+		if z85.EncodedToRawOffset(encodedOffset) != chunkStart {
+			t.Fatalf(`Encoded offset %d is not mapped back to %d`, encodedOffset, chunkStart)
+		}
+	}
+}
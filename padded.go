@@ -0,0 +1,148 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"math"
+)
+
+// ******** Public constants ********
+
+// MaxPaddedEncodeInputLen is the maximum length of data that can be encoded with a padded encoding
+// without the length of the encoding overflowing an int.
+const MaxPaddedEncodeInputLen = ((math.MaxInt - 1) / encodedChunkSize) * byteChunkSize
+
+// ******** Public variables ********
+
+// PaddedEncoding is the Z85 encoding with padding (Z85P).
+// It encodes data of any length.
+var PaddedEncoding = StdEncoding.WithPadding()
+
+// ******** Public functions ********
+
+// WithPadding creates a new encoding identical to e except that it encodes data of any length.
+//
+// The data is padded with zero bytes to a multiple of 4 before it is encoded.
+// The number of padding bytes is appended to the encoded data as one character
+// that encodes the values 0 to 3.
+// So the length of the encoded data is a multiple of 5 plus 1.
+// Empty data is encoded as an empty string.
+func (e *Encoding) WithPadding() *Encoding {
+	result := *e
+	result.padded = true
+
+	return &result
+}
+
+// Padded reports whether e is a padded encoding.
+func (e *Encoding) Padded() bool {
+	return e.padded
+}
+
+// ******** Private functions ********
+
+// paddedEncodedLength checks the length of data to encode with padding and returns the length of its encoding.
+func paddedEncodedLength(n int) (int, error) {
+	if n > MaxPaddedEncodeInputLen {
+		return 0, newInputTooLargeError(MaxPaddedEncodeInputLen)
+	}
+
+	if n == 0 {
+		return 0, nil
+	}
+
+	chunkCount := n >> byteChunkShift
+	if (n & byteChunkMask) != 0 {
+		chunkCount++
+	}
+
+	return chunkCount*encodedChunkSize + 1, nil
+}
+
+// paddedDecodedLength checks the length of a padded encoding and returns the length of its decoding
+// including the padding bytes.
+func paddedDecodedLength(n int) (int, error) {
+	if n == 0 {
+		return 0, nil
+	}
+
+	dataLen := n - 1
+	chunkCount := dataLen / encodedChunkSize
+	if dataLen != chunkCount*encodedChunkSize {
+		return 0, newInvalidLengthError(encodedChunkSize, uint(chunkCount*encodedChunkSize))
+	}
+
+	return chunkCount * byteChunkSize, nil
+}
+
+// encodePadded encodes source with padding into destination.
+// The destination must be large enough.
+func (e *Encoding) encodePadded(destination []byte, source []byte) {
+	if len(source) == 0 {
+		return
+	}
+
+	fullLen := len(source) &^ byteChunkMask
+	e.encodeChunks(destination, source[:fullLen])
+	destination = destination[fullLen+(fullLen>>byteChunkShift):]
+
+	padCount := 0
+	if fullLen < len(source) {
+		var lastChunk [byteChunkSize]byte
+		padCount = byteChunkSize - copy(lastChunk[:], source[fullLen:])
+		e.encodeChunks(destination, lastChunk[:])
+		destination = destination[encodedChunkSize:]
+	}
+
+	destination[0] = e.encodeTable[padCount]
+}
+
+// decodePadded decodes source, which is either a string or a byte slice, with padding into destination
+// and returns the number of bytes without the padding.
+// The length of source must be a multiple of 5 plus 1 and destination must be large enough
+// for the decoding including the padding bytes.
+// The position is the position of source in the encoded input and is used for error reporting.
+func decodePadded[T string | []byte](e *Encoding, destination []byte, source T, position uint) (int, error) {
+	if len(source) == 0 {
+		return 0, nil
+	}
+
+	dataLen := len(source) - 1
+	padCount := e.decodeValue(source[dataLen])
+	if padCount >= byteChunkSize || dataLen == 0 {
+		return 0, newInvalidByteError(position+uint(dataLen), source[dataLen])
+	}
+
+	err := decodeChunks(e, destination, source[:dataLen], position)
+	if err != nil {
+		return 0, err
+	}
+
+	return (dataLen/encodedChunkSize)*byteChunkSize - int(padCount), nil
+}
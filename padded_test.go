@@ -0,0 +1,198 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"github.com/xformerfhs/z85"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// ******** Test functions ********
+
+// TestPaddedRoundTrip tests if data of all lengths survives encoding and decoding with padding.
+func TestPaddedRoundTrip(t *testing.T) {
+	for size := 0; size <= maxSliceSize; size++ {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		encoded, err := z85.PaddedEncoding.EncodeToString(data)
+		if err != nil {
+			t.Fatalf(`Encoding of size %d failed: %v`, size, err)
+		}
+
+		if len(encoded) != z85.PaddedEncoding.EncodedLen(size) {
+			t.Fatalf(`Encoding of size %d has length %d, but EncodedLen is %d`, size, len(encoded), z85.PaddedEncoding.EncodedLen(size))
+		}
+
+		decoded, err := z85.PaddedEncoding.DecodeString(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoded data of size %d is '% 02x', but should be '% 02x'`, size, decoded, data)
+		}
+	}
+}
+
+// TestPaddedKnownValues tests if padded encodings have the expected values.
+func TestPaddedKnownValues(t *testing.T) {
+	tests := []struct {
+		data     []byte
+		expected string
+	}{
+		{nil, ``},
+		{clearTheOne, encodedTheOne + `0`},
+		{clearTheOne[:7], `HelloWork71`},
+		{clearTheOne[:1], `H5.hN3`},
+	}
+
+	for _, test := range tests {
+		encoded, _ := z85.PaddedEncoding.EncodeToString(test.data)
+		if encoded != test.expected {
+			t.Fatalf(`Padded encoding of '% 02x' is '%s', but should be '%s'`, test.data, encoded, test.expected)
+		}
+	}
+}
+
+// TestPaddedStrictPrefix tests if the padded encoding of data with a length that is a multiple of 4
+// is the strict encoding followed by the pad count.
+func TestPaddedStrictPrefix(t *testing.T) {
+	data := make([]byte, maxSliceSize)
+	_, _ = crand.Read(data)
+
+	strict, _ := z85.Encode(data)
+	padded, _ := z85.PaddedEncoding.EncodeToString(data)
+
+	if padded != strict+`0` {
+		t.Fatal(`Padded encoding is not the strict encoding followed by the pad count`)
+	}
+}
+
+// TestPaddedInvalid tests if invalid padded encodings are rejected.
+func TestPaddedInvalid(t *testing.T) {
+	_, err := z85.PaddedEncoding.DecodeString(encodedTheOne)
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	_, err = z85.PaddedEncoding.DecodeString(encodedTheOne + `4`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Expected invalid byte error for pad count 4, but got: %v`, err)
+	}
+
+	_, err = z85.PaddedEncoding.DecodeString(`0`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Expected invalid byte error for pad count without data, but got: %v`, err)
+	}
+}
+
+// TestPaddedMethods tests if the other methods of a padded encoding handle the padding.
+func TestPaddedMethods(t *testing.T) {
+	data := clearTheOne[:6]
+	expected, _ := z85.PaddedEncoding.EncodeToString(data)
+
+	dst := make([]byte, z85.PaddedEncoding.EncodedLen(len(data)))
+	n, err := z85.PaddedEncoding.Encode(dst, data)
+	if err != nil || string(dst[:n]) != expected {
+		t.Fatalf(`Encode returned '%s' and %v`, dst[:n], err)
+	}
+
+	appended, err := z85.PaddedEncoding.AppendEncode([]byte(`x`), data)
+	if err != nil || string(appended) != `x`+expected {
+		t.Fatalf(`AppendEncode returned '%s' and %v`, appended, err)
+	}
+
+	maxLen, _ := z85.PaddedEncoding.DecodedLen(len(expected))
+	decoded := make([]byte, maxLen)
+	n, err = z85.PaddedEncoding.Decode(decoded, []byte(expected))
+	if err != nil || !bytes.Equal(decoded[:n], data) {
+		t.Fatalf(`Decode returned '% 02x' and %v`, decoded[:n], err)
+	}
+
+	appended, err = z85.PaddedEncoding.AppendDecode([]byte{0xff}, expected)
+	if err != nil || !bytes.Equal(appended, append([]byte{0xff}, data...)) {
+		t.Fatalf(`AppendDecode returned '% 02x' and %v`, appended, err)
+	}
+
+	if z85.StdEncoding.Padded() || !z85.PaddedEncoding.Padded() {
+		t.Fatal(`Padded reports wrong values`)
+	}
+}
+
+// TestPaddedStream tests if the stream encoder and decoder of a padded encoding handle the padding.
+func TestPaddedStream(t *testing.T) {
+	for _, size := range []int{0, 1, 4, 1023, 1024, 1025, 1279, 1280, 1281, 5000} {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		expected, _ := z85.PaddedEncoding.EncodeToString(data)
+
+		var out strings.Builder
+		enc := z85.PaddedEncoding.NewEncoder(&out)
+		for i := 0; i < size; i += 3 {
+			_, _ = enc.Write(data[i:min(i+3, size)])
+		}
+
+		err := enc.Close()
+		if err != nil {
+			t.Fatalf(`Close with size %d failed: %v`, size, err)
+		}
+
+		if out.String() != expected {
+			t.Fatalf(`Stream encoding with size %d differs from encoding of slice`, size)
+		}
+
+		for _, r := range []io.Reader{strings.NewReader(expected), iotest.OneByteReader(strings.NewReader(expected))} {
+			decoded, err := io.ReadAll(z85.PaddedEncoding.NewDecoder(r))
+			if err != nil {
+				t.Fatalf(`Stream decoding with size %d failed: %v`, size, err)
+			}
+
+			if !bytes.Equal(decoded, data) {
+				t.Fatalf(`Stream decoding with size %d differs from source data`, size)
+			}
+		}
+	}
+}
+
+// TestPaddedStreamInvalid tests if the stream decoder of a padded encoding rejects invalid lengths.
+func TestPaddedStreamInvalid(t *testing.T) {
+	_, err := io.ReadAll(z85.PaddedEncoding.NewDecoder(strings.NewReader(encodedTheOne)))
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	checkStreamOffsets(t, err, 4, 5)
+}
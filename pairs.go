@@ -0,0 +1,81 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Private constants ********
+
+// pairDivisor is the number of values that are encoded by 2 characters.
+const pairDivisor = codeSize * codeSize
+
+// pairReciprocal is the fixed-point reciprocal of pairDivisor that replaces the division.
+// For all 32 bit values x, (x * pairReciprocal) >> pairReciprocalShift is exactly x / pairDivisor,
+// and the product fits into 64 bits.
+const pairReciprocal = 2434904643
+
+// pairReciprocalShift is the number of fractional bits of pairReciprocal.
+const pairReciprocalShift = 44
+
+// pairIndexMask is the mask for an index into the pair table.
+// The table is padded to a power of 2, so masking the index proves to the compiler that it is in range.
+// As all indices are less than pairDivisor, the mask does not change them.
+const pairIndexMask = 1<<13 - 1
+
+// pairTableSize is the size of a table that contains the 2 characters of all values below pairDivisor.
+const pairTableSize = 2 * (pairIndexMask + 1)
+
+// ******** Private types ********
+
+// pairTable contains the 2 characters that encode each value below pairDivisor.
+// The characters of value v are at the indices 2*v and 2*v + 1.
+type pairTable [pairTableSize]byte
+
+// ******** Private creation functions ********
+
+// newPairTable creates the pair table for alphabet.
+func newPairTable(alphabet string) *pairTable {
+	result := new(pairTable)
+
+	index := 0
+	for high := 0; high < codeSize; high++ {
+		for low := 0; low < codeSize; low++ {
+			result[index] = alphabet[high]
+			result[index+1] = alphabet[low]
+			index += 2
+		}
+	}
+
+	return result
+}
+
+// ******** Private functions ********
+
+// divPair divides value by pairDivisor with a multiplication and a shift.
+func divPair(value uint32) uint32 {
+	return uint32((uint64(value) * pairReciprocal) >> pairReciprocalShift)
+}
@@ -0,0 +1,81 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestEncodePairBoundaries tests if values at the boundaries of the character pairs are encoded correctly.
+func TestEncodePairBoundaries(t *testing.T) {
+	const pairDivisor = 85 * 85
+
+	values := []uint32{0, 1, 0xffffffff, 0xfffffffe}
+	for _, boundary := range []uint32{
+		pairDivisor,
+		pairDivisor * pairDivisor,
+		0xffffffff / pairDivisor * pairDivisor,
+		0xffffffff / (pairDivisor * pairDivisor) * (pairDivisor * pairDivisor),
+	} {
+		values = append(values, boundary-1, boundary, boundary+1)
+	}
+
+	for i := 0; i < iterationCount; i++ {
+		values = append(values, rand.Uint32())
+	}
+
+	customEncoding, _ := z85.NewEncoding(reversedAlphabet)
+	for _, value := range values {
+		var data [4]byte
+		binary.BigEndian.PutUint32(data[:], value)
+
+		for _, encoding := range []*z85.Encoding{z85.StdEncoding, customEncoding} {
+			alphabet := z85.Alphabet
+			if encoding != z85.StdEncoding {
+				alphabet = reversedAlphabet
+			}
+
+			encoded, err := encoding.EncodeToString(data[:])
+			if err != nil {
+				t.Fatalf(`Encoding of %08x failed: %v`, value, err)
+			}
+
+			expected := referenceEncode(alphabet, data[:])
+			if encoded != expected {
+				t.Fatalf(`Encoding of %08x resulted in '%s' instead of '%s'`, value, encoded, expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"runtime"
+	"sync"
+)
+
+// ******** Private constants ********
+
+// defaultParallelThreshold is the size of the data from which on it is encoded in parallel,
+// if EnvParallelThreshold is not set.
+const defaultParallelThreshold = 1024 * 1024
+
+// parallelMinPartSize is the minimum size of the part that is encoded by one goroutine.
+const parallelMinPartSize = 256 * 1024
+
+// ******** Private functions ********
+
+// encodeOwned encodes source into destination, which has been allocated by this package.
+// Large data is encoded in parallel.
+// The goroutines make the slices escape to the heap, so this is not used for destinations supplied by the caller.
+func (e *Encoding) encodeOwned(destination []byte, source []byte) {
+	if len(source) < parallelThreshold || e.padded {
+		e.encode(destination, source)
+		return
+	}
+
+	unwrapped := destination
+	if e.wrap > 0 {
+		unwrapped = destination[len(destination)/(e.wrap+1):]
+	}
+
+	if !e.encodeParallel(unwrapped, source) {
+		e.encodeChunks(unwrapped, source)
+	}
+
+	if e.wrap > 0 {
+		e.wrapLines(destination)
+	}
+}
+
+// encodeParallel splits source into parts on chunk boundaries and encodes them in parallel.
+// As all chunks are encoded independently, the result is the same as the one of encodeChunks.
+// It returns false and does nothing, if only one goroutine would be used.
+func (e *Encoding) encodeParallel(destination []byte, source []byte) bool {
+	workers := min(runtime.GOMAXPROCS(0), len(source)/parallelMinPartSize)
+	if workers < 2 {
+		return false
+	}
+
+	partSize := (len(source)/workers + byteChunkMask) &^ byteChunkMask
+
+	var wg sync.WaitGroup
+	for start := 0; start < len(source); start += partSize {
+		end := min(start+partSize, len(source))
+
+		wg.Add(1)
+		go func(part []byte, destination []byte) {
+			defer wg.Done()
+			e.encodeChunks(destination, part)
+		}(source[start:end], destination[start+start>>byteChunkShift:])
+	}
+
+	wg.Wait()
+
+	return true
+}
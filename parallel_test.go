@@ -0,0 +1,62 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	crand "crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestEncodeParallel tests if large data that is encoded in parallel yields the same result as the reference.
+func TestEncodeParallel(t *testing.T) {
+	for _, size := range []int{1024 * 1024, 3*1024*1024 + 12} {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		encoded, err := z85.Encode(data)
+		if err != nil {
+			t.Fatalf(`Encoding of size %d failed: %v`, size, err)
+		}
+
+		if encoded != referenceEncode(z85.StdEncoding.Alphabet(), data) {
+			t.Fatalf(`Encoding of size %d differs from the reference`, size)
+		}
+
+		wrapping := z85.StdEncoding.WithWrap(76)
+		wrapped, _ := wrapping.EncodeToString(data)
+		if strings.ReplaceAll(wrapped, "\n", ``) != encoded {
+			t.Fatalf(`Wrapped encoding of size %d differs from the encoding`, size)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ******** Private constants ********
+
+// previewEllipsis marks the characters that are left out of a preview.
+const previewEllipsis = `…`
+
+// ******** Public functions ********
+
+// Preview returns s unchanged, if it has at most n characters.
+// Otherwise it returns the first and the last characters of s, so that n characters are shown,
+// separated by an ellipsis and followed by the length of s, e.g. "Hel…rld (10 characters)".
+// It is meant for logging large or sensitive encoded data.
+// A negative n is treated as 0.
+func Preview(s string, n int) string {
+	n = max(n, 0)
+	if len(s) <= n {
+		return s
+	}
+
+	prefixLen := (n + 1) / 2
+	return formatPreview(s[:prefixLen], s[len(s)-(n-prefixLen):], len(s), `characters`)
+}
+
+// PreviewBytes returns a preview of the Z85 encoding of b like Preview without encoding all of b.
+// If the length of b is not a multiple of 4, the padded encoding is used.
+// The length in the preview is the length of b.
+func PreviewBytes(b []byte, n int) string {
+	n = max(n, 0)
+
+	encoding := StdEncoding
+	if len(b)&byteChunkMask != 0 {
+		encoding = PaddedEncoding
+	}
+
+	// Data that is too large to be encoded is longer than any n.
+	encodedLen, err := encoding.encodedLength(len(b))
+	if err == nil && encodedLen <= n {
+		result, _ := encoding.EncodeToString(b)
+		return result
+	}
+
+	// Chunks are encoded independently, so the encodings of the start and the end of b
+	// are the start and the end of the encoding of b.
+	prefixLen := (n + 1) / 2
+	suffixLen := n - prefixLen
+
+	prefixEnd := min((prefixLen+encodedChunkSize-1)/encodedChunkSize*byteChunkSize, len(b))
+	prefix, _ := encoding.EncodeToString(b[:prefixEnd])
+
+	suffixStart := max(len(b)-(suffixLen+encodedChunkSize-1)/encodedChunkSize*byteChunkSize, 0) &^ byteChunkMask
+	suffix, _ := encoding.EncodeToString(b[suffixStart:])
+
+	return formatPreview(prefix[:prefixLen], suffix[len(suffix)-suffixLen:], len(b), `bytes`)
+}
+
+// ******** Private functions ********
+
+// formatPreview joins the prefix and the suffix of a preview and appends the length with its unit.
+func formatPreview(prefix string, suffix string, length int, unit string) string {
+	var builder strings.Builder
+	builder.WriteString(prefix)
+	builder.WriteString(previewEllipsis)
+	builder.WriteString(suffix)
+	builder.WriteString(` (`)
+	builder.WriteString(strconv.Itoa(length))
+	builder.WriteByte(' ')
+	builder.WriteString(unit)
+	builder.WriteByte(')')
+
+	return builder.String()
+}
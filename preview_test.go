@@ -0,0 +1,88 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	crand "crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestPreview tests the preview of encoded strings.
+func TestPreview(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected string
+	}{
+		{10, encodedTheOne},
+		{20, encodedTheOne},
+		{9, `Hello…orld (10 characters)`},
+		{4, `He…ld (10 characters)`},
+		{1, `H… (10 characters)`},
+		{0, `… (10 characters)`},
+		{-1, `… (10 characters)`},
+	}
+
+	for _, test := range tests {
+		preview := z85.Preview(encodedTheOne, test.n)
+		if preview != test.expected {
+			t.Fatalf(`Preview with %d characters is '%s', but should be '%s'`, test.n, preview, test.expected)
+		}
+	}
+}
+
+// TestPreviewBytes tests if the preview of a byte slice is the preview of its encoding.
+func TestPreviewBytes(t *testing.T) {
+	for size := 0; size <= maxSliceSize; size++ {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		encoded, _ := z85.PaddedEncoding.EncodeToString(data)
+		if size%4 == 0 {
+			encoded, _ = z85.Encode(data)
+		}
+
+		for n := 0; n <= 30; n++ {
+			expected := encoded
+			if len(encoded) > n {
+				prefixLen := (n + 1) / 2
+				expected = fmt.Sprintf(`%s…%s (%d bytes)`, encoded[:prefixLen], encoded[len(encoded)-(n-prefixLen):], size)
+			}
+
+			preview := z85.PreviewBytes(data, n)
+			if preview != expected {
+				t.Fatalf(`Preview of size %d with %d characters is '%s', but should be '%s'`, size, n, preview, expected)
+			}
+		}
+	}
+}
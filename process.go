@@ -0,0 +1,148 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ******** Private constants ********
+
+// processChunkSize is the number of raw bytes that ProcessChunks passes to the callback at once.
+const processChunkSize = 64 * 1024
+
+// ******** Public functions ********
+
+// ProcessChunks encodes src in chunks with the given number of workers and calls fn for each chunk in order.
+// rawChunk is the part of src and encodedChunk is its Z85 encoding.
+// The length of src must be a multiple of 4. All chunks except the last one have the same size.
+// If workers is 0 or less, runtime.GOMAXPROCS(0) workers are used.
+//
+// fn is called sequentially from the calling goroutine, so it may e.g. feed a hash or a writer.
+// encodedChunk is reused after fn returns and must not be retained.
+// The first error returned by fn or the error of a canceled context stops the processing and is returned.
+func ProcessChunks(ctx context.Context, src []byte, workers int, fn func(rawChunk []byte, encodedChunk []byte) error) error {
+	if _, err := encodedLength(len(src)); err != nil {
+		return err
+	}
+
+	chunkCount := (len(src) + processChunkSize - 1) / processChunkSize
+	if chunkCount == 0 {
+		return nil
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > chunkCount {
+		workers = chunkCount
+	}
+
+	// At most "window" chunks are encoded but not yet processed, so each of them has its own buffer.
+	window := min(2*workers, chunkCount)
+	chunkLen := min(processChunkSize, len(src))
+	encodedChunkLen := chunkLen + chunkLen>>byteChunkShift
+	buffers := make([][]byte, window)
+	for i := range buffers {
+		buffers[i] = make([]byte, encodedChunkLen)
+	}
+
+	slots := make(chan struct{}, window)
+	for i := 0; i < window; i++ {
+		slots <- struct{}{}
+	}
+
+	done := make([]chan struct{}, chunkCount)
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	workCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-workCtx.Done():
+					return
+				case <-slots:
+				}
+
+				index := int(next.Add(1) - 1)
+				if index >= chunkCount {
+					return
+				}
+
+				raw := processChunk(src, index)
+				StdEncoding.encodeChunks(buffers[index%window], raw)
+				close(done[index])
+			}
+		}()
+	}
+
+	var err error
+	for index := 0; index < chunkCount && err == nil; index++ {
+		select {
+		case <-ctx.Done():
+		case <-done[index]:
+		}
+
+		// A canceled context wins over a chunk that is ready.
+		if err = ctx.Err(); err != nil {
+			break
+		}
+
+		raw := processChunk(src, index)
+		err = fn(raw, buffers[index%window][:len(raw)+len(raw)>>byteChunkShift])
+		slots <- struct{}{}
+	}
+
+	cancel()
+	wg.Wait()
+
+	return err
+}
+
+// ******** Private functions ********
+
+// processChunk returns the raw chunk with the given index.
+func processChunk(src []byte, index int) []byte {
+	start := index * processChunkSize
+	end := min(start+processChunkSize, len(src))
+
+	return src[start:end]
+}
@@ -0,0 +1,118 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	"context"
+	crand "crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestProcessChunks tests if the chunks are passed in order and their encodings are correct.
+func TestProcessChunks(t *testing.T) {
+	for _, size := range []int{0, 4, 64 * 1024, 200*1024 + 12} {
+		for _, workers := range []int{0, 1, 3} {
+			data := make([]byte, size)
+			_, _ = crand.Read(data)
+
+			var raw []byte
+			var encoded []byte
+			err := z85.ProcessChunks(context.Background(), data, workers, func(rawChunk []byte, encodedChunk []byte) error {
+				raw = append(raw, rawChunk...)
+				encoded = append(encoded, encodedChunk...)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf(`Processing of size %d with %d workers failed: %v`, size, workers, err)
+			}
+
+			if !bytes.Equal(raw, data) {
+				t.Fatalf(`Raw chunks of size %d with %d workers are not the source`, size, workers)
+			}
+
+			expected, _ := z85.Encode(data)
+			if string(encoded) != expected {
+				t.Fatalf(`Encoded chunks of size %d with %d workers are not the encoding`, size, workers)
+			}
+		}
+	}
+}
+
+// TestProcessChunksError tests if an error of the callback stops the processing.
+func TestProcessChunksError(t *testing.T) {
+	errStop := errors.New(`stop`)
+	data := make([]byte, 1024*1024)
+
+	calls := 0
+	err := z85.ProcessChunks(context.Background(), data, 4, func(_ []byte, _ []byte) error {
+		calls++
+		if calls == 2 {
+			return errStop
+		}
+
+		return nil
+	})
+	if err != errStop {
+		t.Fatalf(`Expected stop error, but got: %v`, err)
+	}
+
+	if calls != 2 {
+		t.Fatalf(`Callback was called %d times, but should have been called 2 times`, calls)
+	}
+}
+
+// TestProcessChunksCanceled tests if a canceled context stops the processing.
+func TestProcessChunksCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := z85.ProcessChunks(ctx, make([]byte, 1024*1024), 2, func(_ []byte, _ []byte) error {
+		t.Fatal(`Callback must not be called`)
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf(`Expected canceled error, but got: %v`, err)
+	}
+}
+
+// TestProcessChunksInvalidLength tests if a source with an invalid length is rejected.
+func TestProcessChunksInvalidLength(t *testing.T) {
+	err := z85.ProcessChunks(context.Background(), make([]byte, 5), 1, func(_ []byte, _ []byte) error {
+		return nil
+	})
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+}
@@ -0,0 +1,85 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"io"
+	"unsafe"
+)
+
+// ******** Private constants ********
+
+// readBufferSize is the size of the buffer used for reading raw data.
+// It is a multiple of 4.
+const readBufferSize = 4096
+
+// ******** Public functions ********
+
+// EncodeReaderN reads exactly n bytes from r and returns their Z85 encoding.
+// The result is allocated once with its final size and the input is encoded while it is read,
+// so the input is never held in memory completely.
+// n must be a multiple of 4.
+// If r ends before n bytes have been read, io.ErrUnexpectedEOF is returned.
+func EncodeReaderN(r io.Reader, n int64) (string, error) {
+	if n < 0 {
+		return ``, newInvalidLengthError(byteChunkSize, 0)
+	}
+
+	if n > MaxEncodeInputLen {
+		return ``, newInputTooLargeError(MaxEncodeInputLen)
+	}
+
+	encodedLen, err := encodedLength(int(n))
+	if err != nil {
+		return ``, err
+	}
+
+	result := make([]byte, encodedLen)
+	buffer := make([]byte, min(readBufferSize, int(n)))
+	destination := result
+	for remaining := int(n); remaining > 0; {
+		chunk := buffer[:min(len(buffer), remaining)]
+		_, err = io.ReadFull(r, chunk)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+
+			return ``, err
+		}
+
+		StdEncoding.encodeChunks(destination, chunk)
+
+		destination = destination[len(chunk)+(len(chunk)>>byteChunkShift):]
+		remaining -= len(chunk)
+	}
+
+	// The buffer is not referenced anywhere else, so it can become the string without a copy.
+	return unsafe.String(unsafe.SliceData(result), len(result)), nil
+}
@@ -0,0 +1,88 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"errors"
+	"github.com/xformerfhs/z85"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+// ******** Test functions ********
+
+// TestEncodeReaderN tests if EncodeReaderN has the same result as Encode.
+func TestEncodeReaderN(t *testing.T) {
+	data := make([]byte, 10000)
+	_, _ = crand.Read(data)
+
+	expected, _ := z85.Encode(data)
+
+	encoded, err := z85.EncodeReaderN(iotest.HalfReader(bytes.NewReader(data)), int64(len(data)))
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if encoded != expected {
+		t.Fatal(`Encoding of reader differs from encoding of slice`)
+	}
+}
+
+// TestEncodeReaderNEmpty tests if an empty input is encoded into an empty string.
+func TestEncodeReaderNEmpty(t *testing.T) {
+	encoded, err := z85.EncodeReaderN(bytes.NewReader(nil), 0)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if len(encoded) != 0 {
+		t.Fatalf(`Encoding of empty input is not empty, but '%s'`, encoded)
+	}
+}
+
+// TestEncodeReaderNShort tests if a reader that ends too early results in an error.
+func TestEncodeReaderNShort(t *testing.T) {
+	_, err := z85.EncodeReaderN(bytes.NewReader(clearTheOne), 12)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf(`Wrong error for short reader: '%v'`, err)
+	}
+}
+
+// TestEncodeReaderNInvalidLength tests if an invalid length results in an error.
+func TestEncodeReaderNInvalidLength(t *testing.T) {
+	for _, n := range []int64{-4, 6} {
+		_, err := z85.EncodeReaderN(bytes.NewReader(clearTheOne), n)
+		if !z85.IsErrInvalidLength(err) {
+			t.Fatalf(`Wrong error for length %d: '%v'`, n, err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Private constants ********
+
+// rfc1924Alphabet is the alphabet of RFC 1924.
+const rfc1924Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz!#$%&()*+-;<=>?@^_`{|}~"
+
+// ******** Public variables ********
+
+// RFC1924Encoding is the encoding with the alphabet of RFC 1924.
+// This is the alphabet of Python's base64.b85encode, Mercurial and Git.
+// For data whose length is a multiple of 4, the encoding is identical to the one of base64.b85encode.
+var RFC1924Encoding = mustNewEncoding(rfc1924Alphabet)
+
+// ******** Private functions ********
+
+// mustNewEncoding creates a new Encoding for a built-in alphabet and panics, if the alphabet is not valid.
+func mustNewEncoding(alphabet string) *Encoding {
+	result, err := NewEncoding(alphabet)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
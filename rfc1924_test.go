@@ -0,0 +1,77 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	"github.com/xformerfhs/z85"
+	"testing"
+)
+
+// ******** Private constants ********
+
+// b85AllBytes is the result of Python's base64.b85encode(bytes(range(256))).
+const b85AllBytes = "009C61O)~M2nh-c3=Iws5D^j+6crX17#SKH9337XAR!_nBqb&%C@Cr{EG;fCFflSSG&MFiI5|2yJUu=?KtV!7L`6nNNJ&adOifNtP*GA-R8>}2SXo+ITwPvYU}0ioWMyV&XlZI|Y;A6DaB*^Tbai%jczJqze0_d@fPsR8goTEOh>41ejE#<ukdcy;l$Dm3n3<ZJoSmMZprN9pq@|{(sHv)}tgWuEu(7hUw6(UkxVgH!yuH4^z`?@9#Kp$P$jQpf%+1cv(9zP<)YaD4*xB0K+}+;a;Njxq<mKk)=;`X~?CtLF@bU8V^!4`l`1$(#{Qds_"
+
+// ******** Test functions ********
+
+// TestRFC1924Encoding tests if the RFC 1924 encoding has the same results as Python's base64.b85encode.
+func TestRFC1924Encoding(t *testing.T) {
+	encoded, _ := z85.RFC1924Encoding.EncodeToString(clearTheOne)
+	if encoded != `hELLOwORLD` {
+		t.Fatalf(`Encoding is '%s', but should be 'hELLOwORLD'`, encoded)
+	}
+
+	allBytes := make([]byte, 256)
+	for i := range allBytes {
+		allBytes[i] = byte(i)
+	}
+
+	encoded, _ = z85.RFC1924Encoding.EncodeToString(allBytes)
+	if encoded != b85AllBytes {
+		t.Fatalf(`Encoding of all bytes is '%s', but should be '%s'`, encoded, b85AllBytes)
+	}
+
+	decoded, err := z85.RFC1924Encoding.DecodeString(b85AllBytes)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, allBytes) {
+		t.Fatalf(`Decoding is '% 02x', but should be '% 02x'`, decoded, allBytes)
+	}
+}
+
+// TestRFC1924EncodingRejectsZ85 tests if characters that are only in the Z85 alphabet are rejected.
+func TestRFC1924EncodingRejectsZ85(t *testing.T) {
+	_, err := z85.RFC1924Encoding.DecodeString(`Hell.World`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Expected invalid byte error, but got: %v`, err)
+	}
+}
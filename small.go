@@ -0,0 +1,59 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Private constants ********
+
+// smallBufferSize is the size of the buffers on the stack that are used for small data.
+// It holds the encoding of 64 bytes including padding and a few line breaks,
+// which covers keys, UUIDs and hash values.
+const smallBufferSize = 128
+
+// ******** Private functions ********
+
+// encodeSmallToString encodes src, whose encoding has a length of encodedLen, into a string.
+// The encoding is done in a buffer on the stack, so the only allocation is the one of the string.
+// encodedLen must not be larger than smallBufferSize.
+func (e *Encoding) encodeSmallToString(src []byte, encodedLen int) string {
+	var buffer [smallBufferSize]byte
+	result := buffer[:encodedLen]
+	e.encode(result, src)
+
+	return string(result)
+}
+
+// withoutLineBreaks returns source, which is either a string or a byte slice, without its line breaks.
+// The result is stored in buffer, if it is large enough, so small data can be kept on the stack.
+func withoutLineBreaks[T string | []byte](buffer []byte, source T) []byte {
+	if len(source) > cap(buffer) {
+		buffer = make([]byte, 0, len(source))
+	}
+
+	return appendWithoutLineBreaks(buffer[:0], source)
+}
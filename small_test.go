@@ -0,0 +1,103 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestSmallEncodeAllocations tests if small data is encoded with only one allocation for the result.
+func TestSmallEncodeAllocations(t *testing.T) {
+	for _, size := range []int{4, 16, 32, 64} {
+		source := make([]byte, size)
+
+		allocs := testing.AllocsPerRun(iterationCount, func() {
+			_, _ = z85.Encode(source)
+		})
+		if allocs != 1 {
+			t.Fatalf(`Encoding of %d bytes needed %.1f allocations instead of 1`, size, allocs)
+		}
+	}
+}
+
+// TestSmallWrappedDecodeAllocations tests if small wrapped data is decoded with only one allocation for the result.
+func TestSmallWrappedDecodeAllocations(t *testing.T) {
+	wrapped := z85.StdEncoding.WithWrap(20)
+	source, _ := wrapped.EncodeToString(make([]byte, 32))
+	if !strings.Contains(source, "\n") {
+		t.Fatal(`Wrapped encoding does not contain line breaks`)
+	}
+
+	allocs := testing.AllocsPerRun(iterationCount, func() {
+		_, _ = wrapped.DecodeString(source)
+	})
+	if allocs != 1 {
+		t.Fatalf(`Decoding of wrapped data needed %.1f allocations instead of 1`, allocs)
+	}
+
+	sourceBytes := []byte(source)
+	destination := make([]byte, 32)
+	allocs = testing.AllocsPerRun(iterationCount, func() {
+		_, _ = wrapped.Decode(destination, sourceBytes)
+	})
+	if allocs != 0 {
+		t.Fatalf(`Decoding of wrapped data into a buffer needed %.1f allocations instead of 0`, allocs)
+	}
+}
+
+// TestSmallEncodeLimits tests if data at the size limit of the stack buffer is encoded correctly.
+func TestSmallEncodeLimits(t *testing.T) {
+	for _, size := range []int{96, 100, 104, 108} {
+		source := make([]byte, size)
+		for i := range source {
+			source[i] = byte(i)
+		}
+
+		for _, encoding := range []*z85.Encoding{z85.StdEncoding, z85.StdEncoding.WithWrap(10), z85.StdEncoding.WithPadding()} {
+			encoded, err := encoding.EncodeToString(source)
+			if err != nil {
+				t.Fatalf(`Encoding of %d bytes failed: %v`, size, err)
+			}
+
+			decoded, err := encoding.DecodeString(encoded)
+			if err != nil {
+				t.Fatalf(`Decoding of %d bytes failed: %v`, size, err)
+			}
+
+			if string(decoded) != string(source) {
+				t.Fatalf(`Round trip of %d bytes failed`, size)
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.1.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//    2026-10-17: V1.1.0: Add Z85Check.
+//
+
+package z85
+
+// ******** Public types ********
+
+// FormatSpec is a machine-readable description of an encoding format.
+// It is meant to be consumed by other implementations to generate conformant codecs.
+type FormatSpec struct {
+	// Name is the name of the format.
+	Name string `json:"name"`
+	// Reference is the location of the specification of the format.
+	Reference string `json:"reference"`
+	// Alphabet contains the encoding characters in the order of their values.
+	Alphabet string `json:"alphabet"`
+	// RawChunkSize is the number of raw bytes that are encoded together.
+	RawChunkSize int `json:"rawChunkSize"`
+	// EncodedChunkSize is the number of characters a raw chunk is encoded to.
+	EncodedChunkSize int `json:"encodedChunkSize"`
+	// ByteOrder is the byte order in which a raw chunk is interpreted as a number.
+	ByteOrder string `json:"byteOrder"`
+	// Padding describes how data is handled whose length is not a multiple of RawChunkSize.
+	Padding string `json:"padding"`
+	// Checksum is the name of the checksum algorithm.
+	Checksum string `json:"checksum"`
+	// Prefix is a fixed string that precedes the encoded data.
+	Prefix string `json:"prefix,omitempty"`
+}
+
+// ******** Private constants ********
+
+// nameZ85 is the name of the Z85 format.
+const nameZ85 = `Z85`
+
+// nameJSONSafe is the name of the JSON-safe Z85 format.
+const nameJSONSafe = `Z85-JSONSafe`
+
+// namePadded is the name of the padded Z85 format.
+const namePadded = `Z85P`
+
+// nameRFC1924 is the name of the RFC 1924 format.
+const nameRFC1924 = `RFC1924`
+
+// byteOrderBigEndian is the name of the big-endian byte order.
+const byteOrderBigEndian = `big-endian`
+
+// paddingNone is the padding description of formats without padding.
+const paddingNone = `none: the raw length must be a multiple of the raw chunk size`
+
+// paddingCountSuffix is the padding description of formats that append the pad count.
+const paddingCountSuffix = `zero bytes up to the raw chunk size; the number of padding bytes (0 to 3) is encoded as one trailing character`
+
+// checksumNone is the checksum name of formats without checksum.
+const checksumNone = `none`
+
+// checksumCRC32 is the checksum name of formats that append a CRC-32 chunk.
+const checksumCRC32 = `CRC-32 (IEEE) of the raw data, appended as one big-endian chunk`
+
+// ******** Public functions ********
+
+// Spec returns the descriptions of all built-in formats.
+func Spec() []FormatSpec {
+	return []FormatSpec{
+		{
+			Name:             nameZ85,
+			Reference:        `https://rfc.zeromq.org/spec/32`,
+			Alphabet:         encodeTable,
+			RawChunkSize:     byteChunkSize,
+			EncodedChunkSize: encodedChunkSize,
+			ByteOrder:        byteOrderBigEndian,
+			Padding:          paddingNone,
+			Checksum:         checksumNone,
+		},
+		{
+			Name:             nameJSONSafe,
+			Reference:        `https://rfc.zeromq.org/spec/32`,
+			Alphabet:         encodeTable,
+			RawChunkSize:     byteChunkSize,
+			EncodedChunkSize: encodedChunkSize,
+			ByteOrder:        byteOrderBigEndian,
+			Padding:          paddingNone,
+			Checksum:         checksumNone,
+			Prefix:           string(jsonSafePrefix),
+		},
+		{
+			Name:             namePadded,
+			Reference:        `https://rfc.zeromq.org/spec/32`,
+			Alphabet:         encodeTable,
+			RawChunkSize:     byteChunkSize,
+			EncodedChunkSize: encodedChunkSize,
+			ByteOrder:        byteOrderBigEndian,
+			Padding:          paddingCountSuffix,
+			Checksum:         checksumNone,
+		},
+		{
+			Name:             `Z85Check`,
+			Reference:        `https://rfc.zeromq.org/spec/32`,
+			Alphabet:         encodeTable,
+			RawChunkSize:     byteChunkSize,
+			EncodedChunkSize: encodedChunkSize,
+			ByteOrder:        byteOrderBigEndian,
+			Padding:          paddingNone,
+			Checksum:         checksumCRC32,
+		},
+		{
+			Name:             nameRFC1924,
+			Reference:        `https://www.rfc-editor.org/rfc/rfc1924`,
+			Alphabet:         rfc1924Alphabet,
+			RawChunkSize:     byteChunkSize,
+			EncodedChunkSize: encodedChunkSize,
+			ByteOrder:        byteOrderBigEndian,
+			Padding:          paddingNone,
+			Checksum:         checksumNone,
+		},
+	}
+}
@@ -0,0 +1,76 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"encoding/json"
+	"github.com/xformerfhs/z85"
+	"reflect"
+	"testing"
+)
+
+// ******** Test functions ********
+
+// TestSpecZ85 tests if the Z85 specification describes the implemented encoding.
+func TestSpecZ85(t *testing.T) {
+	spec := z85.Spec()[0]
+	if spec.Name != `Z85` {
+		t.Fatalf(`First format is not Z85, but '%s'`, spec.Name)
+	}
+
+	if len(spec.Alphabet) != 85 {
+		t.Fatalf(`Alphabet has length %d`, len(spec.Alphabet))
+	}
+
+	// The value 84 is encoded with the last character of the alphabet.
+	encoded, _ := z85.Encode([]byte{0, 0, 0, 84})
+	if encoded[spec.EncodedChunkSize-1] != spec.Alphabet[84] {
+		t.Fatalf(`Alphabet does not match encoding: '%s'`, encoded)
+	}
+}
+
+// TestSpecJSON tests if the specifications survive a JSON round trip.
+func TestSpecJSON(t *testing.T) {
+	specs := z85.Spec()
+
+	data, err := json.Marshal(specs)
+	if err != nil {
+		t.Fatalf(`Marshalling failed: %v`, err)
+	}
+
+	var unmarshalled []z85.FormatSpec
+	err = json.Unmarshal(data, &unmarshalled)
+	if err != nil {
+		t.Fatalf(`Unmarshalling failed: %v`, err)
+	}
+
+	if !reflect.DeepEqual(specs, unmarshalled) {
+		t.Fatalf(`Specifications changed in JSON round trip: %s`, data)
+	}
+}
@@ -0,0 +1,543 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// ******** Private constants ********
+
+// streamChunkCount is the number of chunks that a stream processes at once.
+const streamChunkCount = 256
+
+// minDecoderChunkCount is the minimum number of chunks that a decoder processes at once.
+// Its buffer must hold a chunk, the lookahead of a padded encoding and a held back character.
+const minDecoderChunkCount = 4
+
+// paddedLookahead is the number of characters that must follow a chunk of a padded encoding,
+// so that it is known not to be the last chunk.
+const paddedLookahead = 2
+
+// ******** Private variables ********
+
+// errClosed is returned when data is written to a closed stream.
+var errClosed = errors.New(`write to closed stream`)
+
+// ******** Private types ********
+
+// encoder is a streaming encoder that writes the encoding of the data written to it to an io.Writer.
+type encoder struct {
+	encoding *Encoding
+	w        io.Writer
+	err      error
+	count    int64
+	buf      [byteChunkSize]byte
+	nbuf     int
+	out      [streamChunkCount * encodedChunkSize]byte
+	closed   bool
+	column   int
+}
+
+// decoder is a streaming decoder that decodes the data read from an io.Reader.
+// If the reader is a bufio.Reader, the chunks are decoded directly from its buffer
+// and the buffer of the decoder only holds the end of the stream.
+type decoder struct {
+	encoding *Encoding
+	r        io.Reader
+	br       *bufio.Reader
+	err      error
+	count    int64
+	buf      []byte
+	nbuf     int
+	out      []byte
+	outbuf   []byte
+	partial  bool
+	held     [utf8.UTFMax - 1]byte
+	nheld    int
+	column   int
+}
+
+// ******** Public creation functions ********
+
+// NewEncoder returns a new Z85 stream encoder.
+// Data written to the returned writer is encoded and written to w.
+// Bytes of an incomplete chunk are buffered until the next write.
+// The caller must call Close to finish the encoding.
+// Close returns an error, if the length of the data written is not a multiple of 4.
+func NewEncoder(w io.Writer) io.WriteCloser {
+	return StdEncoding.NewEncoder(w)
+}
+
+// NewEncoder returns a new stream encoder for the encoding e.
+// It works like the package level function NewEncoder.
+// If e is a padded encoding, Close writes the padded last chunk and the pad count instead of returning an error.
+func (e *Encoding) NewEncoder(w io.Writer) io.WriteCloser {
+	return newEncoder(e, w)
+}
+
+// NewDecoder returns a new Z85 stream decoder.
+// It reads the encoded data from r and buffers incomplete chunks between reads,
+// so r does not need to deliver the data in multiples of 5 bytes.
+// If r is a *bufio.Reader, the data is decoded directly from its buffer instead of being copied
+// into a second one.
+// Errors are CodecErrors with the offsets in the stream.
+// A trailing incomplete chunk is an error.
+func NewDecoder(r io.Reader) io.Reader {
+	return StdEncoding.NewDecoder(r)
+}
+
+// NewDecoderSize returns a new Z85 stream decoder that works like NewDecoder,
+// but decodes up to size encoded bytes at once instead of 1280.
+// The size is rounded up to a multiple of 5 and is at least 20.
+// If r is a *bufio.Reader, the size is also limited by the size of its buffer.
+func NewDecoderSize(r io.Reader, size int) io.Reader {
+	return StdEncoding.NewDecoderSize(r, size)
+}
+
+// NewDecoder returns a new stream decoder for the encoding e.
+// It works like the package level function NewDecoder.
+// If e is a wrapping encoding, line breaks are ignored and the offsets in errors do not count them.
+// The same holds for the transparent characters of e.
+func (e *Encoding) NewDecoder(r io.Reader) io.Reader {
+	return newDecoder(e, r, streamChunkCount*encodedChunkSize)
+}
+
+// NewDecoderSize returns a new stream decoder for the encoding e that decodes up to size encoded bytes at once.
+// It works like the package level function NewDecoderSize.
+func (e *Encoding) NewDecoderSize(r io.Reader, size int) io.Reader {
+	return newDecoder(e, r, size)
+}
+
+// NewPartialDecoder returns a new Z85 stream decoder that delivers all data in front of an error.
+// It works like NewDecoder, but when it encounters an invalid chunk, it first returns the decoded data
+// of all chunks before it and only then the error.
+// This makes it possible to salvage the valid prefix of a damaged transfer.
+func NewPartialDecoder(r io.Reader) io.Reader {
+	return StdEncoding.NewPartialDecoder(r)
+}
+
+// NewPartialDecoder returns a new stream decoder for the encoding e that delivers all data in front of an error.
+// It works like the package level function NewPartialDecoder.
+func (e *Encoding) NewPartialDecoder(r io.Reader) io.Reader {
+	d := newDecoder(e, r, streamChunkCount*encodedChunkSize)
+	d.partial = true
+	return d
+}
+
+// ******** Private creation functions ********
+
+// newEncoder creates a new streaming encoder for the encoding e that writes to w.
+func newEncoder(e *Encoding, w io.Writer) *encoder {
+	return &encoder{encoding: e, w: w}
+}
+
+// newDecoder creates a new streaming decoder for the encoding e that reads from r
+// and decodes up to size encoded bytes at once.
+func newDecoder(e *Encoding, r io.Reader, size int) *decoder {
+	d := &decoder{encoding: e, r: r}
+
+	chunkCount := max((size+encodedChunkSize-1)/encodedChunkSize, minDecoderChunkCount)
+	bufSize := chunkCount * encodedChunkSize
+
+	// Line breaks and transparent characters are removed in place, which can not be done in the buffer
+	// of a bufio.Reader.
+	if br, isBuffered := r.(*bufio.Reader); isBuffered && e.wrap == 0 && e.transparent == nil {
+		d.br = br
+		chunkCount = max(min(chunkCount, br.Size()/encodedChunkSize), minDecoderChunkCount)
+		bufSize = minDecoderChunkCount * encodedChunkSize
+	}
+
+	// Both buffers are allocated at once.
+	buffer := make([]byte, bufSize+chunkCount*byteChunkSize)
+	d.buf = buffer[:bufSize:bufSize]
+	d.outbuf = buffer[bufSize:]
+
+	return d
+}
+
+// ******** Private functions ********
+
+// Write encodes p and writes the encoding of all complete chunks to the underlying writer.
+// Bytes of an incomplete chunk are kept until the next call.
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+
+	n := 0
+
+	// Complete a chunk that was started in a previous call.
+	if e.nbuf > 0 {
+		copied := copy(e.buf[e.nbuf:], p)
+		e.nbuf += copied
+		n += copied
+		p = p[copied:]
+
+		if e.nbuf < byteChunkSize {
+			return n, nil
+		}
+
+		e.encoding.encodeChunks(e.out[:], e.buf[:])
+		if e.err = e.writeOut(encodedChunkSize); e.err != nil {
+			return n, e.err
+		}
+
+		e.nbuf = 0
+		e.count += byteChunkSize
+	}
+
+	// Encode complete chunks.
+	for len(p) >= byteChunkSize {
+		chunkLen := min(len(p)&^byteChunkMask, streamChunkCount*byteChunkSize)
+		e.encoding.encodeChunks(e.out[:], p[:chunkLen])
+		if e.err = e.writeOut(chunkLen + (chunkLen >> byteChunkShift)); e.err != nil {
+			return n, e.err
+		}
+
+		n += chunkLen
+		p = p[chunkLen:]
+		e.count += int64(chunkLen)
+	}
+
+	// Keep the rest for the next call.
+	e.nbuf = copy(e.buf[:], p)
+	n += e.nbuf
+
+	return n, nil
+}
+
+// Close finishes the encoding.
+// It returns an error, if the length of the data written is not a multiple of 4.
+// For a padded encoding it writes the last chunk and the pad count instead.
+// It does not close the underlying writer.
+// Calling Close more than once has no effect.
+func (e *encoder) Close() error {
+	if e.closed {
+		return nil
+	}
+
+	e.closed = true
+
+	err := e.err
+	if err == nil {
+		if e.encoding.padded {
+			err = e.writePadding()
+		} else if e.nbuf > 0 {
+			err = newInvalidLengthError(byteChunkSize, uint(e.count))
+		}
+	}
+
+	e.err = errClosed
+
+	return err
+}
+
+// writePadding writes the padded last chunk and the pad count of a padded encoding.
+func (e *encoder) writePadding() error {
+	if e.count == 0 && e.nbuf == 0 {
+		return nil
+	}
+
+	n := 0
+	padCount := 0
+	if e.nbuf > 0 {
+		padCount = byteChunkSize - e.nbuf
+		clear(e.buf[e.nbuf:])
+		e.encoding.encodeChunks(e.out[:], e.buf[:])
+		n = encodedChunkSize
+	}
+
+	e.out[n] = e.encoding.encodeTable[padCount]
+
+	return e.writeOut(n + 1)
+}
+
+// writeOut writes the first n bytes of the output buffer to the underlying writer.
+func (e *encoder) writeOut(n int) error {
+	if e.encoding.wrap > 0 {
+		return e.writeWrapped(e.out[:n])
+	}
+
+	_, err := e.w.Write(e.out[:n])
+	return err
+}
+
+// writeWrapped writes p to the underlying writer and inserts a line feed whenever a line is full.
+// The line feed is only written before the next character, so the last line is not terminated.
+func (e *encoder) writeWrapped(p []byte) error {
+	for len(p) > 0 {
+		if e.column == e.encoding.wrap {
+			if _, err := e.w.Write([]byte{lineFeed}); err != nil {
+				return err
+			}
+
+			e.column = 0
+		}
+
+		n := min(e.encoding.wrap-e.column, len(p))
+		if _, err := e.w.Write(p[:n]); err != nil {
+			return err
+		}
+
+		e.column += n
+		p = p[n:]
+	}
+
+	return nil
+}
+
+// Read decodes the data from the underlying reader into p.
+func (d *decoder) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	// Return decoded data from a previous call first.
+	if len(d.out) > 0 {
+		n := copy(p, d.out)
+		d.out = d.out[n:]
+		return n, nil
+	}
+
+	// A reader may return its last bytes together with io.EOF, so the rest in the buffer has to be decoded
+	// or reported as an incomplete chunk before io.EOF is returned.
+	if d.err != nil && (d.err != io.EOF || d.nbuf == 0) {
+		return 0, d.err
+	}
+
+	// Read at least one complete chunk or until an error occurs.
+	// The last chunk of a padded encoding is followed by the pad count only,
+	// so a chunk is only known not to be the last one, if it is followed by at least 2 more characters.
+	lookahead := 0
+	if d.encoding.padded {
+		lookahead = paddedLookahead
+	}
+
+	if d.br != nil && d.nbuf == 0 && d.err == nil {
+		if peeked := d.peek(lookahead); peeked != nil {
+			return d.readPeeked(p, peeked, lookahead)
+		}
+	}
+
+	for d.nbuf < encodedChunkSize+lookahead && d.err == nil {
+		// The start of a character that may be transparent has been held back by the previous read.
+		n := copy(d.buf[d.nbuf:], d.held[:d.nheld])
+		d.nheld = 0
+
+		var read int
+		read, d.err = d.r.Read(d.buf[d.nbuf+n:])
+		n += read
+		if d.encoding.wrap > 0 {
+			n = d.removeLineBreaks(d.buf[d.nbuf : d.nbuf+n])
+		}
+
+		if d.encoding.transparent != nil {
+			n = d.removeTransparent(d.buf[d.nbuf : d.nbuf+n])
+		}
+
+		d.nbuf += n
+	}
+
+	if d.err == io.EOF && d.encoding.padded && d.nbuf > 0 {
+		return d.readLast(p)
+	}
+
+	chunkLen := max(d.nbuf-lookahead, 0)
+	chunkLen -= chunkLen % encodedChunkSize
+	if chunkLen == 0 {
+		if d.err == io.EOF && d.nbuf > 0 {
+			d.err = newInvalidLengthError(encodedChunkSize, uint(d.count))
+		}
+
+		return 0, d.err
+	}
+
+	err := decodeChunks(d.encoding, d.outbuf, d.buf[:chunkLen], uint(d.count))
+	if err != nil {
+		d.err = err
+		if !d.partial {
+			return 0, err
+		}
+
+		// The chunks in front of the invalid one have already been decoded into the output buffer.
+		chunkLen = d.validLength(err)
+		if chunkLen == 0 {
+			return 0, err
+		}
+	}
+
+	d.nbuf = copy(d.buf, d.buf[chunkLen:d.nbuf])
+
+	return d.deliver(p, chunkLen)
+}
+
+// peek returns the encoded characters in the buffer of the bufio.Reader that can be decoded at once.
+// It reads more data, if the buffer does not contain a complete chunk and the lookahead.
+// If the stream ends or fails before, the rest is moved into the buffer of the decoder and nil is returned.
+func (d *decoder) peek(lookahead int) []byte {
+	needed := encodedChunkSize + lookahead
+	if d.br.Buffered() < needed {
+		// The error is only returned once by the bufio.Reader, so it has to be kept, if there is not enough data.
+		peeked, err := d.br.Peek(needed)
+		if err != nil {
+			d.nbuf = copy(d.buf, peeked)
+			_, _ = d.br.Discard(d.nbuf)
+			d.err = err
+			return nil
+		}
+	}
+
+	// Buffered data is returned without reading, so no error can occur.
+	peeked, _ := d.br.Peek(min(d.br.Buffered(), len(d.outbuf)/byteChunkSize*encodedChunkSize))
+
+	return peeked
+}
+
+// readPeeked decodes the complete chunks of peeked, which is in the buffer of the bufio.Reader, into p
+// and discards them from the bufio.Reader.
+func (d *decoder) readPeeked(p []byte, peeked []byte, lookahead int) (int, error) {
+	chunkLen := len(peeked) - lookahead
+	chunkLen -= chunkLen % encodedChunkSize
+
+	err := decodeChunks(d.encoding, d.outbuf, peeked[:chunkLen], uint(d.count))
+	if err != nil {
+		d.err = err
+		if !d.partial {
+			return 0, err
+		}
+
+		chunkLen = d.validLength(err)
+		if chunkLen == 0 {
+			return 0, err
+		}
+	}
+
+	_, _ = d.br.Discard(chunkLen)
+
+	return d.deliver(p, chunkLen)
+}
+
+// deliver copies the decoding of chunkLen encoded characters from the output buffer into p
+// and keeps the rest for the next call.
+func (d *decoder) deliver(p []byte, chunkLen int) (int, error) {
+	d.count += int64(chunkLen)
+	d.out = d.outbuf[:(chunkLen/encodedChunkSize)*byteChunkSize]
+
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+
+	return n, nil
+}
+
+// removeTransparent removes the transparent characters from the data that has just been read into buffer
+// and returns the remaining length.
+// An incomplete character at the end is held back until the next read, as it may become a transparent one.
+func (d *decoder) removeTransparent(buffer []byte) int {
+	n := removeTransparentInPlace(d.encoding, buffer)
+	if d.err != nil {
+		return n
+	}
+
+	d.nheld = copy(d.held[:], buffer[n-incompleteRuneLen(buffer[:n]):n])
+
+	return n - d.nheld
+}
+
+// removeLineBreaks removes the line breaks from the data that has just been read into buffer
+// and returns the remaining length.
+// If the encoding has a maximum line length, the data is cut in front of the first character beyond it
+// and the error is kept for the next read.
+func (d *decoder) removeLineBreaks(buffer []byte) int {
+	maxLine := d.encoding.maxLine
+	if maxLine == 0 {
+		return removeLineBreaksInPlace(buffer)
+	}
+
+	n := 0
+	for _, b := range buffer {
+		switch b {
+		case lineFeed:
+			d.column = 0
+			continue
+		case carriageReturn:
+			continue
+		}
+
+		if d.column == maxLine {
+			d.err = newLineTooLongError(maxLine, uint(d.count)+uint(d.nbuf+n))
+			return n
+		}
+
+		d.column++
+		buffer[n] = b
+		n++
+	}
+
+	return n
+}
+
+// validLength returns the number of characters in the buffer in front of the chunk that caused err.
+func (d *decoder) validLength(err error) int {
+	var codecErr *CodecError
+	if !errors.As(err, &codecErr) {
+		return 0
+	}
+
+	validLen := int(codecErr.EncodedOffset - d.count)
+
+	return validLen - validLen%encodedChunkSize
+}
+
+// readLast decodes the rest of a padded encoding at the end of the stream into p.
+func (d *decoder) readLast(p []byte) (int, error) {
+	dataLen := d.nbuf - 1
+	if dataLen%encodedChunkSize != 0 {
+		d.err = newInvalidLengthError(encodedChunkSize, uint(d.count)+uint(dataLen-dataLen%encodedChunkSize))
+		return 0, d.err
+	}
+
+	decodedLen, err := decodePadded(d.encoding, d.outbuf, d.buf[:d.nbuf], uint(d.count))
+	if err != nil {
+		d.err = err
+		return 0, err
+	}
+
+	d.count += int64(d.nbuf)
+	d.nbuf = 0
+	d.out = d.outbuf[:decodedLen]
+
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+
+	return n, nil
+}
@@ -0,0 +1,509 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bufio"
+	"bytes"
+	crand "crypto/rand"
+	"errors"
+	"github.com/xformerfhs/z85"
+	"github.com/xformerfhs/z85/z85test"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// ******** Test functions ********
+
+// TestEncoder tests if the stream encoder has the same result as Encode for different write sizes.
+func TestEncoder(t *testing.T) {
+	data := make([]byte, 10000)
+	_, _ = crand.Read(data)
+
+	expected, _ := z85.Encode(data)
+
+	for _, writeSize := range []int{1, 3, 4, 7, 1024, 5000, len(data)} {
+		var out strings.Builder
+		enc := z85.NewEncoder(&out)
+
+		for i := 0; i < len(data); i += writeSize {
+			n, err := enc.Write(data[i:min(i+writeSize, len(data))])
+			if err != nil {
+				t.Fatalf(`Write with size %d failed: %v`, writeSize, err)
+			}
+			if n != min(writeSize, len(data)-i) {
+				t.Fatalf(`Write with size %d wrote %d bytes`, writeSize, n)
+			}
+		}
+
+		err := enc.Close()
+		if err != nil {
+			t.Fatalf(`Close with write size %d failed: %v`, writeSize, err)
+		}
+
+		if out.String() != expected {
+			t.Fatalf(`Encoding with write size %d differs from encoding of slice`, writeSize)
+		}
+	}
+}
+
+// TestEncoderCopy tests if the stream encoder works with io.Copy.
+func TestEncoderCopy(t *testing.T) {
+	data := make([]byte, 100000)
+	_, _ = crand.Read(data)
+
+	var out bytes.Buffer
+	enc := z85.NewEncoder(&out)
+
+	_, err := io.Copy(enc, bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf(`Copy failed: %v`, err)
+	}
+
+	err = enc.Close()
+	if err != nil {
+		t.Fatalf(`Close failed: %v`, err)
+	}
+
+	decoded, err := z85.DecodeBytes(out.Bytes())
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, data) {
+		t.Fatal(`Decoded data differs from source data`)
+	}
+}
+
+// TestEncoderEmpty tests if no data results in no output.
+func TestEncoderEmpty(t *testing.T) {
+	var out strings.Builder
+	enc := z85.NewEncoder(&out)
+
+	err := enc.Close()
+	if err != nil {
+		t.Fatalf(`Close failed: %v`, err)
+	}
+
+	if out.Len() != 0 {
+		t.Fatalf(`Output is '%s', but should be empty`, out.String())
+	}
+}
+
+// TestEncoderPartialChunk tests if Close rejects a trailing partial chunk.
+func TestEncoderPartialChunk(t *testing.T) {
+	var out strings.Builder
+	enc := z85.NewEncoder(&out)
+
+	_, _ = enc.Write(clearTheOne[:5])
+
+	err := enc.Close()
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: %v`, err)
+	}
+
+	if codecErr.RawOffset != 4 || codecErr.EncodedOffset != 5 {
+		t.Fatalf(`Offsets are %d and %d, but should be 4 and 5`, codecErr.RawOffset, codecErr.EncodedOffset)
+	}
+
+	if out.String() != encodedTheOne[:5] {
+		t.Fatalf(`Output is '%s', but should be '%s'`, out.String(), encodedTheOne[:5])
+	}
+}
+
+// TestEncoderClosed tests if writes after Close fail and Close can be called twice.
+func TestEncoderClosed(t *testing.T) {
+	var out strings.Builder
+	enc := z85.NewEncoder(&out)
+
+	_, _ = enc.Write(clearTheOne)
+
+	err := enc.Close()
+	if err != nil {
+		t.Fatalf(`Close failed: %v`, err)
+	}
+
+	err = enc.Close()
+	if err != nil {
+		t.Fatalf(`Second Close failed: %v`, err)
+	}
+
+	_, err = enc.Write(clearTheOne)
+	if err == nil {
+		t.Fatal(`Write after Close did not fail`)
+	}
+}
+
+// TestEncoderWriteError tests if an error of the underlying writer is returned.
+func TestEncoderWriteError(t *testing.T) {
+	data := make([]byte, 8192)
+
+	enc := z85.NewEncoder(z85test.NewFaultyWriter(io.Discard, 3000, nil))
+
+	_, err := enc.Write(data)
+	if !errors.Is(err, z85test.ErrInjected) {
+		t.Fatalf(`Expected injected error, but got: %v`, err)
+	}
+
+	_, err = enc.Write(data)
+	if !errors.Is(err, z85test.ErrInjected) {
+		t.Fatalf(`Expected injected error on second write, but got: %v`, err)
+	}
+
+	err = enc.Close()
+	if !errors.Is(err, z85test.ErrInjected) {
+		t.Fatalf(`Expected injected error on Close, but got: %v`, err)
+	}
+}
+
+// TestEncodingNewEncoder tests if a stream encoder of a custom encoding has the same result as its EncodeToString.
+func TestEncodingNewEncoder(t *testing.T) {
+	enc, _ := z85.NewEncoding(reversedAlphabet)
+
+	data := make([]byte, 2000)
+	_, _ = crand.Read(data)
+
+	expected, _ := enc.EncodeToString(data)
+
+	var out strings.Builder
+	w := enc.NewEncoder(&out)
+	_, _ = w.Write(data[:1001])
+	_, _ = w.Write(data[1001:])
+
+	err := w.Close()
+	if err != nil {
+		t.Fatalf(`Close failed: %v`, err)
+	}
+
+	if out.String() != expected {
+		t.Fatal(`Stream encoding differs from encoding of slice`)
+	}
+}
+
+// TestDecoder tests if the stream decoder has the same result as Decode for readers that deliver odd amounts of data.
+func TestDecoder(t *testing.T) {
+	data := make([]byte, 10000)
+	_, _ = crand.Read(data)
+
+	encoded, _ := z85.Encode(data)
+
+	readers := map[string]io.Reader{
+		`plain`:    strings.NewReader(encoded),
+		`one byte`: iotest.OneByteReader(strings.NewReader(encoded)),
+		`half`:     iotest.HalfReader(strings.NewReader(encoded)),
+		`data EOF`: iotest.DataErrReader(strings.NewReader(encoded)),
+	}
+
+	for name, r := range readers {
+		decoded, err := io.ReadAll(z85.NewDecoder(r))
+		if err != nil {
+			t.Fatalf(`Decoding of %s reader failed: %v`, name, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoding of %s reader differs from source data`, name)
+		}
+	}
+}
+
+// TestDecoderSmallReads tests if the stream decoder works with reads that are smaller than a chunk.
+func TestDecoderSmallReads(t *testing.T) {
+	dec := z85.NewDecoder(strings.NewReader(encodedTheOne))
+
+	decoded, err := io.ReadAll(iotest.OneByteReader(dec))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, clearTheOne) {
+		t.Fatalf(`Decoded data is '% 02x', but should be '% 02x'`, decoded, clearTheOne)
+	}
+}
+
+// TestDecoderEmpty tests if an empty reader results in no data.
+func TestDecoderEmpty(t *testing.T) {
+	decoded, err := io.ReadAll(z85.NewDecoder(strings.NewReader(``)))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if len(decoded) != 0 {
+		t.Fatalf(`Decoded data is '% 02x', but should be empty`, decoded)
+	}
+}
+
+// TestDecoderPartialChunk tests if a trailing partial chunk is an error with the correct offsets.
+func TestDecoderPartialChunk(t *testing.T) {
+	decoded, err := io.ReadAll(z85.NewDecoder(iotest.OneByteReader(strings.NewReader(encodedTheOne[:8]))))
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Expected invalid length error, but got: %v`, err)
+	}
+
+	checkStreamOffsets(t, err, 4, 5)
+
+	if !bytes.Equal(decoded, clearTheOne[:4]) {
+		t.Fatalf(`Decoded data is '% 02x', but should be '% 02x'`, decoded, clearTheOne[:4])
+	}
+}
+
+// TestDecoderTruncatedWithEOF tests if a trailing partial chunk is an error, when the reader returns it with io.EOF.
+func TestDecoderTruncatedWithEOF(t *testing.T) {
+	readers := map[string]func(string) io.Reader{
+		`data EOF`: func(s string) io.Reader { return iotest.DataErrReader(strings.NewReader(s)) },
+		`one byte`: func(s string) io.Reader { return iotest.OneByteReader(strings.NewReader(s)) },
+	}
+
+	for name, newReader := range readers {
+		for _, encoding := range []*z85.Encoding{z85.StdEncoding, z85.PaddedEncoding} {
+			for _, truncated := range []string{`HelloWorl`, `HelloWo`, `Hello12`} {
+				_, err := io.ReadAll(encoding.NewDecoder(newReader(truncated)))
+				if !z85.IsErrInvalidLength(err) {
+					t.Fatalf(`Expected invalid length error for '%s' with %s reader, but got: %v`, truncated, name, err)
+				}
+			}
+		}
+
+		padded, _ := z85.PaddedEncoding.EncodeToString(clearTheOne[:7])
+		decoded, err := io.ReadAll(z85.PaddedEncoding.NewDecoder(newReader(padded)))
+		if err != nil {
+			t.Fatalf(`Decoding of padded data with %s reader failed: %v`, name, err)
+		}
+
+		if !bytes.Equal(decoded, clearTheOne[:7]) {
+			t.Fatalf(`Decoded data is '% 02x', but should be '% 02x'`, decoded, clearTheOne[:7])
+		}
+	}
+}
+
+// TestDecoderSize tests if decoders with different buffer sizes decode data of different lengths.
+func TestDecoderSize(t *testing.T) {
+	data := make([]byte, 3000)
+	_, _ = crand.Read(data)
+
+	for _, encoding := range []*z85.Encoding{z85.StdEncoding, z85.PaddedEncoding} {
+		for _, size := range []int{-1, 1, 7, 21, 100, 5000} {
+			for _, length := range []int{0, 4, 8, 400, 2000, 3000} {
+				encoded, _ := encoding.EncodeToString(data[:length])
+
+				for name, r := range map[string]io.Reader{
+					`plain`:    strings.NewReader(encoded),
+					`one byte`: iotest.OneByteReader(strings.NewReader(encoded)),
+					`bufio`:    bufio.NewReaderSize(iotest.DataErrReader(strings.NewReader(encoded)), 16),
+				} {
+					decoded, err := io.ReadAll(encoding.NewDecoderSize(r, size))
+					if err != nil {
+						t.Fatalf(`Decoding of %d bytes with size %d from %s reader failed: %v`, length, size, name, err)
+					}
+
+					if !bytes.Equal(decoded, data[:length]) {
+						t.Fatalf(`Decoding of %d bytes with size %d from %s reader resulted in wrong data`, length, size, name)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestDecoderBufio tests if the decoder reads from the buffer of a bufio.Reader
+// and leaves the data after the decoded chunks in it.
+func TestDecoderBufio(t *testing.T) {
+	data := make([]byte, 2000)
+	_, _ = crand.Read(data)
+	encoded, _ := z85.Encode(data)
+
+	for _, size := range []int{16, 100, 4096} {
+		br := bufio.NewReaderSize(iotest.OneByteReader(strings.NewReader(encoded+` rest`)), size)
+		decoder := z85.NewDecoder(br)
+
+		decoded := make([]byte, len(data))
+		if _, err := io.ReadFull(decoder, decoded); err != nil {
+			t.Fatalf(`Decoding with a buffer of %d bytes failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoding with a buffer of %d bytes resulted in wrong data`, size)
+		}
+
+		rest, _ := io.ReadAll(br)
+		if string(rest) != ` rest` {
+			t.Fatalf(`The bufio.Reader with a buffer of %d bytes contains '%s' after the decoded data`, size, rest)
+		}
+	}
+
+	invalid := encoded[:1000] + `~` + encoded[1001:]
+	for _, partial := range []bool{false, true} {
+		br := bufio.NewReaderSize(strings.NewReader(invalid), 64)
+
+		var decoder io.Reader
+		if partial {
+			decoder = z85.NewPartialDecoder(br)
+		} else {
+			decoder = z85.NewDecoder(br)
+		}
+
+		decoded, err := io.ReadAll(decoder)
+
+		var codecErr *z85.CodecError
+		if !errors.As(err, &codecErr) {
+			t.Fatalf(`Error is not a CodecError: %v`, err)
+		}
+
+		checkCodecError(t, codecErr, z85.KindInvalidByte, 800, 1000, '~')
+
+		if partial && !bytes.Equal(decoded, data[:800]) {
+			t.Fatalf(`Partial decoder delivered %d bytes instead of 800`, len(decoded))
+		}
+	}
+
+	wrapped, _ := z85.StdEncoding.WithWrap(76).EncodeToString(data)
+	decoded, err := io.ReadAll(z85.StdEncoding.WithWrap(76).NewDecoder(bufio.NewReaderSize(strings.NewReader(wrapped), 16)))
+	if err != nil || !bytes.Equal(decoded, data) {
+		t.Fatalf(`Decoding of wrapped data from a bufio.Reader failed: %v`, err)
+	}
+}
+
+// TestDecoderInvalidByte tests if an invalid byte is reported with its offset in the stream.
+func TestDecoderInvalidByte(t *testing.T) {
+	data := make([]byte, 4000)
+	encoded, _ := z85.Encode(data)
+	encoded = encoded[:3002] + `~` + encoded[3003:]
+
+	_, err := io.ReadAll(z85.NewDecoder(iotest.HalfReader(strings.NewReader(encoded))))
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Expected invalid byte error, but got: %v`, err)
+	}
+
+	checkStreamOffsets(t, err, 2400, 3002)
+}
+
+// TestPartialDecoder tests if the partial decoder delivers all data in front of an invalid chunk.
+func TestPartialDecoder(t *testing.T) {
+	data := make([]byte, 4000)
+	_, _ = crand.Read(data)
+	encoded, _ := z85.Encode(data)
+
+	readers := map[string]func(string) io.Reader{
+		`Plain`: func(s string) io.Reader {
+			return strings.NewReader(s)
+		},
+		`HalfReader`: func(s string) io.Reader {
+			return iotest.HalfReader(strings.NewReader(s))
+		},
+		`OneByteReader`: func(s string) io.Reader {
+			return iotest.OneByteReader(strings.NewReader(s))
+		},
+	}
+
+	for name, newReader := range readers {
+		for _, position := range []int{0, 4, 5, 1283, 3002, 4999} {
+			invalid := encoded[:position] + `~` + encoded[position+1:]
+
+			decoded, err := io.ReadAll(z85.NewPartialDecoder(newReader(invalid)))
+			if !z85.IsErrInvalidByte(err) {
+				t.Fatalf(`%s: Expected invalid byte error at %d, but got: %v`, name, position, err)
+			}
+
+			checkStreamOffsets(t, err, int64(position/5*4), int64(position))
+
+			if !bytes.Equal(decoded, data[:position/5*4]) {
+				t.Fatalf(`%s: Decoded %d bytes for an error at %d, but should be %d`, name, len(decoded), position, position/5*4)
+			}
+		}
+	}
+}
+
+// TestPartialDecoderOverflow tests if the partial decoder delivers all data in front of an overflowing chunk.
+func TestPartialDecoderOverflow(t *testing.T) {
+	decoded, err := io.ReadAll(z85.NewPartialDecoder(strings.NewReader(encodedTheOne + `%nSc1` + encodedTheOne)))
+	if !z85.IsErrOverflow(err) {
+		t.Fatalf(`Expected overflow error, but got: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, clearTheOne) {
+		t.Fatalf(`Decoded data is '% 02x', but should be '% 02x'`, decoded, clearTheOne)
+	}
+}
+
+// TestDecoderReadError tests if an error of the underlying reader is returned.
+func TestDecoderReadError(t *testing.T) {
+	data := make([]byte, 4000)
+	encoded, _ := z85.Encode(data)
+
+	_, err := io.ReadAll(z85.NewDecoder(z85test.NewFaultyReader(strings.NewReader(encoded), 1234, nil)))
+	if !errors.Is(err, z85test.ErrInjected) {
+		t.Fatalf(`Expected injected error, but got: %v`, err)
+	}
+}
+
+// TestEncoderDecoder tests if a stream encoder and a stream decoder can be chained.
+func TestEncoderDecoder(t *testing.T) {
+	enc, _ := z85.NewEncoding(reversedAlphabet)
+
+	data := make([]byte, 5000)
+	_, _ = crand.Read(data)
+
+	pr, pw := io.Pipe()
+	go func() {
+		w := enc.NewEncoder(pw)
+		_, _ = w.Write(data)
+		_ = pw.CloseWithError(w.Close())
+	}()
+
+	decoded, err := io.ReadAll(enc.NewDecoder(pr))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, data) {
+		t.Fatal(`Decoded data differs from source data`)
+	}
+}
+
+// ******** Private functions ********
+
+// checkStreamOffsets checks that err is a CodecError with the expected offsets.
+func checkStreamOffsets(t *testing.T, err error, rawOffset int64, encodedOffset int64) {
+	t.Helper()
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: %v`, err)
+	}
+
+	if codecErr.RawOffset != rawOffset || codecErr.EncodedOffset != encodedOffset {
+		t.Fatalf(`Offsets are %d and %d, but should be %d and %d`, codecErr.RawOffset, codecErr.EncodedOffset, rawOffset, encodedOffset)
+	}
+}
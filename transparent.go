@@ -0,0 +1,220 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"slices"
+	"unicode/utf8"
+)
+
+// ******** Public types ********
+
+// Range is a range of Unicode code points from Lo to Hi, both inclusive.
+type Range struct {
+	Lo rune
+	Hi rune
+}
+
+// ******** Public variables ********
+
+// InvisibleRanges contains the invisible characters that messaging platforms and word processors
+// insert into text: the soft hyphen, the zero width space, non-joiner and joiner, the word joiner
+// and the zero width no-break space, which is also the byte order mark.
+var InvisibleRanges = []Range{
+	{0x00AD, 0x00AD},
+	{0x200B, 0x200D},
+	{0x2060, 0x2060},
+	{0xFEFF, 0xFEFF},
+}
+
+// ******** Public functions ********
+
+// WithTransparent creates a new encoding identical to e except that its decoders skip the UTF-8 encoded
+// characters in the ranges, so text that is pasted from messaging platforms decodes without manual cleanup.
+// Characters of the alphabet are never skipped. No ranges switch the skipping off.
+//
+// The offsets in errors of the block decoding functions are the ones in the text with the skipped characters,
+// the offsets in errors of the stream decoders do not count them.
+func (e *Encoding) WithTransparent(ranges []Range) *Encoding {
+	result := *e
+	result.transparent = nil
+	if len(ranges) > 0 {
+		result.transparent = slices.Clone(ranges)
+	}
+
+	return &result
+}
+
+// Transparent returns a copy of the ranges of the characters that the decoders skip.
+func (e *Encoding) Transparent() []Range {
+	return slices.Clone(e.transparent)
+}
+
+// ******** Private functions ********
+
+// isTransparent reports whether the decoders of e skip the character r.
+func (e *Encoding) isTransparent(r rune) bool {
+	if r < utf8.RuneSelf && e.decodeValue(byte(r)) != ivEc {
+		return false
+	}
+
+	for _, transparent := range e.transparent {
+		if r >= transparent.Lo && r <= transparent.Hi {
+			return true
+		}
+	}
+
+	return false
+}
+
+// transparentLen returns the length of the character at index i of source, which is either a string
+// or a byte slice, if the decoders of e skip it, or 0 otherwise.
+func transparentLen[T string | []byte](e *Encoding, source T, i int) int {
+	b := source[i]
+	if b < utf8.RuneSelf {
+		if e.isTransparent(rune(b)) {
+			return 1
+		}
+
+		return 0
+	}
+
+	r, size := utf8.DecodeRuneInString(string(source[i:min(i+utf8.UTFMax, len(source))]))
+	if r == utf8.RuneError && size <= 1 {
+		return 0
+	}
+
+	if e.isTransparent(r) {
+		return size
+	}
+
+	return 0
+}
+
+// containsTransparent reports whether source, which is either a string or a byte slice,
+// contains a character that the decoders of e skip.
+func containsTransparent[T string | []byte](e *Encoding, source T) bool {
+	for i := 0; i < len(source); i++ {
+		if transparentLen(e, source, i) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withoutTransparent returns source, which is either a string or a byte slice, without the characters
+// that the decoders of e skip.
+// The result is stored in buffer, if it is large enough, so small data can be kept on the stack.
+func withoutTransparent[T string | []byte](e *Encoding, buffer []byte, source T) []byte {
+	if len(source) > cap(buffer) {
+		buffer = make([]byte, 0, len(source))
+	}
+
+	result := buffer[:0]
+	for i := 0; i < len(source); {
+		if skip := transparentLen(e, source, i); skip > 0 {
+			i += skip
+			continue
+		}
+
+		result = append(result, source[i])
+		i++
+	}
+
+	return result
+}
+
+// removeTransparentInPlace removes the characters that the decoders of e skip from buffer
+// and returns the remaining length.
+func removeTransparentInPlace(e *Encoding, buffer []byte) int {
+	n := 0
+	for i := 0; i < len(buffer); {
+		if skip := transparentLen(e, buffer, i); skip > 0 {
+			i += skip
+			continue
+		}
+
+		buffer[n] = buffer[i]
+		n++
+		i++
+	}
+
+	return n
+}
+
+// incompleteRuneLen returns the length of an incomplete UTF-8 sequence at the end of buffer,
+// which may become a character that is skipped, when the rest of it is read.
+func incompleteRuneLen(buffer []byte) int {
+	for i := len(buffer) - 1; i >= max(len(buffer)-utf8.UTFMax+1, 0); i-- {
+		if utf8.RuneStart(buffer[i]) {
+			if utf8.FullRune(buffer[i:]) {
+				return 0
+			}
+
+			return len(buffer) - i
+		}
+	}
+
+	return 0
+}
+
+// transparentOffset returns the offset in source, which is either a string or a byte slice,
+// of the character at offset in source without the characters that the decoders of e skip.
+func transparentOffset[T string | []byte](e *Encoding, source T, offset int64) int64 {
+	remaining := offset
+	for i := 0; i < len(source); {
+		if skip := transparentLen(e, source, i); skip > 0 {
+			i += skip
+			continue
+		}
+
+		if remaining == 0 {
+			return int64(i)
+		}
+
+		remaining--
+		i++
+	}
+
+	return int64(len(source))
+}
+
+// remapTransparentError changes the encoded offset of an error that occurred when source without
+// the characters that the decoders of e skip was decoded into the offset in source.
+func remapTransparentError[T string | []byte](e *Encoding, err error, source T) error {
+	// Return early, so that the successful case does not allocate.
+	if err == nil {
+		return nil
+	}
+
+	return remapError(err, func(offset int64) int64 {
+		return transparentOffset(e, source, offset)
+	})
+}
@@ -0,0 +1,160 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// pastedTheOne is encodedTheOne as it may be pasted from a messaging platform,
+// with a soft hyphen, a zero width space, a word joiner and a zero width no-break space.
+const pastedTheOne = "Hel\u00adlo\u200bWor\u2060ld\ufeff"
+
+// ******** Test functions ********
+
+// TestTransparentDecode tests if the block decoding functions skip the transparent characters.
+func TestTransparentDecode(t *testing.T) {
+	encoding := z85.StdEncoding.WithTransparent(z85.InvisibleRanges)
+
+	decoded, err := encoding.DecodeString(pastedTheOne)
+	if err != nil || !bytes.Equal(decoded, clearTheOne) {
+		t.Fatalf(`DecodeString resulted in '% 02x', %v`, decoded, err)
+	}
+
+	destination := make([]byte, len(clearTheOne))
+	n, err := encoding.Decode(destination, []byte(pastedTheOne))
+	if err != nil || !bytes.Equal(destination[:n], clearTheOne) {
+		t.Fatalf(`Decode resulted in '% 02x', %v`, destination[:n], err)
+	}
+
+	appended, err := encoding.AppendDecode([]byte{1}, pastedTheOne)
+	if err != nil || !bytes.Equal(appended, append([]byte{1}, clearTheOne...)) {
+		t.Fatalf(`AppendDecode resulted in '% 02x', %v`, appended, err)
+	}
+
+	if err = encoding.Validate(pastedTheOne); err != nil {
+		t.Fatalf(`Validate failed: %v`, err)
+	}
+
+	if _, err = z85.StdEncoding.DecodeString(pastedTheOne); err == nil {
+		t.Fatal(`Encoding without transparent characters decoded the pasted text`)
+	}
+}
+
+// TestTransparentError tests if the offsets in errors are the ones in the text with the transparent characters.
+func TestTransparentError(t *testing.T) {
+	encoding := z85.StdEncoding.WithTransparent(z85.InvisibleRanges)
+
+	// The zero width space has 3 bytes, so the comma is at offset 10 instead of 7.
+	_, err := encoding.DecodeString("Hello\u200bWo,ld")
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: %v`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindInvalidByte, 4, 10, ',')
+}
+
+// TestTransparentAlphabet tests if characters of the alphabet are never skipped
+// and if characters outside of the ranges are invalid.
+func TestTransparentAlphabet(t *testing.T) {
+	encoding := z85.StdEncoding.WithTransparent([]z85.Range{{'A', 'Z'}, {'~', '~'}})
+
+	decoded, err := encoding.DecodeString(`Hello~World`)
+	if err != nil || !bytes.Equal(decoded, clearTheOne) {
+		t.Fatalf(`Decoding resulted in '% 02x', %v`, decoded, err)
+	}
+
+	if _, err = encoding.DecodeString("Hello\u200bWo"); !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Character outside of the ranges resulted in the error %v`, err)
+	}
+
+	ranges := encoding.Transparent()
+	ranges[0].Lo = 'B'
+	if encoding.Transparent()[0].Lo != 'A' {
+		t.Fatal(`Transparent returned the ranges of the encoding instead of a copy`)
+	}
+
+	if z85.StdEncoding.WithTransparent(nil).Transparent() != nil {
+		t.Fatal(`Empty ranges did not switch the transparent characters off`)
+	}
+}
+
+// TestTransparentWrapPadded tests the combination of transparent characters with wrapping and padding.
+func TestTransparentWrapPadded(t *testing.T) {
+	encoding := z85.PaddedEncoding.WithWrap(5).WithTransparent(z85.InvisibleRanges)
+	data := []byte(`transparent`)
+
+	encoded, _ := encoding.EncodeToString(data)
+	pasted := strings.ReplaceAll(encoded, "\n", "\u200b\r\n\u00ad")
+
+	decoded, err := encoding.DecodeString(pasted)
+	if err != nil || !bytes.Equal(decoded, data) {
+		t.Fatalf(`Decoding of %q resulted in '% 02x', %v`, pasted, decoded, err)
+	}
+
+	decoded, err = io.ReadAll(encoding.NewDecoder(iotest.OneByteReader(strings.NewReader(pasted))))
+	if err != nil || !bytes.Equal(decoded, data) {
+		t.Fatalf(`Stream decoding of %q resulted in '% 02x', %v`, pasted, decoded, err)
+	}
+}
+
+// TestTransparentStream tests if the stream decoder skips transparent characters that are split between reads.
+func TestTransparentStream(t *testing.T) {
+	encoding := z85.StdEncoding.WithTransparent(z85.InvisibleRanges)
+
+	for name, r := range map[string]io.Reader{
+		`complete`: strings.NewReader(pastedTheOne),
+		`one byte`: iotest.OneByteReader(strings.NewReader(pastedTheOne)),
+		`data err`: iotest.DataErrReader(strings.NewReader(pastedTheOne)),
+	} {
+		decoded, err := io.ReadAll(encoding.NewDecoder(r))
+		if err != nil || !bytes.Equal(decoded, clearTheOne) {
+			t.Fatalf(`Decoding from the %s reader resulted in '% 02x', %v`, name, decoded, err)
+		}
+	}
+
+	// A truncated transparent character at the end is not skipped, but an incomplete chunk.
+	_, err := io.ReadAll(encoding.NewDecoder(iotest.OneByteReader(strings.NewReader(encodedTheOne + "\u200b"[:2]))))
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) || codecErr.Kind != z85.KindInvalidLength {
+		t.Fatalf(`Truncated character resulted in the error %v`, err)
+	}
+}
@@ -0,0 +1,74 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestDecodeTrusted tests if DecodeTrusted decodes valid input like Decode.
+func TestDecodeTrusted(t *testing.T) {
+	for size := 0; size <= maxSliceSize; size += 4 {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		encoded, _ := z85.Encode(data)
+		decoded, err := z85.DecodeTrusted(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoded data of size %d is '% 02x', but should be '% 02x'`, size, decoded, data)
+		}
+	}
+}
+
+// TestDecodeTrustedInvalid tests if DecodeTrusted checks the length and does not panic on invalid characters.
+func TestDecodeTrustedInvalid(t *testing.T) {
+	_, err := z85.DecodeTrusted(`Hell`)
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Invalid length not detected: %v`, err)
+	}
+
+	decoded, err := z85.DecodeTrusted("\x00\xff~ \x80%nSc1")
+	if err != nil {
+		t.Fatalf(`Decoding of invalid characters failed: %v`, err)
+	}
+
+	if len(decoded) != 8 {
+		t.Fatalf(`Decoded length is %d, but should be 8`, len(decoded))
+	}
+}
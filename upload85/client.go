@@ -0,0 +1,283 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package upload85
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// maxBackoffShift is the largest number of times the retry delay is doubled.
+const maxBackoffShift = 10
+
+// ******** Public types ********
+
+// Client uploads data to a Server.
+// The fields can be changed before the first upload.
+type Client struct {
+	// BaseURL is the URL the Server is mounted at.
+	BaseURL string
+	// HTTPClient is the client that sends the requests.
+	HTTPClient *http.Client
+	// ChunkSize is the number of bytes that are sent in one request.
+	// It must be a positive multiple of 4 that is not larger than MaxChunkSize.
+	ChunkSize int
+	// MaxRetries is the number of times a failed request is retried before the upload fails.
+	// The counter is reset after each successful request.
+	MaxRetries int
+	// RetryDelay is the time the client waits before the first retry.
+	// The time is doubled for each further retry of the same request.
+	RetryDelay time.Duration
+}
+
+// ******** Public creation functions ********
+
+// NewClient creates a new Client for the Server at baseURL with the default settings.
+func NewClient(baseURL string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimSuffix(baseURL, `/`),
+		HTTPClient: http.DefaultClient,
+		ChunkSize:  DefaultChunkSize,
+		MaxRetries: DefaultMaxRetries,
+		RetryDelay: DefaultRetryDelay,
+	}
+}
+
+// ******** Public functions ********
+
+// Upload creates an upload session and uploads data.
+// It returns the id of the session. If the upload fails, it can be continued with Resume and this id.
+func (c *Client) Upload(ctx context.Context, data []byte) (string, error) {
+	if err := c.checkChunkSize(); err != nil {
+		return ``, err
+	}
+
+	id, err := c.create(ctx, int64(len(data)))
+	if err != nil || len(data) == 0 {
+		return id, err
+	}
+
+	return id, c.Resume(ctx, id, data)
+}
+
+// Resume continues the upload of data in the session with the given id at the offset the server reports.
+// data must be the same data that was passed to Upload.
+// Failed requests are retried up to MaxRetries times with an exponential backoff that starts with RetryDelay,
+// after the offset has been requested again.
+// Responses with a status of 4xx, except for conflicts and checksum errors, are not retried.
+func (c *Client) Resume(ctx context.Context, id string, data []byte) error {
+	if err := c.checkChunkSize(); err != nil {
+		return err
+	}
+
+	length := paddedLength(int64(len(data)))
+	offset, err := c.Offset(ctx, id)
+
+	retries := 0
+	for err == nil && offset < length {
+		var next int64
+		next, err = c.send(ctx, id, offset, chunkAt(data, offset, c.ChunkSize))
+		if err == nil {
+			offset = next
+			retries = 0
+			continue
+		}
+
+		if ctx.Err() != nil || !isRetryable(err) || retries >= c.MaxRetries {
+			break
+		}
+
+		if err = wait(ctx, c.RetryDelay<<min(retries, maxBackoffShift)); err != nil {
+			break
+		}
+
+		retries++
+		offset, err = c.Offset(ctx, id)
+	}
+
+	return err
+}
+
+// Offset returns the number of bytes that the server has received for the session with the given id.
+func (c *Client) Offset(ctx context.Context, id string) (int64, error) {
+	response, err := c.do(ctx, http.MethodHead, c.BaseURL+`/`+id, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return 0, ErrStatus(response.StatusCode)
+	}
+
+	return offsetOf(response)
+}
+
+// ******** Private functions ********
+
+// create creates an upload session for data with the given length and returns its id.
+func (c *Client) create(ctx context.Context, length int64) (string, error) {
+	header := http.Header{HeaderLength: {strconv.FormatInt(length, 10)}}
+	response, err := c.do(ctx, http.MethodPost, c.BaseURL+`/`, header)
+	if err != nil {
+		return ``, err
+	}
+
+	if response.StatusCode != http.StatusCreated {
+		return ``, ErrStatus(response.StatusCode)
+	}
+
+	id := response.Header.Get(HeaderID)
+	if id == `` {
+		return ``, fmt.Errorf(invalidResponseMessage, HeaderID)
+	}
+
+	return id, nil
+}
+
+// send sends the chunk at offset and returns the new offset.
+func (c *Client) send(ctx context.Context, id string, offset int64, chunk []byte) (int64, error) {
+	body, err := z85.EncodeCheck(chunk)
+	if err != nil {
+		return 0, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPatch, c.BaseURL+`/`+id, strings.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set(HeaderOffset, strconv.FormatInt(offset, 10))
+	request.Header.Set(`Content-Type`, `text/plain; charset=us-ascii`)
+
+	response, err := c.HTTPClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	_ = response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		return 0, ErrStatus(response.StatusCode)
+	}
+
+	return offsetOf(response)
+}
+
+// do sends a request without a body and closes the body of the response.
+func (c *Client) do(ctx context.Context, method string, url string, header http.Header) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, values := range header {
+		request.Header[name] = values
+	}
+
+	response, err := c.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	_ = response.Body.Close()
+
+	return response, nil
+}
+
+// checkChunkSize returns an error, if the chunk size is not valid.
+func (c *Client) checkChunkSize() error {
+	if c.ChunkSize <= 0 || c.ChunkSize%4 != 0 || c.ChunkSize > MaxChunkSize {
+		return fmt.Errorf(invalidChunkSizeMessage, c.ChunkSize, MaxChunkSize)
+	}
+
+	return nil
+}
+
+// chunkAt returns the chunk of data at offset with at most chunkSize bytes.
+// The last chunk is padded with zero bytes to a multiple of 4.
+func chunkAt(data []byte, offset int64, chunkSize int) []byte {
+	end := min(offset+int64(chunkSize), paddedLength(int64(len(data))))
+	if end <= int64(len(data)) {
+		return data[offset:end]
+	}
+
+	chunk := make([]byte, end-offset)
+	copy(chunk, data[offset:])
+
+	return chunk
+}
+
+// offsetOf returns the offset in the header of response.
+func offsetOf(response *http.Response) (int64, error) {
+	offset, err := strconv.ParseInt(response.Header.Get(HeaderOffset), 10, 64)
+	if err != nil || offset < 0 || offset%4 != 0 {
+		return 0, fmt.Errorf(invalidResponseMessage, HeaderOffset)
+	}
+
+	return offset, nil
+}
+
+// wait waits for the given time or until ctx is done and returns the error of ctx in the latter case.
+func wait(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isRetryable reports whether a request that failed with err may succeed, when it is sent again.
+func isRetryable(err error) bool {
+	var status ErrStatus
+	if !errors.As(err, &status) {
+		return true
+	}
+
+	switch int(status) {
+	case http.StatusConflict, http.StatusUnprocessableEntity, http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	default:
+		return status >= 500
+	}
+}
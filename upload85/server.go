@@ -0,0 +1,337 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package upload85
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// idSize is the number of random bytes of a session id.
+const idSize = 16
+
+// maxCompleted is the number of completed sessions that are remembered,
+// so a client whose last response got lost learns that its upload is complete.
+const maxCompleted = 1024
+
+// maxBodySize is the size of the encoding of a chunk of MaxChunkSize bytes with its checksum.
+const maxBodySize = (MaxChunkSize/4 + 1) * 5
+
+// ******** Public types ********
+
+// Server is an http.Handler that receives resumable uploads.
+// It is mounted at the base URL of the uploads, e.g. with http.StripPrefix.
+// The sessions are held in memory, so their number is limited and idle sessions are discarded.
+// The fields can be changed before the first request.
+type Server struct {
+	// MaxSessions is the largest number of active sessions.
+	// A request for a new session fails with the status 503, when this number is reached.
+	MaxSessions int
+	// IdleTimeout is the time after which a session without requests is discarded.
+	IdleTimeout time.Duration
+	// ErrorLog is the logger for errors of the completion function.
+	// If it is nil, the standard logger of the log package is used.
+	ErrorLog *log.Logger
+
+	mu         sync.Mutex
+	sessions   map[string]*session
+	completed  map[string]int64
+	order      []string
+	maxLength  int64
+	onComplete func(id string, data []byte) error
+}
+
+// ******** Private types ********
+
+// session is the state of an upload.
+type session struct {
+	length   int64
+	data     []byte
+	lastUsed time.Time
+}
+
+// ******** Public creation functions ********
+
+// NewServer creates a new Server that accepts uploads of up to maxLength bytes.
+// If maxLength is 0 or less, DefaultMaxLength is used.
+// onComplete is called with the data, when an upload is complete.
+// If it returns an error, the error is logged, the request that completed the upload fails with the status 500
+// and the upload is discarded.
+// The server allows DefaultMaxSessions active sessions, that are discarded after DefaultIdleTimeout.
+func NewServer(maxLength int64, onComplete func(id string, data []byte) error) *Server {
+	if maxLength <= 0 {
+		maxLength = DefaultMaxLength
+	}
+
+	return &Server{
+		MaxSessions: DefaultMaxSessions,
+		IdleTimeout: DefaultIdleTimeout,
+		sessions:    make(map[string]*session),
+		completed:   make(map[string]int64),
+		maxLength:   maxLength,
+		onComplete:  onComplete,
+	}
+}
+
+// ******** Public functions ********
+
+// ServeHTTP handles the requests of the upload protocol.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := strings.Trim(r.URL.Path, `/`)
+
+	switch {
+	case id == `` && r.Method == http.MethodPost:
+		s.create(w, r)
+
+	case id != `` && (r.Method == http.MethodHead || r.Method == http.MethodGet):
+		s.status(w, id)
+
+	case id != `` && r.Method == http.MethodPatch:
+		s.append(w, r, id)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// ******** Private functions ********
+
+// create creates a new upload session.
+func (s *Server) create(w http.ResponseWriter, r *http.Request) {
+	length, err := strconv.ParseInt(r.Header.Get(HeaderLength), 10, 64)
+	if err != nil || length < 0 {
+		http.Error(w, `invalid `+HeaderLength, http.StatusBadRequest)
+		return
+	}
+
+	if length > s.maxLength {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var idBytes [idSize]byte
+	if _, err = rand.Read(idBytes[:]); err != nil {
+		s.fail(w, `could not create session id: %v`, err)
+		return
+	}
+	id := hex.EncodeToString(idBytes[:])
+
+	if length == 0 {
+		if !s.complete(w, id, nil) {
+			return
+		}
+
+		s.remember(id, 0)
+	} else if !s.open(id, length) {
+		http.Error(w, `too many active sessions`, http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set(HeaderID, id)
+	w.Header().Set(HeaderOffset, `0`)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// status reports the offset of an upload session.
+func (s *Server) status(w http.ResponseWriter, id string) {
+	s.mu.Lock()
+	offset, found := s.completed[id]
+	if sess := s.active(id); sess != nil {
+		offset, found = int64(len(sess.data)), true
+	}
+	s.mu.Unlock()
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set(HeaderOffset, strconv.FormatInt(offset, 10))
+	w.WriteHeader(http.StatusOK)
+}
+
+// append appends a chunk to an upload session.
+func (s *Server) append(w http.ResponseWriter, r *http.Request, id string) {
+	offset, err := strconv.ParseInt(r.Header.Get(HeaderOffset), 10, 64)
+	if err != nil {
+		http.Error(w, `invalid `+HeaderOffset, http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if len(body) > maxBodySize {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	chunk, err := z85.DecodeCheck(string(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	s.mu.Lock()
+	sess := s.active(id)
+	if sess == nil {
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	current := int64(len(sess.data))
+	if offset != current {
+		s.mu.Unlock()
+		w.Header().Set(HeaderOffset, strconv.FormatInt(current, 10))
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	if current+int64(len(chunk)) > paddedLength(sess.length) {
+		s.mu.Unlock()
+		http.Error(w, `chunk exceeds the upload length`, http.StatusBadRequest)
+		return
+	}
+
+	sess.data = append(sess.data, chunk...)
+	current = int64(len(sess.data))
+	done := current == paddedLength(sess.length)
+	if done {
+		delete(s.sessions, id)
+	}
+	s.mu.Unlock()
+
+	if done {
+		if !s.complete(w, id, sess.data[:sess.length]) {
+			return
+		}
+
+		s.remember(id, current)
+	}
+
+	w.Header().Set(HeaderOffset, strconv.FormatInt(current, 10))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// complete calls the completion function and reports whether it succeeded.
+// If it failed, the error response has been written.
+func (s *Server) complete(w http.ResponseWriter, id string, data []byte) bool {
+	if s.onComplete == nil {
+		return true
+	}
+
+	if err := s.onComplete(id, data); err != nil {
+		s.fail(w, `completion of upload %s failed: %v`, id, err)
+		return false
+	}
+
+	return true
+}
+
+// fail logs an internal error and responds with the status 500 without the details of the error.
+func (s *Server) fail(w http.ResponseWriter, format string, args ...any) {
+	if s.ErrorLog != nil {
+		s.ErrorLog.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+// open creates an active session and reports whether this was possible.
+// Idle sessions are discarded before the number of active sessions is checked.
+func (s *Server) open(id string, length int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for sessionID, sess := range s.sessions {
+		if now.Sub(sess.lastUsed) > s.IdleTimeout {
+			delete(s.sessions, sessionID)
+		}
+	}
+
+	if len(s.sessions) >= s.MaxSessions {
+		return false
+	}
+
+	s.sessions[id] = &session{length: length, lastUsed: now}
+
+	return true
+}
+
+// active returns the active session with the given id and marks it as used.
+// It returns nil, if there is no such session or if it has been idle for too long.
+// The caller must hold the lock.
+func (s *Server) active(id string) *session {
+	sess, found := s.sessions[id]
+	if !found {
+		return nil
+	}
+
+	now := time.Now()
+	if now.Sub(sess.lastUsed) > s.IdleTimeout {
+		delete(s.sessions, id)
+		return nil
+	}
+
+	sess.lastUsed = now
+
+	return sess
+}
+
+// remember records a completed session and forgets the oldest one, if there are too many.
+func (s *Server) remember(id string, length int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.order) == maxCompleted {
+		delete(s.completed, s.order[0])
+		s.order = s.order[1:]
+	}
+
+	s.completed[id] = length
+	s.order = append(s.order, id)
+}
@@ -0,0 +1,117 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Package upload85 implements a resumable chunked upload of binary data over HTTP with Z85 encoded chunks.
+//
+// The protocol consists of three requests:
+//
+//   - POST to the base URL with the header Upload-Length creates an upload session.
+//     The response has the status 201 and contains the id of the session in the header Upload-ID.
+//   - HEAD to the base URL followed by "/" and the id returns the number of bytes received
+//     in the header Upload-Offset.
+//   - PATCH to the same URL with the header Upload-Offset appends a chunk.
+//     The body is the z85.EncodeCheck encoding of the chunk, so every chunk is verified with its CRC-32 checksum.
+//     The response has the status 204 and contains the new offset.
+//
+// As Z85 can only encode data with a length that is a multiple of 4, the data is padded with zero bytes
+// like the files of z85.EncodeFS. The server removes the padding, when the upload is complete.
+// A client that loses the connection asks for the offset and continues from there.
+package upload85
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ******** Public constants ********
+
+// Names of the protocol headers.
+const (
+	HeaderLength = `Upload-Length`
+	HeaderOffset = `Upload-Offset`
+	HeaderID     = `Upload-ID`
+)
+
+// DefaultChunkSize is the number of bytes that a Client sends in one request, if no other size is set.
+const DefaultChunkSize = 64 * 1024
+
+// MaxChunkSize is the largest number of bytes that a Server accepts in one request.
+const MaxChunkSize = 1024 * 1024
+
+// DefaultMaxLength is the largest upload that a Server accepts, if no other size is set.
+const DefaultMaxLength = 64 * 1024 * 1024
+
+// DefaultMaxSessions is the largest number of active sessions of a Server, if no other number is set.
+const DefaultMaxSessions = 1024
+
+// DefaultIdleTimeout is the time after which a Server discards a session without requests, if no other time is set.
+const DefaultIdleTimeout = 10 * time.Minute
+
+// DefaultMaxRetries is the number of times a Client retries a failed request, if no other number is set.
+const DefaultMaxRetries = 3
+
+// DefaultRetryDelay is the time a Client waits before the first retry, if no other time is set.
+const DefaultRetryDelay = 100 * time.Millisecond
+
+// ******** Private constants ********
+
+// statusMessage contains the format for the error message of an unexpected status.
+const statusMessage = `upload request failed with status %d`
+
+// invalidChunkSizeMessage contains the format for the error message of an invalid chunk size.
+const invalidChunkSizeMessage = `chunk size %d is not a positive multiple of 4 up to %d`
+
+// invalidResponseMessage contains the format for the error message of a response without a valid header.
+const invalidResponseMessage = `response has no valid %s header`
+
+// ******** Public types ********
+
+// ErrStatus is returned by a Client, when the server responds with an unexpected status code.
+// Its value is the status code.
+type ErrStatus int
+
+// ******** Public functions ********
+
+// Error returns the error message for an unexpected status.
+func (e ErrStatus) Error() string {
+	return fmt.Sprintf(statusMessage, int(e))
+}
+
+// IsErrStatus reports whether the supplied error is the ErrStatus error.
+func IsErrStatus(err error) bool {
+	var expectedErr ErrStatus
+	return errors.As(err, &expectedErr)
+}
+
+// ******** Private functions ********
+
+// paddedLength returns n rounded up to a multiple of 4.
+func paddedLength(n int64) int64 {
+	return (n + 3) &^ 3
+}
@@ -0,0 +1,364 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package upload85_test
+
+import (
+	"bytes"
+	"context"
+	crand "crypto/rand"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xformerfhs/z85/upload85"
+)
+
+// ******** Private types ********
+
+// flakyTransport loses the response of every third PATCH request and corrupts the body of the next one.
+type flakyTransport struct {
+	mu    sync.Mutex
+	count int
+}
+
+// ******** Test functions ********
+
+// TestUpload tests if data of different lengths is uploaded completely.
+func TestUpload(t *testing.T) {
+	for _, size := range []int{0, 1, 4, 63, 64, 1001} {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		received, client, closeServer := newTestSetup(t, nil)
+
+		client.ChunkSize = 64
+		id, err := client.Upload(context.Background(), data)
+		closeServer()
+		if err != nil {
+			t.Fatalf(`Upload of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(received[id], data) {
+			t.Fatalf(`Received data of size %d is '% 02x', but should be '% 02x'`, size, received[id], data)
+		}
+	}
+}
+
+// TestUploadFlaky tests if lost responses and corrupted chunks are recovered from.
+func TestUploadFlaky(t *testing.T) {
+	data := make([]byte, 1001)
+	_, _ = crand.Read(data)
+
+	received, client, closeServer := newTestSetup(t, &flakyTransport{})
+	defer closeServer()
+
+	client.ChunkSize = 100
+	id, err := client.Upload(context.Background(), data)
+	if err != nil {
+		t.Fatalf(`Upload failed: %v`, err)
+	}
+
+	if !bytes.Equal(received[id], data) {
+		t.Fatal(`Received data differs from the source data`)
+	}
+
+	offset, err := client.Offset(context.Background(), id)
+	if err != nil || offset != 1004 {
+		t.Fatalf(`Offset of the completed upload is %d: %v`, offset, err)
+	}
+}
+
+// TestResume tests if an interrupted upload can be continued with another client.
+func TestResume(t *testing.T) {
+	data := make([]byte, 500)
+	_, _ = crand.Read(data)
+
+	received, client, closeServer := newTestSetup(t, &flakyTransport{})
+	defer closeServer()
+
+	client.ChunkSize = 100
+	client.MaxRetries = 0
+	id, err := client.Upload(context.Background(), data)
+	if err == nil || id == `` {
+		t.Fatalf(`Upload without retries over a flaky connection returned '%s': %v`, id, err)
+	}
+
+	resumer := upload85.NewClient(client.BaseURL)
+	resumer.ChunkSize = 100
+	resumer.RetryDelay = time.Millisecond
+	if err = resumer.Resume(context.Background(), id, data); err != nil {
+		t.Fatalf(`Resume failed: %v`, err)
+	}
+
+	if !bytes.Equal(received[id], data) {
+		t.Fatal(`Received data differs from the source data`)
+	}
+}
+
+// TestUploadCanceled tests if an upload with a canceled context fails.
+func TestUploadCanceled(t *testing.T) {
+	_, client, closeServer := newTestSetup(t, nil)
+	defer closeServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.Upload(ctx, make([]byte, 8)); !errors.Is(err, context.Canceled) {
+		t.Fatalf(`Expected canceled error, but got: %v`, err)
+	}
+}
+
+// TestServerErrors tests if invalid requests are rejected.
+func TestServerErrors(t *testing.T) {
+	server := upload85.NewServer(1000, nil)
+
+	tests := []struct {
+		method string
+		path   string
+		header map[string]string
+		body   string
+		status int
+	}{
+		{http.MethodGet, `/`, nil, ``, http.StatusMethodNotAllowed},
+		{http.MethodPost, `/`, nil, ``, http.StatusBadRequest},
+		{http.MethodPost, `/`, map[string]string{upload85.HeaderLength: `1001`}, ``, http.StatusRequestEntityTooLarge},
+		{http.MethodHead, `/unknown`, nil, ``, http.StatusNotFound},
+		{http.MethodPatch, `/unknown`, map[string]string{upload85.HeaderOffset: `0`}, `HelloWorld>P<I{`, http.StatusNotFound},
+		{http.MethodPatch, `/unknown`, map[string]string{upload85.HeaderOffset: `0`}, `HelloWorld>P<I}`, http.StatusUnprocessableEntity},
+		{http.MethodPatch, `/unknown`, nil, ``, http.StatusBadRequest},
+	}
+
+	for _, test := range tests {
+		request := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+		for name, value := range test.header {
+			request.Header.Set(name, value)
+		}
+
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+		if recorder.Code != test.status {
+			t.Fatalf(`%s %s returned %d, but should return %d`, test.method, test.path, recorder.Code, test.status)
+		}
+	}
+}
+
+// TestServerConflict tests if a chunk at the wrong offset is rejected with the current offset.
+func TestServerConflict(t *testing.T) {
+	server := upload85.NewServer(0, nil)
+
+	request := httptest.NewRequest(http.MethodPost, `/`, nil)
+	request.Header.Set(upload85.HeaderLength, `16`)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+	id := recorder.Header().Get(upload85.HeaderID)
+
+	request = httptest.NewRequest(http.MethodPatch, `/`+id, strings.NewReader(`HelloWorld>P<I{`))
+	request.Header.Set(upload85.HeaderOffset, `8`)
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusConflict || recorder.Header().Get(upload85.HeaderOffset) != `0` {
+		t.Fatalf(`Conflict returned %d with offset '%s'`, recorder.Code, recorder.Header().Get(upload85.HeaderOffset))
+	}
+}
+
+// TestServerSessionLimit tests if the number of active sessions is limited and idle sessions are discarded.
+func TestServerSessionLimit(t *testing.T) {
+	server := upload85.NewServer(0, nil)
+	server.MaxSessions = 2
+	server.IdleTimeout = 50 * time.Millisecond
+
+	create := func() *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, `/`, nil)
+		request.Header.Set(upload85.HeaderLength, `16`)
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	first := create().Header().Get(upload85.HeaderID)
+	_ = create()
+	if code := create().Code; code != http.StatusServiceUnavailable {
+		t.Fatalf(`Session beyond the limit returned %d`, code)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if code := create().Code; code != http.StatusCreated {
+		t.Fatalf(`Session after the idle timeout returned %d`, code)
+	}
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest(http.MethodHead, `/`+first, nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Fatalf(`Idle session returned %d`, recorder.Code)
+	}
+}
+
+// TestServerCompletionError tests if the error of the completion function is logged and not sent to the client.
+func TestServerCompletionError(t *testing.T) {
+	server := upload85.NewServer(0, func(string, []byte) error {
+		return errors.New(`disk /secret is full`)
+	})
+
+	var logged bytes.Buffer
+	server.ErrorLog = log.New(&logged, ``, 0)
+
+	request := httptest.NewRequest(http.MethodPost, `/`, nil)
+	request.Header.Set(upload85.HeaderLength, `0`)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusInternalServerError {
+		t.Fatalf(`Failed completion returned %d`, recorder.Code)
+	}
+
+	if strings.Contains(recorder.Body.String(), `secret`) {
+		t.Fatalf(`Response contains the error: '%s'`, recorder.Body.String())
+	}
+
+	if !strings.Contains(logged.String(), `disk /secret is full`) {
+		t.Fatalf(`Error is not logged: '%s'`, logged.String())
+	}
+}
+
+// TestClientBackoff tests if the client waits longer before each retry.
+func TestClientBackoff(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.Header().Set(upload85.HeaderOffset, `0`)
+			return
+		}
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	client := upload85.NewClient(ts.URL)
+	client.MaxRetries = 3
+	client.RetryDelay = 10 * time.Millisecond
+
+	start := time.Now()
+	err := client.Resume(context.Background(), `id`, make([]byte, 8))
+	if !upload85.IsErrStatus(err) {
+		t.Fatalf(`Expected status error, but got: %v`, err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 70*time.Millisecond {
+		t.Fatalf(`Retries took %v, but should take at least 70ms`, elapsed)
+	}
+}
+
+// TestClientChunkSize tests if an invalid chunk size is rejected.
+func TestClientChunkSize(t *testing.T) {
+	client := upload85.NewClient(`http://localhost`)
+
+	for _, size := range []int{0, 5, upload85.MaxChunkSize + 4} {
+		client.ChunkSize = size
+		if _, err := client.Upload(context.Background(), make([]byte, 8)); err == nil {
+			t.Fatalf(`Chunk size %d is accepted`, size)
+		}
+	}
+}
+
+// TestIsErrStatus tests if status errors are recognized.
+func TestIsErrStatus(t *testing.T) {
+	if !upload85.IsErrStatus(upload85.ErrStatus(http.StatusNotFound)) {
+		t.Fatal(`Status error is not recognized`)
+	}
+
+	if upload85.IsErrStatus(errors.New(`other`)) {
+		t.Fatal(`Other error is recognized as status error`)
+	}
+}
+
+// ******** Private functions ********
+
+// newTestSetup starts a server and returns the map of received uploads, a client and a function that stops the server.
+func newTestSetup(t *testing.T, transport http.RoundTripper) (map[string][]byte, *upload85.Client, func()) {
+	t.Helper()
+
+	received := make(map[string][]byte)
+	var mu sync.Mutex
+	server := upload85.NewServer(0, func(id string, data []byte) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		received[id] = append([]byte{}, data...)
+		return nil
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle(`/upload/`, http.StripPrefix(`/upload`, server))
+	ts := httptest.NewServer(mux)
+
+	client := upload85.NewClient(ts.URL + `/upload/`)
+	client.RetryDelay = time.Millisecond
+	if transport != nil {
+		client.HTTPClient = &http.Client{Transport: transport}
+	}
+
+	return received, client, ts.Close
+}
+
+// RoundTrip sends the request and simulates the failures of a flaky connection.
+func (ft *flakyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Method != http.MethodPatch {
+		return http.DefaultTransport.RoundTrip(request)
+	}
+
+	ft.mu.Lock()
+	ft.count++
+	count := ft.count
+	ft.mu.Unlock()
+
+	switch count % 3 {
+	case 1:
+		response, err := http.DefaultTransport.RoundTrip(request)
+		if err == nil {
+			_ = response.Body.Close()
+		}
+
+		return nil, errors.New(`connection lost`)
+
+	case 2:
+		body, _ := io.ReadAll(request.Body)
+		body[0] ^= 1
+		request.Body = io.NopCloser(bytes.NewReader(body))
+		request.ContentLength = int64(len(body))
+	}
+
+	return http.DefaultTransport.RoundTrip(request)
+}
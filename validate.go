@@ -0,0 +1,100 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+// ******** Public functions ********
+
+// Validate checks whether s is a valid Z85 encoded string without decoding it.
+// It checks the length, the characters and whether the chunks fit into 32 bits.
+// It returns the same error that decoding s would return, or nil, if s is valid.
+// It does not allocate memory for valid input.
+func Validate(s string) error {
+	return StdEncoding.Validate(s)
+}
+
+// IsValid reports whether s is a valid Z85 encoded string.
+// It is the same check as Validate, but it neither allocates memory nor creates an error.
+// The checks of all chunks are combined, so there is only one branch per chunk.
+func IsValid(s string) bool {
+	if len(s)%encodedChunkSize != 0 {
+		return false
+	}
+
+	var invalid byte
+	var overflow uint64
+	for position := 0; position < len(s); position += encodedChunkSize {
+		chunk := s[position : position+encodedChunkSize]
+		d0 := StdEncoding.decodeValue(chunk[0])
+		d1 := StdEncoding.decodeValue(chunk[1])
+		d2 := StdEncoding.decodeValue(chunk[2])
+		d3 := StdEncoding.decodeValue(chunk[3])
+		d4 := StdEncoding.decodeValue(chunk[4])
+
+		// The value of an invalid character is wrong, but the chunk is invalid anyway.
+		invalid |= d0 | d1 | d2 | d3 | d4
+		overflow |= ((((uint64(d0)*codeSize+uint64(d1))*codeSize+uint64(d2))*codeSize+uint64(d3))*codeSize + uint64(d4)) >> 32
+	}
+
+	return invalid&invalidMarker == 0 && overflow == 0
+}
+
+// Validate checks whether s is a valid encoding of e without decoding it.
+// It works like the package level function Validate.
+// For a wrapping encoding, line breaks are ignored, and so are the transparent characters of the encoding.
+func (e *Encoding) Validate(s string) error {
+	if e.transparent != nil && containsTransparent(e, s) {
+		return remapTransparentError(e, e.Validate(string(withoutTransparent(e, nil, s))), s)
+	}
+
+	if e.wrap > 0 && containsLineBreak(s) {
+		return remapWrappedError(e.Validate(string(removeLineBreaks(s))), s)
+	}
+
+	_, err := e.decodedLength(len(s))
+	if err != nil {
+		return err
+	}
+
+	dataLen := len(s)
+	if e.padded && dataLen > 0 {
+		dataLen--
+		if dataLen == 0 || e.decodeValue(s[dataLen]) >= byteChunkSize {
+			return newInvalidByteError(uint(dataLen), s[dataLen])
+		}
+	}
+
+	for position := 0; position < dataLen; position += encodedChunkSize {
+		_, err = e.decodeChunkValue(s[position:position+encodedChunkSize], uint(position))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
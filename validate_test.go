@@ -0,0 +1,132 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Test functions ********
+
+// TestValidateRegressions tests if Validate returns the same errors as Decode.
+func TestValidateRegressions(t *testing.T) {
+	for _, regression := range decodeRegressions {
+		_, decodeErr := z85.Decode(regression.input)
+		validateErr := z85.Validate(regression.input)
+
+		checkSameError(t, regression.input, validateErr, decodeErr)
+	}
+}
+
+// TestIsValidRegressions tests if IsValid agrees with Decode.
+func TestIsValidRegressions(t *testing.T) {
+	for _, regression := range decodeRegressions {
+		_, err := z85.Decode(regression.input)
+		if z85.IsValid(regression.input) != (err == nil) {
+			t.Fatalf(`IsValid of %q is %t, but decoding returned: %v`, regression.input, !(err == nil), err)
+		}
+	}
+}
+
+// TestValidatePadded tests the validation of a padded encoding.
+func TestValidatePadded(t *testing.T) {
+	for _, input := range []string{``, `HelloWorld0`, `HelloWorld3`, `HelloWorld4`, `0`, `HelloWorld`, `Hello%nSc10`} {
+		_, decodeErr := z85.PaddedEncoding.DecodeString(input)
+		validateErr := z85.PaddedEncoding.Validate(input)
+
+		checkSameError(t, input, validateErr, decodeErr)
+	}
+}
+
+// TestValidateWrapped tests the validation of a wrapping encoding.
+func TestValidateWrapped(t *testing.T) {
+	encoding := z85.StdEncoding.WithWrap(4)
+
+	for _, input := range []string{"Hell\noWor\nld", "Hell\noWor\n,d", "Hell\r\noWorl"} {
+		_, decodeErr := encoding.DecodeString(input)
+		validateErr := encoding.Validate(input)
+
+		checkSameError(t, input, validateErr, decodeErr)
+	}
+}
+
+// TestValidateNoAllocation tests if Validate does not allocate memory for valid input.
+func TestValidateNoAllocation(t *testing.T) {
+	input := encodedTheOne + encodedTheOne
+	allocs := testing.AllocsPerRun(iterationCount, func() {
+		_ = z85.Validate(input)
+	})
+
+	if allocs != 0 {
+		t.Fatalf(`Validate allocated %.0f times`, allocs)
+	}
+}
+
+// TestIsValidNoAllocation tests if IsValid does not allocate memory for any input.
+func TestIsValidNoAllocation(t *testing.T) {
+	for _, input := range []string{encodedTheOne, `Hell~World`, `HelloWorld%nSc1`, `Hell`} {
+		allocs := testing.AllocsPerRun(iterationCount, func() {
+			_ = z85.IsValid(input)
+		})
+
+		if allocs != 0 {
+			t.Fatalf(`IsValid of %q allocated %.0f times`, input, allocs)
+		}
+	}
+}
+
+// ******** Private functions ********
+
+// checkSameError checks if the validation error has the same kind and offsets as the decoding error.
+func checkSameError(t *testing.T, input string, validateErr error, decodeErr error) {
+	t.Helper()
+
+	if decodeErr == nil {
+		if validateErr != nil {
+			t.Fatalf(`Validation of %q failed: %v`, input, validateErr)
+		}
+
+		return
+	}
+
+	var decodeCodecErr *z85.CodecError
+	if !errors.As(decodeErr, &decodeCodecErr) {
+		t.Fatalf(`Decoding of %q did not return a CodecError, but: %v`, input, decodeErr)
+	}
+
+	var validateCodecErr *z85.CodecError
+	if !errors.As(validateErr, &validateCodecErr) {
+		t.Fatalf(`Validation of %q did not return a CodecError, but: %v`, input, validateErr)
+	}
+
+	checkCodecError(t, validateCodecErr, decodeCodecErr.Kind, decodeCodecErr.RawOffset, decodeCodecErr.EncodedOffset, decodeCodecErr.Byte)
+}
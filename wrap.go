@@ -0,0 +1,222 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85
+
+import (
+	"errors"
+	"math"
+)
+
+// ******** Private constants ********
+
+// lineFeed is the character that separates the lines of a wrapped encoding.
+const lineFeed = '\n'
+
+// carriageReturn is the character that is ignored together with lineFeed when a wrapped encoding is decoded.
+const carriageReturn = '\r'
+
+// ******** Public functions ********
+
+// WithWrap creates a new encoding identical to e except that the encoded data is split into lines
+// of n characters that are separated by a line feed.
+// The last line is not terminated by a line feed.
+// A value of n that is 0 or less switches wrapping off.
+//
+// The decoding functions of a wrapping encoding ignore all line feeds and carriage returns,
+// so the lines do not need to have a length of n.
+func (e *Encoding) WithWrap(n int) *Encoding {
+	result := *e
+	result.wrap = max(n, 0)
+
+	return &result
+}
+
+// Wrap returns the line length of the encoding, or 0, if the encoding does not wrap.
+func (e *Encoding) Wrap() int {
+	return e.wrap
+}
+
+// WithMaxLineLength creates a new encoding identical to e except that the stream decoders of a wrapping encoding
+// reject a line that is longer than n characters with an ErrLineTooLong error.
+// The line is rejected as soon as its first character beyond the limit has been read,
+// so a line-oriented server never buffers a pathological line of arbitrary length.
+// Carriage returns are not counted. The data of the lines in front of the long line is delivered before the error.
+// A value of n that is 0 or less switches the limit off.
+//
+// The limit only applies to the stream decoders, as the block decoding functions already have the complete input.
+func (e *Encoding) WithMaxLineLength(n int) *Encoding {
+	result := *e
+	result.maxLine = max(n, 0)
+
+	return &result
+}
+
+// MaxLineLength returns the maximum line length that the stream decoders accept, or 0, if there is no limit.
+func (e *Encoding) MaxLineLength() int {
+	return e.maxLine
+}
+
+// ******** Private functions ********
+
+// wrappedLength adds the number of line feeds to the length of unwrapped encoded data.
+func (e *Encoding) wrappedLength(n int) (int, error) {
+	if n == 0 {
+		return 0, nil
+	}
+
+	lineFeedCount := (n - 1) / e.wrap
+	if n > math.MaxInt-lineFeedCount {
+		return 0, newInputTooLargeError((math.MaxInt / (e.wrap + 1) * e.wrap / encodedChunkSize) * byteChunkSize)
+	}
+
+	return n + lineFeedCount, nil
+}
+
+// wrapLines inserts the line feeds into destination.
+// The unwrapped encoded data must be at the end of destination, after the room for the line feeds.
+func (e *Encoding) wrapLines(destination []byte) {
+	read := len(destination) / (e.wrap + 1)
+	write := 0
+	for {
+		// The write position never passes the read position, so no data is overwritten before it is read.
+		lineLen := min(e.wrap, len(destination)-read)
+		copy(destination[write:], destination[read:read+lineLen])
+		write += lineLen
+		read += lineLen
+
+		if read == len(destination) {
+			return
+		}
+
+		destination[write] = lineFeed
+		write++
+	}
+}
+
+// isLineBreak reports whether b is a character that is ignored when a wrapped encoding is decoded.
+func isLineBreak(b byte) bool {
+	return b == lineFeed || b == carriageReturn
+}
+
+// containsLineBreak reports whether source, which is either a string or a byte slice, contains a line break.
+func containsLineBreak[T string | []byte](source T) bool {
+	for i := 0; i < len(source); i++ {
+		if isLineBreak(source[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeLineBreaks returns a copy of source, which is either a string or a byte slice, without line breaks.
+func removeLineBreaks[T string | []byte](source T) []byte {
+	return appendWithoutLineBreaks(make([]byte, 0, len(source)), source)
+}
+
+// appendWithoutLineBreaks appends source, which is either a string or a byte slice, without its line breaks to dst
+// and returns the extended slice.
+func appendWithoutLineBreaks[T string | []byte](dst []byte, source T) []byte {
+	for i := 0; i < len(source); i++ {
+		if !isLineBreak(source[i]) {
+			dst = append(dst, source[i])
+		}
+	}
+
+	return dst
+}
+
+// removeLineBreaksInPlace removes the line breaks from buffer and returns the remaining length.
+func removeLineBreaksInPlace(buffer []byte) int {
+	n := 0
+	for _, b := range buffer {
+		if !isLineBreak(b) {
+			buffer[n] = b
+			n++
+		}
+	}
+
+	return n
+}
+
+// wrappedOffset returns the offset in source, which is either a string or a byte slice,
+// of the character at offset in source without line breaks.
+func wrappedOffset[T string | []byte](source T, offset int64) int64 {
+	remaining := offset
+	for i := 0; i < len(source); i++ {
+		if isLineBreak(source[i]) {
+			continue
+		}
+
+		if remaining == 0 {
+			return int64(i)
+		}
+
+		remaining--
+	}
+
+	return int64(len(source))
+}
+
+// remapWrappedError changes the encoded offset of an error that occurred when source without line breaks
+// was decoded into the offset in source.
+func remapWrappedError[T string | []byte](err error, source T) error {
+	// Return early, so that the successful case does not allocate.
+	if err == nil {
+		return nil
+	}
+
+	return remapError(err, func(offset int64) int64 {
+		return wrappedOffset(source, offset)
+	})
+}
+
+// remapError changes the encoded offset of a CodecError and the position of its cause with mapOffset.
+// Other errors are returned unchanged.
+func remapError(err error, mapOffset func(int64) int64) error {
+	var codecErr *CodecError
+	if !errors.As(err, &codecErr) {
+		return err
+	}
+
+	result := *codecErr
+	result.EncodedOffset = mapOffset(codecErr.EncodedOffset)
+	position := uint(result.EncodedOffset)
+
+	switch cause := codecErr.Err.(type) {
+	case *ErrInvalidByte:
+		result.Err = &ErrInvalidByte{position: position, value: cause.value}
+	case *ErrControlCharacter:
+		result.Err = &ErrControlCharacter{position: position, value: cause.value}
+	case ErrOverflow:
+		result.Err = ErrOverflow(position)
+	}
+
+	return &result
+}
@@ -0,0 +1,339 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85_test
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private variables ********
+
+// wrapLengths contains the line lengths that are tested.
+var wrapLengths = []int{1, 5, 7, 76}
+
+// ******** Private types ********
+
+// endlessReader delivers a line of encoded characters without end and counts the bytes read.
+type endlessReader struct {
+	count int
+}
+
+// ******** Test functions ********
+
+// TestWrapKnownValue tests the wrapped encoding of a known value.
+func TestWrapKnownValue(t *testing.T) {
+	encoding := z85.StdEncoding.WithWrap(4)
+
+	encoded, err := encoding.EncodeToString(clearTheOne)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if encoded != "Hell\noWor\nld" {
+		t.Fatalf(`Encoding is %q, but should be %q`, encoded, "Hell\noWor\nld")
+	}
+
+	if encoding.EncodedLen(len(clearTheOne)) != len(encoded) {
+		t.Fatalf(`EncodedLen is %d, but should be %d`, encoding.EncodedLen(len(clearTheOne)), len(encoded))
+	}
+}
+
+// TestWrapRoundTrip tests if the wrapped encoding is the unwrapped encoding split into lines
+// and if it is decoded correctly.
+func TestWrapRoundTrip(t *testing.T) {
+	for _, wrap := range wrapLengths {
+		encoding := z85.StdEncoding.WithWrap(wrap)
+
+		for size := 0; size <= maxSliceSize; size += 4 {
+			data := make([]byte, size)
+			_, _ = crand.Read(data)
+
+			encoded, err := encoding.EncodeToString(data)
+			if err != nil {
+				t.Fatalf(`Encoding of size %d with wrap %d failed: %v`, size, wrap, err)
+			}
+
+			unwrapped, _ := z85.Encode(data)
+			expected := wrapString(unwrapped, wrap)
+			if encoded != expected {
+				t.Fatalf(`Encoding of size %d with wrap %d is %q, but should be %q`, size, wrap, encoded, expected)
+			}
+
+			decoded, err := encoding.DecodeString(encoded)
+			if err != nil {
+				t.Fatalf(`Decoding of size %d with wrap %d failed: %v`, size, wrap, err)
+			}
+
+			if !bytes.Equal(decoded, data) {
+				t.Fatalf(`Decoded data of size %d with wrap %d is '% 02x', but should be '% 02x'`, size, wrap, decoded, data)
+			}
+		}
+	}
+}
+
+// TestWrapPadded tests the combination of wrapping and padding.
+func TestWrapPadded(t *testing.T) {
+	encoding := z85.PaddedEncoding.WithWrap(5)
+
+	for size := 0; size <= maxSliceSize; size++ {
+		data := make([]byte, size)
+		_, _ = crand.Read(data)
+
+		encoded, _ := encoding.EncodeToString(data)
+		unwrapped, _ := z85.PaddedEncoding.EncodeToString(data)
+		expected := wrapString(unwrapped, 5)
+		if encoded != expected {
+			t.Fatalf(`Encoding of size %d is %q, but should be %q`, size, encoded, expected)
+		}
+
+		decoded, err := encoding.DecodeString(encoded)
+		if err != nil {
+			t.Fatalf(`Decoding of size %d failed: %v`, size, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Decoded data of size %d is '% 02x', but should be '% 02x'`, size, decoded, data)
+		}
+	}
+}
+
+// TestWrapDecodeIrregularLines tests if line breaks are accepted anywhere.
+func TestWrapDecodeIrregularLines(t *testing.T) {
+	encoding := z85.StdEncoding.WithWrap(76)
+
+	decoded, err := encoding.Decode(make([]byte, 8), []byte("\r\nHel\r\nloWorld\n"))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if decoded != len(clearTheOne) {
+		t.Fatalf(`Decoded length is %d, but should be %d`, decoded, len(clearTheOne))
+	}
+
+	appended, err := encoding.AppendDecode([]byte{1}, "Hello\nWorld")
+	if err != nil {
+		t.Fatalf(`Appending failed: %v`, err)
+	}
+
+	if !bytes.Equal(appended[1:], clearTheOne) {
+		t.Fatalf(`Appended data is '% 02x', but should be '% 02x'`, appended[1:], clearTheOne)
+	}
+}
+
+// TestWrapDecodeErrorOffset tests if the offsets of errors count the line breaks.
+func TestWrapDecodeErrorOffset(t *testing.T) {
+	encoding := z85.StdEncoding.WithWrap(4)
+
+	_, err := encoding.DecodeString("Hell\noWor\n,d")
+
+	var codecErr *z85.CodecError
+	if !errors.As(err, &codecErr) {
+		t.Fatalf(`Error is not a CodecError: '%v'`, err)
+	}
+
+	checkCodecError(t, codecErr, z85.KindInvalidByte, 4, 10, ',')
+
+	if !strings.Contains(err.Error(), `position 10`) {
+		t.Fatalf(`Error message does not contain the position: '%v'`, err)
+	}
+}
+
+// TestWrapNoLineBreaksInStrictEncoding tests if line breaks are rejected by an encoding that does not wrap.
+func TestWrapNoLineBreaksInStrictEncoding(t *testing.T) {
+	_, err := z85.StdEncoding.DecodeString("Hello\nWorld")
+	if err == nil {
+		t.Fatal(`Line break was accepted`)
+	}
+}
+
+// TestWrapStream tests if the stream encoder and decoder handle wrapping.
+func TestWrapStream(t *testing.T) {
+	for _, wrap := range wrapLengths {
+		encoding := z85.StdEncoding.WithWrap(wrap)
+
+		data := make([]byte, 1000)
+		_, _ = crand.Read(data)
+
+		var buffer bytes.Buffer
+		writer := encoding.NewEncoder(&buffer)
+		for i := 0; i < len(data); i += 9 {
+			_, _ = writer.Write(data[i:min(i+9, len(data))])
+		}
+
+		err := writer.Close()
+		if err != nil {
+			t.Fatalf(`Closing with wrap %d failed: %v`, wrap, err)
+		}
+
+		expected, _ := encoding.EncodeToString(data)
+		if buffer.String() != expected {
+			t.Fatalf(`Stream encoding with wrap %d is %q, but should be %q`, wrap, buffer.String(), expected)
+		}
+
+		decoded, err := io.ReadAll(encoding.NewDecoder(&buffer))
+		if err != nil {
+			t.Fatalf(`Stream decoding with wrap %d failed: %v`, wrap, err)
+		}
+
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf(`Stream decoded data with wrap %d is '% 02x', but should be '% 02x'`, wrap, decoded, data)
+		}
+	}
+}
+
+// TestWrapOffsets tests the offset conversions of a wrapping encoding.
+func TestWrapOffsets(t *testing.T) {
+	encoding := z85.StdEncoding.WithWrap(4)
+	encoded := "Hell\noWor\nld"
+
+	// The second chunk starts with the 'W' after the first line feed.
+	if encoding.RawToEncodedOffset(4) != 6 {
+		t.Fatalf(`RawToEncodedOffset(4) is %d, but should be 6`, encoding.RawToEncodedOffset(4))
+	}
+
+	for offset, expected := range []int64{0, 0, 0, 0, 0, 0, 4, 4, 4, 4, 4, 4} {
+		got := encoding.EncodedToRawOffset(int64(offset))
+		if got != expected {
+			t.Fatalf(`EncodedToRawOffset(%d) of %q is %d, but should be %d`, offset, encoded, got, expected)
+		}
+	}
+}
+
+// TestWrapDisabled tests if a line length of 0 or less switches wrapping off.
+func TestWrapDisabled(t *testing.T) {
+	for _, wrap := range []int{0, -1} {
+		encoding := z85.StdEncoding.WithWrap(wrap)
+		if encoding.Wrap() != 0 {
+			t.Fatalf(`Wrap of %d is %d`, wrap, encoding.Wrap())
+		}
+
+		encoded, _ := encoding.EncodeToString(clearTheOne)
+		if encoded != encodedTheOne {
+			t.Fatalf(`Encoding with wrap %d is '%s', but should be '%s'`, wrap, encoded, encodedTheOne)
+		}
+	}
+}
+
+// TestWrapBuilder tests if the builder sets the line length.
+func TestWrapBuilder(t *testing.T) {
+	encoding, _ := z85.Builder().Wrap(76).Build()
+	if encoding.Wrap() != 76 {
+		t.Fatalf(`Wrap is %d, but should be 76`, encoding.Wrap())
+	}
+}
+
+// TestWrapMaxLineLength tests if the stream decoder rejects a long line before it has read it completely.
+func TestWrapMaxLineLength(t *testing.T) {
+	encoding := z85.StdEncoding.WithWrap(10).WithMaxLineLength(10)
+	if encoding.MaxLineLength() != 10 {
+		t.Fatalf(`MaxLineLength is %d, but should be 10`, encoding.MaxLineLength())
+	}
+
+	decoded, err := io.ReadAll(encoding.NewDecoder(strings.NewReader("HelloWorld\r\nHelloWorld\n")))
+	if err != nil || !bytes.Equal(decoded, append(clearTheOne, clearTheOne...)) {
+		t.Fatalf(`Lines within the limit resulted in '% 02x': %v`, decoded, err)
+	}
+
+	decoded, err = io.ReadAll(encoding.NewDecoder(strings.NewReader("HelloWorld\nHelloWorldHello")))
+	if !z85.IsErrLineTooLong(err) {
+		t.Fatalf(`Expected line too long error, but got: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, append(clearTheOne, clearTheOne...)) {
+		t.Fatalf(`Data in front of the character beyond the limit is '% 02x'`, decoded)
+	}
+
+	var codecErr *z85.CodecError
+	if errors.As(err, &codecErr) {
+		checkCodecError(t, codecErr, z85.KindLineTooLong, 16, 20, 0)
+	}
+
+	// A line without end is rejected after a bounded amount of data.
+	endless := &endlessReader{}
+	_, err = io.Copy(io.Discard, encoding.NewDecoder(endless))
+	if !z85.IsErrLineTooLong(err) {
+		t.Fatalf(`Expected line too long error for an endless line, but got: %v`, err)
+	}
+
+	if endless.count > 64*1024 {
+		t.Fatalf(`%d bytes of an endless line have been read`, endless.count)
+	}
+
+	decoded, err = io.ReadAll(z85.StdEncoding.WithWrap(10).NewDecoder(strings.NewReader(`HelloWorldHelloWorld`)))
+	if err != nil || len(decoded) != 16 {
+		t.Fatalf(`Decoding without a limit resulted in %d bytes: %v`, len(decoded), err)
+	}
+}
+
+// TestWrapMaxLineLengthBuilder tests if the builder sets the maximum line length.
+func TestWrapMaxLineLengthBuilder(t *testing.T) {
+	encoding, _ := z85.Builder().Wrap(76).MaxLineLength(100).Build()
+	if encoding.MaxLineLength() != 100 {
+		t.Fatalf(`MaxLineLength is %d, but should be 100`, encoding.MaxLineLength())
+	}
+
+	if z85.StdEncoding.WithMaxLineLength(-1).MaxLineLength() != 0 {
+		t.Fatal(`Negative maximum line length is not switched off`)
+	}
+}
+
+// ******** Private functions ********
+
+// Read fills p with encoded characters and never ends.
+func (e *endlessReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = encodedTheOne[i%len(encodedTheOne)]
+	}
+
+	e.count += len(p)
+
+	return len(p), nil
+}
+
+// wrapString splits s into lines of n characters.
+func wrapString(s string, n int) string {
+	var lines []string
+	for len(s) > n {
+		lines = append(lines, s[:n])
+		s = s[n:]
+	}
+
+	if len(s) > 0 {
+		lines = append(lines, s)
+	}
+
+	return strings.Join(lines, "\n")
+}
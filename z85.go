@@ -20,17 +20,34 @@
 //
 // Author: Frank Schwab
 //
-// Version: 1.0.0
+// Version: 1.17.0
 //
 // Change history:
 //    2025-02-15: V1.0.0: Created.
+//    2026-10-17: V1.1.0: Add ConcatSafeSplit.
+//    2026-10-17: V1.2.0: Return CodecError.
+//    2026-10-17: V1.3.0: Move chunk encoding to chunk.go.
+//    2026-10-17: V1.4.0: Move chunk loops to private functions.
+//    2026-10-17: V1.5.0: Add AppendEncode and AppendDecode.
+//    2026-10-17: V1.6.0: Add EncodeInto.
+//    2026-10-17: V1.7.0: Add DecodeInto.
+//    2026-10-17: V1.8.0: Guard against length overflows.
+//    2026-10-17: V1.9.0: Add DecodeBytes.
+//    2026-10-17: V1.10.0: Add EncodeToBytes.
+//    2026-10-17: V1.11.0: Add EncodeWithBuffer.
+//    2026-10-17: V1.12.0: Delegate to StdEncoding.
+//    2026-10-17: V1.13.0: Add MustEncode and MustDecode.
+//    2026-10-17: V1.14.0: Encode large data in parallel.
+//    2026-10-17: V1.15.0: Use the public Alphabet constant.
+//    2026-10-17: V1.16.0: Use a decoding table with 256 entries.
+//    2026-10-17: V1.17.0: Add constants for double chunks.
 //
 
 // Package z85 implements Z85 encoding as specified in https://rfc.zeromq.org/spec/32.
 package z85
 
 import (
-	"encoding/binary"
+	"slices"
 )
 
 // ******** Private constants ********
@@ -50,20 +67,29 @@ const byteChunkShift = 2
 // encodedChunkSize is the size of an encoded chunk.
 const encodedChunkSize = 5
 
-// decodeOffset is the offset of an encoded byte into the decode table.
-// This is the ASCII value of the encoding character with the least value.
-const decodeOffset = '!'
+// doubleChunkSize is the size of 2 byte chunks that are encoded in one iteration.
+const doubleChunkSize = 2 * byteChunkSize
+
+// doubleEncodedChunkSize is the size of 2 encoded chunks.
+const doubleEncodedChunkSize = 2 * encodedChunkSize
+
+// decodeTableSize is the size of the decoding table, which contains an entry for every byte value.
+const decodeTableSize = 256
 
 // ivEc is the encoding value for an invalid character.
 // The name has to have a length of 4 in order to be exactly as long as a hex constant.
 const ivEc = 0xff
 
 // encodeTable is the table used for encoding.
-var encodeTable = `0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#`
-
-// decodeTable is the decoding table with an offset of decodeOffset.
-var decodeTable = []byte{
-	0x44, ivEc, 0x54, 0x53, 0x52, 0x48, ivEc,
+var encodeTable = Alphabet
+
+// decodeTable is the decoding table that is indexed by the byte value.
+var decodeTable = [decodeTableSize]byte{
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, 0x44, ivEc, 0x54, 0x53, 0x52, 0x48, ivEc,
 	0x4b, 0x4c, 0x46, 0x41, ivEc, 0x3f, 0x3e, 0x45,
 	0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
 	0x08, 0x09, 0x40, ivEc, 0x49, 0x42, 0x4a, 0x47,
@@ -74,75 +100,143 @@ var decodeTable = []byte{
 	ivEc, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
 	0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
 	0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20,
-	0x21, 0x22, 0x23, 0x4f, ivEc, 0x50}
-
-// decodeMaxValue is the maximum acceptable byte value for decoding.
-var decodeMaxValue = byte(len(decodeTable)) + decodeOffset - 1
+	0x21, 0x22, 0x23, 0x4f, ivEc, 0x50, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+	ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc, ivEc,
+}
 
 // ******** Public functions ********
 
 // Encode encodes a byte slice into a Z85 encoded string.
 // The length of the slice must be a multiple of 4.
+//
+// Each chunk of 4 bytes is encoded independently of all other chunks.
+// This guarantees that the encoding of the concatenation of two slices, whose lengths are multiples of 4,
+// is the concatenation of their encodings.
+// So the work of encoding large data can be split at the positions returned by ConcatSafeSplit.
 func Encode(source []byte) (string, error) {
-	sourceLen := uint(len(source))
+	return StdEncoding.EncodeToString(source)
+}
+
+// MustEncode encodes a byte slice into a Z85 encoded string like Encode.
+// It is meant for constants, test fixtures and embedded data that are known to be valid.
+//
+// It panics, if the length of the slice is not a multiple of 4.
+func MustEncode(source []byte) string {
+	result, err := Encode(source)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// EncodeToBytes encodes a byte slice into a Z85 encoded byte slice.
+// The length of the slice must be a multiple of 4.
+// It avoids the conversion to a string when the result is needed as a byte slice.
+func EncodeToBytes(source []byte) ([]byte, error) {
+	encodedLen, err := encodedLength(len(source))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, encodedLen)
+	StdEncoding.encodeOwned(result, source)
+
+	return result, nil
+}
 
-	if (sourceLen & byteChunkMask) != 0 {
-		return ``, ErrInvalidLength(byteChunkSize)
+// EncodeWithBuffer encodes a byte slice into a Z85 encoded string
+// and uses the slice that buf points to as the work buffer.
+// The buffer is grown if needed and stored back into buf, so it can be reused in the next call.
+// This way the only allocation is the one for the resulting string.
+// The length of the slice must be a multiple of 4.
+func EncodeWithBuffer(buf *[]byte, source []byte) (string, error) {
+	encodedLen, err := encodedLength(len(source))
+	if err != nil {
+		return ``, err
 	}
 
-	chunkCount := sourceLen >> byteChunkShift
-	result := make([]byte, sourceLen+chunkCount)
-	destination := result
-	for chunkIndex := uint(0); chunkIndex < chunkCount; chunkIndex++ {
-		value := binary.BigEndian.Uint32(source[:byteChunkSize])
-
-		// Generate 5 characters
-		for i := byteChunkSize; i >= 0; i-- {
-			valueDiv := value / codeSize
-			destination[i] = encodeTable[value-(valueDiv*codeSize)]
-			value = valueDiv
-		}
-
-		destination = destination[encodedChunkSize:]
-		source = source[byteChunkSize:]
+	buffer := slices.Grow((*buf)[:0], encodedLen)[:encodedLen]
+	*buf = buffer
+	StdEncoding.encodeChunks(buffer, source)
+
+	return string(buffer), nil
+}
+
+// AppendEncode appends the Z85 encoding of src to dst and returns the extended slice.
+// The length of src must be a multiple of 4.
+// If an error occurs, dst is returned unchanged.
+func AppendEncode(dst []byte, src []byte) ([]byte, error) {
+	return StdEncoding.AppendEncode(dst, src)
+}
+
+// EncodeInto encodes src into dst and returns the number of bytes written.
+// The length of src must be a multiple of 4 and dst must have room for 5/4 of the length of src.
+func EncodeInto(dst []byte, src []byte) (int, error) {
+	return StdEncoding.Encode(dst, src)
+}
+
+// ConcatSafeSplit rounds n down to the nearest position where data can be split
+// so that the concatenation of the encodings of the parts is the encoding of the data.
+// A negative n returns 0.
+func ConcatSafeSplit(n int) int {
+	if n <= 0 {
+		return 0
 	}
 
-	return string(result), nil
+	return n &^ byteChunkMask
 }
 
 // Decode decodes a Z85 string into a byte slice.
 // The length of the string must be a multiple of 5.
 func Decode(source string) ([]byte, error) {
-	sourceLen := uint(len(source))
+	return StdEncoding.DecodeString(source)
+}
 
-	chunkCount := sourceLen / encodedChunkSize
-	if sourceLen != chunkCount*encodedChunkSize {
-		return nil, ErrInvalidLength(encodedChunkSize)
+// MustDecode decodes a Z85 string into a byte slice like Decode.
+// It is meant for constants, test fixtures and embedded keys that are known to be valid.
+//
+// It panics, if the string is not a valid Z85 encoding.
+func MustDecode(source string) []byte {
+	result, err := Decode(source)
+	if err != nil {
+		panic(err)
 	}
 
-	result := make([]byte, sourceLen-chunkCount)
-	destination := result
-	for chunkIndex := uint(0); chunkIndex < chunkCount; chunkIndex++ {
-		value := uint32(0)
-		for i := uint(0); i < encodedChunkSize; i++ {
-			charByte := source[i]
-			if charByte < decodeOffset || charByte > decodeMaxValue {
-				return nil, &ErrInvalidByte{position: chunkIndex*encodedChunkSize + i, value: charByte}
-			}
-
-			encodedValue := decodeTable[charByte-decodeOffset]
-			if encodedValue == ivEc {
-				return nil, &ErrInvalidByte{position: chunkIndex*encodedChunkSize + i, value: charByte}
-			}
-
-			value = value*codeSize + uint32(encodedValue)
-		}
+	return result
+}
 
-		binary.BigEndian.PutUint32(destination, value)
+// DecodeBytes decodes a Z85 encoded byte slice into a byte slice.
+// The length of the source slice must be a multiple of 5.
+// It avoids the conversion to a string when the encoded data is already in a byte slice.
+func DecodeBytes(source []byte) ([]byte, error) {
+	return decodeToSlice(StdEncoding, source)
+}
 
-		destination = destination[byteChunkSize:]
-		source = source[encodedChunkSize:]
-	}
+// AppendDecode appends the decoding of the Z85 string src to dst and returns the extended slice.
+// The length of src must be a multiple of 5.
+// If an error occurs, dst is returned unchanged.
+func AppendDecode(dst []byte, src string) ([]byte, error) {
+	return StdEncoding.AppendDecode(dst, src)
+}
 
-	return result, nil
+// DecodeInto decodes the Z85 string src into dst and returns the number of bytes written.
+// The length of src must be a multiple of 5 and dst must have room for 4/5 of the length of src.
+func DecodeInto(dst []byte, src string) (int, error) {
+	return decodeInto(StdEncoding, dst, src)
 }
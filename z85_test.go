@@ -31,7 +31,9 @@ package z85_test
 import (
 	"bytes"
 	crand "crypto/rand"
+	"errors"
 	"github.com/xformerfhs/z85"
+	"io"
 	"math/rand"
 	"strings"
 	"testing"
@@ -78,6 +80,99 @@ func TestGeneral(t *testing.T) {
 	}
 }
 
+// TestConcatenation tests if the encoding of concatenated slices is the concatenation of their encodings.
+func TestConcatenation(t *testing.T) {
+	buffer := make([]byte, maxSliceSize)
+	for i := 0; i < iterationCount; i++ {
+		_, _ = crand.Read(buffer)
+		split := z85.ConcatSafeSplit(rand.Intn(maxSliceSize + 1))
+
+		whole, err := z85.Encode(buffer)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var first, second string
+		first, err = z85.Encode(buffer[:split])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		second, err = z85.Encode(buffer[split:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if whole != first+second {
+			t.Fatalf(`Concatenated encodings split at %d do not match encoding of whole slice`, split)
+		}
+	}
+}
+
+// TestConcatSafeSplit tests the rounding of split positions.
+func TestConcatSafeSplit(t *testing.T) {
+	testCases := []struct {
+		n        int
+		expected int
+	}{
+		{-5, 0},
+		{0, 0},
+		{3, 0},
+		{4, 4},
+		{7, 4},
+		{1025, 1024},
+	}
+
+	for _, tc := range testCases {
+		result := z85.ConcatSafeSplit(tc.n)
+		if result != tc.expected {
+			t.Fatalf(`ConcatSafeSplit(%d) returned %d instead of %d`, tc.n, result, tc.expected)
+		}
+	}
+}
+
+// TestAppendEncode tests if AppendEncode appends the encoding to an existing slice.
+func TestAppendEncode(t *testing.T) {
+	prefix := []byte(`prefix:`)
+
+	result, err := z85.AppendEncode(prefix, clearTheOne)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if string(result) != `prefix:`+encodedTheOne {
+		t.Fatalf(`Appending did not result in 'prefix:%s', but '%s'`, encodedTheOne, result)
+	}
+
+	result, err = z85.AppendEncode(prefix, clearTheOne[:3])
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Wrong error when encoding invalid length: '%v'`, err)
+	}
+
+	if string(result) != `prefix:` {
+		t.Fatalf(`Failed append changed the slice: '%s'`, result)
+	}
+}
+
+// TestEncodeInto tests if EncodeInto encodes into a supplied buffer.
+func TestEncodeInto(t *testing.T) {
+	buffer := make([]byte, 12)
+
+	n, err := z85.EncodeInto(buffer, clearTheOne)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if string(buffer[:n]) != encodedTheOne {
+		t.Fatalf(`Encoding did not result in '%s', but '%s'`, encodedTheOne, buffer[:n])
+	}
+
+	_, err = z85.EncodeInto(buffer[:9], clearTheOne)
+	if !errors.Is(err, io.ErrShortBuffer) {
+		t.Fatalf(`Wrong error when encoding into a short buffer: '%v'`, err)
+	}
+}
+
 // TestEncodeTheOne implements the one test case documented on the https://rfc.zeromq.org/spec/32 website.
 func TestEncodeTheOne(t *testing.T) {
 	encoded, err := z85.Encode(clearTheOne)
@@ -90,6 +185,55 @@ func TestEncodeTheOne(t *testing.T) {
 	}
 }
 
+// TestEncodeToBytes tests if EncodeToBytes has the same result as Encode.
+func TestEncodeToBytes(t *testing.T) {
+	encoded, err := z85.EncodeToBytes(clearTheOne)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if string(encoded) != encodedTheOne {
+		t.Fatalf(`Encoding did not result in '%s', but '%s'`, encodedTheOne, encoded)
+	}
+
+	_, err = z85.EncodeToBytes(clearTheOne[:5])
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Wrong error when encoding invalid length: '%v'`, err)
+	}
+}
+
+// TestEncodeWithBuffer tests if EncodeWithBuffer reuses the supplied buffer.
+func TestEncodeWithBuffer(t *testing.T) {
+	var buffer []byte
+
+	encoded, err := z85.EncodeWithBuffer(&buffer, clearTheOne)
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if encoded != encodedTheOne {
+		t.Fatalf(`Encoding did not result in '%s', but '%s'`, encodedTheOne, encoded)
+	}
+
+	if cap(buffer) < len(encodedTheOne) {
+		t.Fatalf(`Buffer was not stored back`)
+	}
+
+	grownBuffer := &buffer[0]
+	encoded, err = z85.EncodeWithBuffer(&buffer, clearTheOne[:4])
+	if err != nil {
+		t.Fatalf(`Encoding failed: %v`, err)
+	}
+
+	if encoded != encodedTheOne[:5] {
+		t.Fatalf(`Encoding did not result in '%s', but '%s'`, encodedTheOne[:5], encoded)
+	}
+
+	if &buffer[0] != grownBuffer {
+		t.Fatal(`Buffer was not reused`)
+	}
+}
+
 // TestEncodeNil tests the encoding of a nil byte slice.
 func TestEncodeNil(t *testing.T) {
 	encoded, err := z85.Encode(nil)
@@ -118,6 +262,22 @@ func TestEncodeWithInvalidLength(t *testing.T) {
 	}
 }
 
+// TestMustEncode tests if MustEncode encodes valid input and panics on invalid input.
+func TestMustEncode(t *testing.T) {
+	encoded := z85.MustEncode(clearTheOne)
+	if encoded != encodedTheOne {
+		t.Fatalf(`Encoding did not result in '%s', but '%s'`, encodedTheOne, encoded)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal(`Invalid length did not panic`)
+		}
+	}()
+
+	_ = z85.MustEncode(clearTheOne[2:5])
+}
+
 // TestDecodeTheOne implements the one test case documented on the https://rfc.zeromq.org/spec/32 website.
 func TestDecodeTheOne(t *testing.T) {
 	decoded, err := z85.Decode(encodedTheOne)
@@ -130,6 +290,75 @@ func TestDecodeTheOne(t *testing.T) {
 	}
 }
 
+// TestAppendDecode tests if AppendDecode appends the decoding to an existing slice.
+func TestAppendDecode(t *testing.T) {
+	prefix := []byte{0x01, 0x02}
+
+	result, err := z85.AppendDecode(prefix, encodedTheOne)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(result, append([]byte{0x01, 0x02}, clearTheOne...)) {
+		t.Fatalf(`Appending did not result in expected bytes, but '% 02x'`, result)
+	}
+
+	result, err = z85.AppendDecode(prefix, `Hello~orld`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Wrong error when decoding invalid character: '%v'`, err)
+	}
+
+	if !bytes.Equal(result, []byte{0x01, 0x02}) {
+		t.Fatalf(`Failed append changed the slice: '% 02x'`, result)
+	}
+}
+
+// TestDecodeInto tests if DecodeInto decodes into a supplied buffer.
+func TestDecodeInto(t *testing.T) {
+	buffer := make([]byte, 10)
+
+	n, err := z85.DecodeInto(buffer, encodedTheOne)
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(buffer[:n], clearTheOne) {
+		t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, buffer[:n])
+	}
+
+	_, err = z85.DecodeInto(buffer[:7], encodedTheOne)
+	if !errors.Is(err, io.ErrShortBuffer) {
+		t.Fatalf(`Wrong error when decoding into a short buffer: '%v'`, err)
+	}
+
+	_, err = z85.DecodeInto(buffer, `Hello~orld`)
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Wrong error when decoding invalid character: '%v'`, err)
+	}
+}
+
+// TestDecodeBytes tests if a byte slice is decoded like a string.
+func TestDecodeBytes(t *testing.T) {
+	decoded, err := z85.DecodeBytes([]byte(encodedTheOne))
+	if err != nil {
+		t.Fatalf(`Decoding failed: %v`, err)
+	}
+
+	if !bytes.Equal(decoded, clearTheOne) {
+		t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, decoded)
+	}
+
+	_, err = z85.DecodeBytes([]byte(`1234`))
+	if !z85.IsErrInvalidLength(err) {
+		t.Fatalf(`Wrong error when decoding invalid length: '%v'`, err)
+	}
+
+	_, err = z85.DecodeBytes([]byte(`123~5`))
+	if !z85.IsErrInvalidByte(err) {
+		t.Fatalf(`Wrong error when decoding invalid character: '%v'`, err)
+	}
+}
+
 // TestDecodeEmpty tests if an empty string is decoded correctly.
 func TestDecodeEmpty(t *testing.T) {
 	decoded, err := z85.Decode(``)
@@ -186,3 +415,23 @@ func TestDecodeInvalidChar(t *testing.T) {
 		}
 	}
 }
+
+// TestMustDecode tests if MustDecode decodes valid input and panics on invalid input.
+func TestMustDecode(t *testing.T) {
+	decoded := z85.MustDecode(encodedTheOne)
+	if !bytes.Equal(decoded, clearTheOne) {
+		t.Fatalf(`Decoding did not result in expected bytes, but '% 02x'`, decoded)
+	}
+
+	for _, source := range []string{`1234`, `12 45`, `%nSc1`} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf(`Invalid input '%s' did not panic`, source)
+				}
+			}()
+
+			_ = z85.MustDecode(source)
+		}()
+	}
+}
@@ -0,0 +1,128 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+// Package z85test provides helpers for testing applications that use the z85 package.
+package z85test
+
+import (
+	"errors"
+	"io"
+)
+
+// ******** Public variables ********
+
+// ErrInjected is the error that is injected, if no specific error is configured.
+var ErrInjected = errors.New(`injected error`)
+
+// ******** Public types ********
+
+// FaultyReader is an io.Reader that returns an error when a configured offset is reached.
+type FaultyReader struct {
+	r      io.Reader
+	offset int64
+	err    error
+	count  int64
+}
+
+// FaultyWriter is an io.Writer that returns an error when a configured offset is reached.
+type FaultyWriter struct {
+	w      io.Writer
+	offset int64
+	err    error
+	count  int64
+}
+
+// ******** Public creation functions ********
+
+// NewFaultyReader creates a new FaultyReader that reads from r and returns err
+// when offset bytes have been read.
+// If err is nil, ErrInjected is returned.
+func NewFaultyReader(r io.Reader, offset int64, err error) *FaultyReader {
+	return &FaultyReader{r: r, offset: offset, err: injectedError(err)}
+}
+
+// NewFaultyWriter creates a new FaultyWriter that writes to w and returns err
+// when offset bytes have been written.
+// If err is nil, ErrInjected is returned.
+func NewFaultyWriter(w io.Writer, offset int64, err error) *FaultyWriter {
+	return &FaultyWriter{w: w, offset: offset, err: injectedError(err)}
+}
+
+// ******** Public functions ********
+
+// Read reads from the underlying reader until the configured offset is reached.
+// From then on, it returns the configured error.
+func (fr *FaultyReader) Read(p []byte) (int, error) {
+	remaining := fr.offset - fr.count
+	if remaining <= 0 {
+		return 0, fr.err
+	}
+
+	if int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+
+	n, err := fr.r.Read(p)
+	fr.count += int64(n)
+
+	return n, err
+}
+
+// Write writes to the underlying writer until the configured offset is reached.
+// A write that crosses the offset is a short write that returns the configured error.
+func (fw *FaultyWriter) Write(p []byte) (int, error) {
+	remaining := fw.offset - fw.count
+	if remaining <= 0 {
+		return 0, fw.err
+	}
+
+	short := false
+	if int64(len(p)) > remaining {
+		p = p[:remaining]
+		short = true
+	}
+
+	n, err := fw.w.Write(p)
+	fw.count += int64(n)
+	if err == nil && short {
+		err = fw.err
+	}
+
+	return n, err
+}
+
+// ******** Private functions ********
+
+// injectedError returns the error to inject.
+func injectedError(err error) error {
+	if err == nil {
+		return ErrInjected
+	}
+
+	return err
+}
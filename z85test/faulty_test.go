@@ -0,0 +1,114 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85test_test
+
+import (
+	"bytes"
+	"errors"
+	"github.com/xformerfhs/z85/z85test"
+	"io"
+	"testing"
+)
+
+// ******** Private variables ********
+
+// testData contains the data used for the tests.
+var testData = []byte(`0123456789abcdefghij`)
+
+// errTest is the error that is injected in the tests.
+var errTest = errors.New(`test error`)
+
+// ******** Test functions ********
+
+// TestFaultyReader tests if the reader fails exactly at the configured offset.
+func TestFaultyReader(t *testing.T) {
+	fr := z85test.NewFaultyReader(bytes.NewReader(testData), 7, errTest)
+
+	data, err := io.ReadAll(fr)
+	if !errors.Is(err, errTest) {
+		t.Fatalf(`Wrong error: '%v'`, err)
+	}
+
+	if !bytes.Equal(data, testData[:7]) {
+		t.Fatalf(`Read wrong data: '%s'`, data)
+	}
+}
+
+// TestFaultyReaderDefaultError tests if the default error is injected, if no error is configured.
+func TestFaultyReaderDefaultError(t *testing.T) {
+	fr := z85test.NewFaultyReader(bytes.NewReader(testData), 0, nil)
+
+	_, err := fr.Read(make([]byte, 4))
+	if !errors.Is(err, z85test.ErrInjected) {
+		t.Fatalf(`Wrong error: '%v'`, err)
+	}
+}
+
+// TestFaultyReaderBeyondEnd tests if the reader returns EOF, if the offset is beyond the end of the data.
+func TestFaultyReaderBeyondEnd(t *testing.T) {
+	fr := z85test.NewFaultyReader(bytes.NewReader(testData), 100, errTest)
+
+	data, err := io.ReadAll(fr)
+	if err != nil {
+		t.Fatalf(`Unexpected error: '%v'`, err)
+	}
+
+	if !bytes.Equal(data, testData) {
+		t.Fatalf(`Read wrong data: '%s'`, data)
+	}
+}
+
+// TestFaultyWriter tests if the writer fails exactly at the configured offset.
+func TestFaultyWriter(t *testing.T) {
+	var buffer bytes.Buffer
+	fw := z85test.NewFaultyWriter(&buffer, 12, errTest)
+
+	n, err := fw.Write(testData[:10])
+	if err != nil || n != 10 {
+		t.Fatalf(`First write failed: %d, '%v'`, n, err)
+	}
+
+	n, err = fw.Write(testData[10:])
+	if !errors.Is(err, errTest) {
+		t.Fatalf(`Wrong error: '%v'`, err)
+	}
+
+	if n != 2 {
+		t.Fatalf(`Short write wrote %d bytes instead of 2`, n)
+	}
+
+	if !bytes.Equal(buffer.Bytes(), testData[:12]) {
+		t.Fatalf(`Wrote wrong data: '%s'`, buffer.Bytes())
+	}
+
+	n, err = fw.Write(testData)
+	if !errors.Is(err, errTest) || n != 0 {
+		t.Fatalf(`Write after failure returned %d, '%v'`, n, err)
+	}
+}
@@ -0,0 +1,120 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+
+package z85test
+
+import (
+	"io"
+	"sync"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Public types ********
+
+// FlakyEncoding is a z85.Encoding whose encoders and decoders fail at configured offsets of the encoded text.
+// The nth stream that is created fails at the nth offset and the streams after the last offset do not fail,
+// so the retry and error handling of applications can be tested deterministically.
+// All other methods are the ones of the wrapped encoding.
+type FlakyEncoding struct {
+	*z85.Encoding
+	err     error
+	offsets []int64
+	mu      sync.Mutex
+	streams int
+}
+
+// ******** Public creation functions ********
+
+// NewFlakyEncoding creates a new FlakyEncoding that wraps enc and injects err at the offsets.
+// Each offset is the number of encoded bytes that the stream writes or reads before it fails.
+// A negative offset creates a stream that does not fail.
+// If err is nil, ErrInjected is returned.
+func NewFlakyEncoding(enc *z85.Encoding, err error, offsets ...int64) *FlakyEncoding {
+	return &FlakyEncoding{Encoding: enc, err: injectedError(err), offsets: offsets}
+}
+
+// ******** Public functions ********
+
+// NewEncoder returns an encoder of the wrapped encoding whose writes to w fail at the next offset.
+func (f *FlakyEncoding) NewEncoder(w io.Writer) io.WriteCloser {
+	if offset, fails := f.nextOffset(); fails {
+		w = NewFaultyWriter(w, offset, f.err)
+	}
+
+	return f.Encoding.NewEncoder(w)
+}
+
+// NewDecoder returns a decoder of the wrapped encoding whose reads from r fail at the next offset.
+func (f *FlakyEncoding) NewDecoder(r io.Reader) io.Reader {
+	if offset, fails := f.nextOffset(); fails {
+		r = NewFaultyReader(r, offset, f.err)
+	}
+
+	return f.Encoding.NewDecoder(r)
+}
+
+// NewDecoderSize returns a decoder of the wrapped encoding with the buffer size whose reads from r fail at the next offset.
+func (f *FlakyEncoding) NewDecoderSize(r io.Reader, size int) io.Reader {
+	if offset, fails := f.nextOffset(); fails {
+		r = NewFaultyReader(r, offset, f.err)
+	}
+
+	return f.Encoding.NewDecoderSize(r, size)
+}
+
+// NewPartialDecoder returns a partial decoder of the wrapped encoding whose reads from r fail at the next offset.
+func (f *FlakyEncoding) NewPartialDecoder(r io.Reader) io.Reader {
+	if offset, fails := f.nextOffset(); fails {
+		r = NewFaultyReader(r, offset, f.err)
+	}
+
+	return f.Encoding.NewPartialDecoder(r)
+}
+
+// Streams returns the number of encoders and decoders that have been created.
+func (f *FlakyEncoding) Streams() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.streams
+}
+
+// ******** Private functions ********
+
+// nextOffset returns the offset of the next stream and whether the stream fails.
+func (f *FlakyEncoding) nextOffset() (int64, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := f.streams
+	f.streams++
+	if n >= len(f.offsets) || f.offsets[n] < 0 {
+		return 0, false
+	}
+
+	return f.offsets[n], true
+}
@@ -0,0 +1,104 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+
+package z85test_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+	"github.com/xformerfhs/z85/z85test"
+)
+
+// ******** Private variables ********
+
+// flakyClear contains the clear data of the encoded text "HelloWorld".
+var flakyClear = []byte{0x86, 0x4F, 0xD2, 0x6F, 0xB5, 0x59, 0xF7, 0x5B}
+
+// ******** Test functions ********
+
+// TestFlakyEncodingEncoder tests if the first encoder fails at the offset and a retry succeeds.
+func TestFlakyEncodingEncoder(t *testing.T) {
+	enc := z85test.NewFlakyEncoding(z85.StdEncoding, errTest, 7)
+
+	var buffer bytes.Buffer
+	encoder := enc.NewEncoder(&buffer)
+	_, err := encoder.Write(flakyClear)
+	if err == nil {
+		err = encoder.Close()
+	}
+
+	if !errors.Is(err, errTest) {
+		t.Fatalf(`Wrong error: '%v'`, err)
+	}
+
+	if buffer.String() != `HelloWo` {
+		t.Fatalf(`Failed encoder wrote '%s'`, buffer.String())
+	}
+
+	buffer.Reset()
+	encoder = enc.NewEncoder(&buffer)
+	if _, err = encoder.Write(flakyClear); err == nil {
+		err = encoder.Close()
+	}
+
+	if err != nil || buffer.String() != `HelloWorld` {
+		t.Fatalf(`Retry resulted in '%s', '%v'`, buffer.String(), err)
+	}
+
+	if enc.Streams() != 2 {
+		t.Fatalf(`Encoding counted %d streams instead of 2`, enc.Streams())
+	}
+}
+
+// TestFlakyEncodingDecoder tests if only the decoders with an offset fail.
+func TestFlakyEncodingDecoder(t *testing.T) {
+	enc := z85test.NewFlakyEncoding(z85.StdEncoding, nil, -1, 5)
+
+	for i, expectedErr := range []error{nil, z85test.ErrInjected, nil} {
+		data, err := io.ReadAll(enc.NewDecoder(strings.NewReader(`HelloWorld`)))
+		if !errors.Is(err, expectedErr) {
+			t.Fatalf(`Decoder %d returned the error '%v' instead of '%v'`, i, err, expectedErr)
+		}
+
+		expected := flakyClear
+		if expectedErr != nil {
+			expected = flakyClear[:4]
+		}
+
+		if !bytes.Equal(data, expected) {
+			t.Fatalf(`Decoder %d read % 02x instead of % 02x`, i, data, expected)
+		}
+	}
+
+	if decoded, err := enc.DecodeString(`HelloWorld`); err != nil || !bytes.Equal(decoded, flakyClear) {
+		t.Fatalf(`Wrapped encoding decoded % 02x, '%v'`, decoded, err)
+	}
+}
@@ -0,0 +1,188 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85test
+
+import (
+	"math/rand"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Private constants ********
+
+// printableCharacters contains all printable ASCII characters except the space character.
+// Alphabets are selected from these characters.
+const printableCharacters = "!\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~"
+
+// nonZ85Characters contains the printable ASCII characters that are not in the Z85 alphabet.
+const nonZ85Characters = "\"',;\\_`|~"
+
+// alphabetSize is the number of characters of an alphabet.
+const alphabetSize = 85
+
+// chunkSize is the size of a raw chunk.
+const chunkSize = 4
+
+// encodedChunkSize is the size of an encoded chunk.
+const encodedChunkSize = 5
+
+// maxOverflowChunk is the largest value that an encoded chunk can represent.
+const maxOverflowChunk = 85*85*85*85*85 - 1
+
+// ******** Public types ********
+
+// Generator generates reproducible test data.
+// The same seed always results in the same sequence of data,
+// so a failure can be reproduced from the seed alone.
+// A Generator is not safe for concurrent use.
+type Generator struct {
+	seed uint64
+	rng  *rand.Rand
+}
+
+// Case is a test case with raw data and its Z85 encoding.
+type Case struct {
+	Data    []byte
+	Encoded string
+}
+
+// ******** Public creation functions ********
+
+// NewGenerator creates a new Generator with the supplied seed.
+func NewGenerator(seed uint64) *Generator {
+	return &Generator{seed: seed, rng: rand.New(rand.NewSource(int64(seed)))}
+}
+
+// ******** Public functions ********
+
+// Seed returns the seed of the generator.
+func (g *Generator) Seed() uint64 {
+	return g.seed
+}
+
+// Size returns a random multiple of 4 between 0 and maxSize.
+func (g *Generator) Size(maxSize int) int {
+	if maxSize < chunkSize {
+		return 0
+	}
+
+	return g.rng.Intn(maxSize/chunkSize+1) * chunkSize
+}
+
+// Data returns size random bytes.
+func (g *Generator) Data(size int) []byte {
+	result := make([]byte, size)
+	for i := range result {
+		result[i] = byte(g.rng.Uint32())
+	}
+
+	return result
+}
+
+// Alphabet returns a random alphabet that is valid for z85.NewEncoding.
+func (g *Generator) Alphabet() string {
+	characters := []byte(printableCharacters)
+	g.rng.Shuffle(len(characters), func(i, j int) {
+		characters[i], characters[j] = characters[j], characters[i]
+	})
+
+	return string(characters[:alphabetSize])
+}
+
+// Corpus returns count test cases with random data of random sizes up to maxSize.
+func (g *Generator) Corpus(count int, maxSize int) []Case {
+	result := make([]Case, count)
+	for i := range result {
+		data := g.Data(g.Size(maxSize))
+		encoded, _ := z85.Encode(data)
+		result[i] = Case{Data: data, Encoded: encoded}
+	}
+
+	return result
+}
+
+// Corrupt applies a random corruption to the Z85 encoded string encoded.
+// It returns the corrupted string and the kind of the error that decoding it results in.
+// The corruptions are an invalid character, a control character, an invalid length and an overflowing chunk.
+func (g *Generator) Corrupt(encoded string) (string, z85.ErrorKind) {
+	result := []byte(encoded)
+	chunkCount := len(result) / encodedChunkSize
+
+	kind := g.corruptionKind(chunkCount)
+	switch kind {
+	case z85.KindInvalidByte:
+		result[g.rng.Intn(len(result))] = nonZ85Characters[g.rng.Intn(len(nonZ85Characters))]
+
+	case z85.KindControlCharacter:
+		result[g.rng.Intn(len(result))] = byte(g.rng.Intn(' '))
+
+	case z85.KindInvalidLength:
+		changeLen := 1 + g.rng.Intn(encodedChunkSize-1)
+		if changeLen <= len(result) && g.rng.Intn(2) == 0 {
+			result = result[:len(result)-changeLen]
+		} else {
+			for i := 0; i < changeLen; i++ {
+				result = append(result, z85.StdEncoding.Alphabet()[g.rng.Intn(alphabetSize)])
+			}
+		}
+
+	case z85.KindOverflow:
+		if chunkCount == 0 {
+			result = append(result, make([]byte, encodedChunkSize)...)
+			chunkCount = 1
+		}
+
+		position := g.rng.Intn(chunkCount) * encodedChunkSize
+		putOverflowChunk(result[position:position+encodedChunkSize], 1<<32+uint64(g.rng.Int63n(maxOverflowChunk-1<<32+1)))
+	}
+
+	return string(result), kind
+}
+
+// ******** Private functions ********
+
+// corruptionKind selects the kind of a corruption.
+// Characters can only be replaced, if there is at least one chunk.
+func (g *Generator) corruptionKind(chunkCount int) z85.ErrorKind {
+	kinds := []z85.ErrorKind{z85.KindInvalidLength, z85.KindOverflow}
+	if chunkCount > 0 {
+		kinds = append(kinds, z85.KindInvalidByte, z85.KindControlCharacter)
+	}
+
+	return kinds[g.rng.Intn(len(kinds))]
+}
+
+// putOverflowChunk writes the encoding of value, which does not fit into 32 bits, into destination.
+func putOverflowChunk(destination []byte, value uint64) {
+	alphabet := z85.StdEncoding.Alphabet()
+	for i := encodedChunkSize - 1; i >= 0; i-- {
+		destination[i] = alphabet[value%alphabetSize]
+		value /= alphabetSize
+	}
+}
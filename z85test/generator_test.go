@@ -0,0 +1,128 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85test_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+	"github.com/xformerfhs/z85/z85test"
+)
+
+// ******** Private constants ********
+
+// testSeed is the seed that is used in the tests.
+const testSeed = 20261017
+
+// ******** Test functions ********
+
+// TestGeneratorReproducible tests if the same seed results in the same data.
+func TestGeneratorReproducible(t *testing.T) {
+	first := z85test.NewGenerator(testSeed)
+	second := z85test.NewGenerator(testSeed)
+
+	if first.Seed() != testSeed {
+		t.Fatalf(`Seed is %d, but should be %d`, first.Seed(), testSeed)
+	}
+
+	firstCorpus := first.Corpus(10, 64)
+	secondCorpus := second.Corpus(10, 64)
+	for i := range firstCorpus {
+		if !bytes.Equal(firstCorpus[i].Data, secondCorpus[i].Data) || firstCorpus[i].Encoded != secondCorpus[i].Encoded {
+			t.Fatalf(`Case %d differs for the same seed`, i)
+		}
+	}
+
+	if first.Alphabet() != second.Alphabet() {
+		t.Fatal(`Alphabets differ for the same seed`)
+	}
+
+	firstCorrupted, _ := first.Corrupt(firstCorpus[0].Encoded)
+	secondCorrupted, _ := second.Corrupt(secondCorpus[0].Encoded)
+	if firstCorrupted != secondCorrupted {
+		t.Fatal(`Corruptions differ for the same seed`)
+	}
+}
+
+// TestGeneratorCorpus tests if the cases of a corpus are valid.
+func TestGeneratorCorpus(t *testing.T) {
+	generator := z85test.NewGenerator(testSeed)
+
+	for _, testCase := range generator.Corpus(100, 64) {
+		if len(testCase.Data) > 64 || len(testCase.Data)%4 != 0 {
+			t.Fatalf(`Data has invalid length %d`, len(testCase.Data))
+		}
+
+		decoded, err := z85.Decode(testCase.Encoded)
+		if err != nil || !bytes.Equal(decoded, testCase.Data) {
+			t.Fatalf(`Encoded case '%s' does not decode to its data: %v`, testCase.Encoded, err)
+		}
+	}
+}
+
+// TestGeneratorAlphabet tests if the generated alphabets are valid.
+func TestGeneratorAlphabet(t *testing.T) {
+	generator := z85test.NewGenerator(testSeed)
+
+	for i := 0; i < 100; i++ {
+		alphabet := generator.Alphabet()
+		_, err := z85.NewEncoding(alphabet)
+		if err != nil {
+			t.Fatalf(`Alphabet '%s' is invalid: %v`, alphabet, err)
+		}
+	}
+}
+
+// TestGeneratorCorrupt tests if decoding a corrupted string results in the reported error kind.
+func TestGeneratorCorrupt(t *testing.T) {
+	generator := z85test.NewGenerator(testSeed)
+
+	kinds := make(map[z85.ErrorKind]bool)
+	for _, testCase := range generator.Corpus(1000, 32) {
+		corrupted, kind := generator.Corrupt(testCase.Encoded)
+		kinds[kind] = true
+
+		_, err := z85.Decode(corrupted)
+
+		var codecErr *z85.CodecError
+		if !errors.As(err, &codecErr) {
+			t.Fatalf(`Corruption of '%s' to %q was not detected: %v`, testCase.Encoded, corrupted, err)
+		}
+
+		if codecErr.Kind != kind {
+			t.Fatalf(`Corruption of '%s' to %q resulted in '%s', but should result in '%s'`, testCase.Encoded, corrupted, codecErr.Kind, kind)
+		}
+	}
+
+	if len(kinds) != 4 {
+		t.Fatalf(`Only %d kinds of corruption were generated`, len(kinds))
+	}
+}
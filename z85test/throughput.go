@@ -0,0 +1,174 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85test
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/xformerfhs/z85"
+)
+
+// ******** Public constants ********
+
+// EnvMinEncodeThroughput is the name of the environment variable with the minimum encoding throughput in MB/s.
+const EnvMinEncodeThroughput = `Z85_MIN_ENCODE_MBPS`
+
+// EnvMinDecodeThroughput is the name of the environment variable with the minimum decoding throughput in MB/s.
+const EnvMinDecodeThroughput = `Z85_MIN_DECODE_MBPS`
+
+// DefaultThroughputSize is the size of the data that CheckThroughput measures with.
+const DefaultThroughputSize = 1024 * 1024
+
+// Names of the measured operations.
+const (
+	OperationEncode = `encode`
+	OperationDecode = `decode`
+)
+
+// ******** Public types ********
+
+// ThroughputResult is the result of a throughput measurement.
+// The throughput always refers to the size of the raw data.
+type ThroughputResult struct {
+	Operation    string  `json:"operation"`
+	Acceleration string  `json:"acceleration"`
+	Size         int     `json:"size"`
+	Iterations   int     `json:"iterations"`
+	NsPerOp      int64   `json:"nsPerOp"`
+	MBPerSecond  float64 `json:"mbPerSecond"`
+}
+
+// ******** Public functions ********
+
+// MeasureThroughput measures the encoding and decoding throughput of the z85 package with random data
+// of the given size.
+// The size is rounded down to a multiple of 4.
+func MeasureThroughput(size int) []ThroughputResult {
+	data := make([]byte, size&^3)
+	_, _ = rand.Read(data)
+	encoded, _ := z85.Encode(data)
+
+	encodeResult := testing.Benchmark(func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			_, _ = z85.Encode(data)
+		}
+	})
+
+	decodeResult := testing.Benchmark(func(b *testing.B) {
+		b.SetBytes(int64(len(data)))
+		for i := 0; i < b.N; i++ {
+			_, _ = z85.Decode(encoded)
+		}
+	})
+
+	return []ThroughputResult{
+		newThroughputResult(OperationEncode, len(data), encodeResult),
+		newThroughputResult(OperationDecode, len(data), decodeResult),
+	}
+}
+
+// CheckThroughput measures the throughput and fails t, if it is lower than the minimum values
+// in the environment variables EnvMinEncodeThroughput and EnvMinDecodeThroughput.
+// It skips t, if none of the variables is set, so it can be part of a normal test run.
+// The results are logged as JSON.
+func CheckThroughput(t *testing.T) {
+	t.Helper()
+
+	minEncode, err := thresholdFromEnv(EnvMinEncodeThroughput)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	minDecode, err := thresholdFromEnv(EnvMinDecodeThroughput)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if minEncode == 0 && minDecode == 0 {
+		t.Skipf(`Neither %s nor %s is set`, EnvMinEncodeThroughput, EnvMinDecodeThroughput)
+	}
+
+	minimums := map[string]float64{
+		OperationEncode: minEncode,
+		OperationDecode: minDecode,
+	}
+
+	for _, result := range MeasureThroughput(DefaultThroughputSize) {
+		output, _ := json.Marshal(result)
+		t.Log(string(output))
+
+		if result.MBPerSecond < minimums[result.Operation] {
+			t.Errorf(`Throughput of %s is %.2f MB/s, but should be at least %.2f MB/s`,
+				result.Operation, result.MBPerSecond, minimums[result.Operation])
+		}
+	}
+}
+
+// ******** Private creation functions ********
+
+// newThroughputResult converts a benchmark result into a ThroughputResult.
+func newThroughputResult(operation string, size int, result testing.BenchmarkResult) ThroughputResult {
+	mbPerSecond := 0.0
+	if result.T > 0 {
+		mbPerSecond = float64(result.Bytes) * float64(result.N) / 1e6 / result.T.Seconds()
+	}
+
+	return ThroughputResult{
+		Operation:    operation,
+		Acceleration: z85.Capabilities().Acceleration,
+		Size:         size,
+		Iterations:   result.N,
+		NsPerOp:      result.NsPerOp(),
+		MBPerSecond:  mbPerSecond,
+	}
+}
+
+// ******** Private functions ********
+
+// thresholdFromEnv reads a throughput in MB/s from the environment variable with the given name.
+// It returns 0, if the variable is not set.
+func thresholdFromEnv(name string) (float64, error) {
+	value, found := os.LookupEnv(name)
+	if !found || value == `` {
+		return 0, nil
+	}
+
+	result, err := strconv.ParseFloat(value, 64)
+	if err != nil || result < 0 {
+		return 0, fmt.Errorf(`environment variable %s has no valid throughput: '%s'`, name, value)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,70 @@
+//
+// SPDX-FileCopyrightText: Copyright 2026 Frank Schwab
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// SPDX-FileType: SOURCE
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+//
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: Frank Schwab
+//
+// Version: 1.0.0
+//
+// Change history:
+//    2026-10-17: V1.0.0: Created.
+//
+
+package z85test_test
+
+import (
+	"testing"
+
+	"github.com/xformerfhs/z85/z85test"
+)
+
+// ******** Test functions ********
+
+// TestThroughput fails, if the throughput is lower than the minimum values in the environment variables.
+// It is skipped, if the variables are not set.
+func TestThroughput(t *testing.T) {
+	z85test.CheckThroughput(t)
+}
+
+// TestMeasureThroughput tests if the measurement yields results for encoding and decoding.
+func TestMeasureThroughput(t *testing.T) {
+	if testing.Short() {
+		t.Skip(`Measurement takes several seconds`)
+	}
+
+	results := z85test.MeasureThroughput(1026)
+	if len(results) != 2 {
+		t.Fatalf(`There are %d results, but there should be 2`, len(results))
+	}
+
+	for i, operation := range []string{z85test.OperationEncode, z85test.OperationDecode} {
+		result := results[i]
+		if result.Operation != operation {
+			t.Fatalf(`Operation is '%s', but should be '%s'`, result.Operation, operation)
+		}
+
+		if result.Size != 1024 {
+			t.Fatalf(`Size of %s is %d, but should be 1024`, operation, result.Size)
+		}
+
+		if result.Iterations <= 0 || result.MBPerSecond <= 0 {
+			t.Fatalf(`Result of %s is not plausible: %+v`, operation, result)
+		}
+	}
+}